@@ -2,11 +2,17 @@ package mathf
 
 import "fmt"
 
-// float32 Array representing an 2D axis aligned bounding box
-type AABB2 struct {
-	Min, Max *Vec2
+// 2D axis aligned bounding box of float32 or float64
+type AABB2T[T Float] struct {
+	Min, Max *Vec2T[T]
 }
 
+// float32 AABB2
+type AABB2 = AABB2T[float32]
+
+// float64 AABB2
+type AABB2d = AABB2T[float64]
+
 // returns new AABB2
 func NewAABB2() *AABB2 {
 	this := new(AABB2)
@@ -17,14 +23,28 @@ func NewAABB2() *AABB2 {
 	return this
 }
 
+// returns new AABB2d
+func NewAABB2d() *AABB2d {
+	this := new(AABB2d)
+
+	this.Min = NewVec2d(inf[float64](), inf[float64]())
+	this.Max = NewVec2d(-inf[float64](), -inf[float64]())
+
+	return this
+}
+
 // returns a copy of this
-func (this *AABB2) Clone() *AABB2 {
+func (this *AABB2T[T]) Clone() *AABB2T[T] {
+	other := new(AABB2T[T])
 
-	return new(AABB2).Copy(this)
+	other.Min = new(Vec2T[T]).Copy(this.Min)
+	other.Max = new(Vec2T[T]).Copy(this.Max)
+
+	return other
 }
 
 // copies other
-func (this *AABB2) Copy(other *AABB2) *AABB2 {
+func (this *AABB2T[T]) Copy(other *AABB2T[T]) *AABB2T[T] {
 
 	this.Min.Copy(other.Min)
 	this.Max.Copy(other.Max)
@@ -33,7 +53,7 @@ func (this *AABB2) Copy(other *AABB2) *AABB2 {
 }
 
 // sets this from values
-func (this *AABB2) Set(min, max *Vec2) *AABB2 {
+func (this *AABB2T[T]) Set(min, max *Vec2T[T]) *AABB2T[T] {
 
 	this.Min.Copy(min)
 	this.Max.Copy(max)
@@ -42,7 +62,7 @@ func (this *AABB2) Set(min, max *Vec2) *AABB2 {
 }
 
 // expands this by point
-func (this *AABB2) ExpandPoint(v *Vec2) *AABB2 {
+func (this *AABB2T[T]) ExpandPoint(v *Vec2T[T]) *AABB2T[T] {
 
 	this.Min.Min(v)
 	this.Max.Max(v)
@@ -51,7 +71,7 @@ func (this *AABB2) ExpandPoint(v *Vec2) *AABB2 {
 }
 
 // expands this by vector
-func (this *AABB2) ExpandVec(v *Vec2) *AABB2 {
+func (this *AABB2T[T]) ExpandVec(v *Vec2T[T]) *AABB2T[T] {
 
 	this.Min.Sub(v)
 	this.Max.Add(v)
@@ -60,7 +80,7 @@ func (this *AABB2) ExpandVec(v *Vec2) *AABB2 {
 }
 
 // expands this by scalar
-func (this *AABB2) ExpandScalar(s float32) *AABB2 {
+func (this *AABB2T[T]) ExpandScalar(s T) *AABB2T[T] {
 
 	this.Min.SSub(s)
 	this.Max.SAdd(s)
@@ -69,7 +89,7 @@ func (this *AABB2) ExpandScalar(s float32) *AABB2 {
 }
 
 // merges this and other
-func (this *AABB2) Union(other *AABB2) *AABB2 {
+func (this *AABB2T[T]) Union(other *AABB2T[T]) *AABB2T[T] {
 
 	this.Min.Min(other.Min)
 	this.Max.Max(other.Max)
@@ -78,16 +98,85 @@ func (this *AABB2) Union(other *AABB2) *AABB2 {
 }
 
 // returns this as empty
-func (this *AABB2) Empty() *AABB2 {
+func (this *AABB2T[T]) Empty() *AABB2T[T] {
+	m := inf[T]()
 
-	this.Min.Set(Inf, Inf)
-	this.Max.Set(-Inf, -Inf)
+	this.Min.Set(m, m)
+	this.Max.Set(-m, -m)
 
 	return this
 }
 
+// checks if this is empty
+func (this *AABB2T[T]) IsEmpty() bool {
+
+	return this.Max[0] < this.Min[0] || this.Max[1] < this.Min[1]
+}
+
+// returns center of this, saves in target
+func (this *AABB2T[T]) Center(target *Vec2T[T]) *Vec2T[T] {
+
+	return target.VAdd(this.Min, this.Max).SMul(0.5)
+}
+
+// returns size of this, saves in target
+func (this *AABB2T[T]) Size(target *Vec2T[T]) *Vec2T[T] {
+
+	if this.IsEmpty() {
+		return target.Set(0, 0)
+	}
+
+	return target.VSub(this.Max, this.Min)
+}
+
+// returns area of this
+func (this *AABB2T[T]) Area() T {
+
+	if this.IsEmpty() {
+		return 0
+	}
+
+	return (this.Max[0] - this.Min[0]) * (this.Max[1] - this.Min[1])
+}
+
+// returns perimeter of this
+func (this *AABB2T[T]) Perimeter() T {
+
+	if this.IsEmpty() {
+		return 0
+	}
+
+	return 2 * ((this.Max[0] - this.Min[0]) + (this.Max[1] - this.Min[1]))
+}
+
+// returns corner i from 0 to 3, bits 0 and 1 of i select max x and y, saves in target
+func (this *AABB2T[T]) Corner(i int, target *Vec2T[T]) *Vec2T[T] {
+
+	target.Copy(this.Min)
+
+	if i&1 != 0 {
+		target[0] = this.Max[0]
+	}
+	if i&2 != 0 {
+		target[1] = this.Max[1]
+	}
+
+	return target
+}
+
+// returns array of the four corners of this
+func (this *AABB2T[T]) Corners() []*Vec2T[T] {
+	array := make([]*Vec2T[T], 4)
+
+	for i := range array {
+		array[i] = this.Corner(i, new(Vec2T[T]))
+	}
+
+	return array
+}
+
 // checks if AABB contains point
-func (this *AABB2) Contains(v *Vec2) bool {
+func (this *AABB2T[T]) Contains(v *Vec2T[T]) bool {
 
 	if v[0] < this.Min[0] {
 		return false
@@ -107,7 +196,7 @@ func (this *AABB2) Contains(v *Vec2) bool {
 }
 
 // checks if this and other intersect
-func (this *AABB2) Intersects(other *AABB2) bool {
+func (this *AABB2T[T]) Intersects(other *AABB2T[T]) bool {
 
 	if other.Max[0] < this.Min[0] {
 		return false
@@ -126,8 +215,67 @@ func (this *AABB2) Intersects(other *AABB2) bool {
 	return true
 }
 
+// sets this to the overlap of this and other, empty if they do not intersect
+func (this *AABB2T[T]) Intersection(other *AABB2T[T]) *AABB2T[T] {
+
+	this.Min.Max(other.Min)
+	this.Max.Min(other.Max)
+
+	if this.IsEmpty() {
+		return this.Empty()
+	}
+
+	return this
+}
+
+// returns closest point in this to point, saves in target
+func (this *AABB2T[T]) ClosestPoint(v, target *Vec2T[T]) *Vec2T[T] {
+
+	return target.Copy(v).Clamp(this.Min, this.Max)
+}
+
+// returns distance from this to point, zero if point is inside
+func (this *AABB2T[T]) DistanceToPoint(v *Vec2T[T]) T {
+	var p Vec2T[T]
+
+	return this.ClosestPoint(v, &p).DistanceTo(v)
+}
+
+// transforms this by Mat32, saves the axis aligned bounds of the transformed box
+func (this *AABB2T[T]) ApplyMat32(m *Mat32T[T]) *AABB2T[T] {
+
+	if this.IsEmpty() {
+		return this
+	}
+
+	min := [2]T{m[4], m[5]}
+	max := min
+
+	for j := 0; j < 2; j++ {
+		a, b := this.Min[j], this.Max[j]
+
+		for i := 0; i < 2; i++ {
+			e := m[j*2+i]
+			x, y := e*a, e*b
+
+			if x < y {
+				min[i] += x
+				max[i] += y
+			} else {
+				min[i] += y
+				max[i] += x
+			}
+		}
+	}
+
+	this.Min.Set(min[0], min[1])
+	this.Max.Set(max[0], max[1])
+
+	return this
+}
+
 // sets min and max from array of points
-func (this *AABB2) FromPoints(array []*Vec2) *AABB2 {
+func (this *AABB2T[T]) FromPoints(array []*Vec2T[T]) *AABB2T[T] {
 	l := len(array)
 
 	if l == 0 {
@@ -165,8 +313,38 @@ func (this *AABB2) FromPoints(array []*Vec2) *AABB2 {
 	return this
 }
 
+// sets this from contiguous array of points
+func (this *AABB2T[T]) FromSlice(array []Vec2T[T]) *AABB2T[T] {
+
+	if len(array) == 0 {
+		return this.Empty()
+	}
+
+	min, max := array[0], array[0]
+
+	for _, v := range array[1:] {
+		if min[0] > v[0] {
+			min[0] = v[0]
+		}
+		if min[1] > v[1] {
+			min[1] = v[1]
+		}
+		if max[0] < v[0] {
+			max[0] = v[0]
+		}
+		if max[1] < v[1] {
+			max[1] = v[1]
+		}
+	}
+
+	this.Min.Copy(&min)
+	this.Max.Copy(&max)
+
+	return this
+}
+
 // sets min and max from center and size
-func (this *AABB2) FromCenterSize(center, size *Vec2) *AABB2 {
+func (this *AABB2T[T]) FromCenterSize(center, size *Vec2T[T]) *AABB2T[T] {
 	hx := size[0] * 0.5
 	hy := size[1] * 0.5
 
@@ -179,17 +357,145 @@ func (this *AABB2) FromCenterSize(center, size *Vec2) *AABB2 {
 	return this
 }
 
-// checks if this equals other
-func (this *AABB2) Equals(other *AABB2) bool {
+// sets min and max from circle
+func (this *AABB2T[T]) FromCircle(circle *Circle) *AABB2T[T] {
+	x, y, r := T(circle.Center[0]), T(circle.Center[1]), T(circle.Radius)
 
-	this.Min.Equals(other.Min)
-	this.Max.Equals(other.Max)
+	this.Min.Set(x-r, y-r)
+	this.Max.Set(x+r, y+r)
 
-	return true
+	return this
+}
+
+// converts this to float32, saves in target
+func (this *AABB2T[T]) Float32(target *AABB2) *AABB2 {
+
+	this.Min.Float32(target.Min)
+	this.Max.Float32(target.Max)
+
+	return target
+}
+
+// converts this to float64, saves in target
+func (this *AABB2T[T]) Float64(target *AABB2d) *AABB2d {
+
+	this.Min.Float64(target.Min)
+	this.Max.Float64(target.Max)
+
+	return target
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *AABB2T[T]) Equals(other *AABB2T[T]) bool {
+
+	return this.Min.Equals(other.Min) && this.Max.Equals(other.Max)
+}
+
+// checks if this equals other within absolute tolerance tol
+func (this *AABB2T[T]) EqualsTol(other *AABB2T[T], tol T) bool {
+
+	return this.Min.EqualsTol(other.Min, tol) && this.Max.EqualsTol(other.Max, tol)
+}
+
+// checks if this equals other within tol relative to the largest magnitude of Min and Max
+func (this *AABB2T[T]) EqualsRel(other *AABB2T[T], tol T) bool {
+	a := [4]T{this.Min[0], this.Min[1], this.Max[0], this.Max[1]}
+	b := [4]T{other.Min[0], other.Min[1], other.Max[0], other.Max[1]}
+
+	return equalsRelSlice(a[:], b[:], tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *AABB2T[T]) EqualsULP(other *AABB2T[T], ulps int) bool {
+
+	return this.Min.EqualsULP(other.Min, ulps) && this.Max.EqualsULP(other.Max, ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *AABB2T[T]) MarshalText() ([]byte, error) {
+	e := this.elements()
+
+	return appendStructText(nil, aabb2Text, e[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *AABB2T[T]) UnmarshalText(text []byte) error {
+	var e [4]T
+
+	if err := parseStructText(text, aabb2Text, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns this as a JSON array of Min and Max, implements json.Marshaler
+func (this *AABB2T[T]) MarshalJSON() ([]byte, error) {
+	e := this.elements()
+
+	return appendJSON(nil, e[:])
+}
+
+// parses a JSON array of Min and Max into this, implements json.Unmarshaler
+func (this *AABB2T[T]) UnmarshalJSON(data []byte) error {
+	var e [4]T
+
+	if isJSONNull(data) {
+		return nil
+	}
+	if err := parseJSON(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Min and Max of this as little endian floats, implements encoding.BinaryMarshaler
+func (this *AABB2T[T]) MarshalBinary() ([]byte, error) {
+	e := this.elements()
+
+	return appendBinary(nil, e[:]), nil
+}
+
+// parses little endian floats of Min and Max into this, implements encoding.BinaryUnmarshaler
+func (this *AABB2T[T]) UnmarshalBinary(data []byte) error {
+	var e [4]T
+
+	if err := parseBinary(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Min and Max of this as marshaling elements, nil fields are zero
+func (this *AABB2T[T]) elements() [4]T {
+	lo, hi := valueOrZero(this.Min), valueOrZero(this.Max)
+
+	return [4]T{lo[0], lo[1], hi[0], hi[1]}
+}
+
+// sets Min and Max of this from marshaling elements, nil fields are allocated
+func (this *AABB2T[T]) setElements(e *[4]T) {
+	if this.Min == nil {
+		this.Min = new(Vec2T[T])
+	}
+	if this.Max == nil {
+		this.Max = new(Vec2T[T])
+	}
+
+	copy(this.Min[:], e[0:2])
+	copy(this.Max[:], e[2:4])
 }
 
 // returns this as string type
-func (this *AABB2) String() string {
+func (this *AABB2T[T]) String() string {
 
 	return fmt.Sprintf("AABB2[ Min: %s, Max: %s ]", this.Min, this.Max)
 }
@@ -0,0 +1,167 @@
+package mathf
+
+import (
+	"testing"
+)
+
+func TestAABB2Clone(t *testing.T) {
+	box := NewAABB2().Set(&Vec2{-1, -2}, &Vec2{4, 5})
+	clone := box.Clone()
+
+	if !clone.Equals(box) || clone.Min == box.Min || clone.Max == box.Max {
+		t.Errorf("%v.Clone() = %v", box, clone)
+	}
+}
+
+func TestAABB2Measures(t *testing.T) {
+	box := NewAABB2().FromCenterSize(&Vec2{1, 3}, &Vec2{4, 6})
+	empty := NewAABB2()
+
+	var center, size Vec2
+
+	if want := NewAABB2().Set(&Vec2{-1, 0}, &Vec2{3, 6}); !box.Equals(want) {
+		t.Errorf("FromCenterSize() = %v, want %v", box, want)
+	}
+	if box.Center(&center); !center.Equals(&Vec2{1, 3}) {
+		t.Errorf("%v.Center() = %v, want [1 3]", box, center)
+	}
+	if box.Size(&size); !size.Equals(&Vec2{4, 6}) {
+		t.Errorf("%v.Size() = %v, want [4 6]", box, size)
+	}
+	if a := box.Area(); a != 24 {
+		t.Errorf("%v.Area() = %v, want 24", box, a)
+	}
+	if p := box.Perimeter(); p != 20 {
+		t.Errorf("%v.Perimeter() = %v, want 20", box, p)
+	}
+	if empty.Size(&size); !size.Equals(&Vec2{}) || empty.Area() != 0 || empty.Perimeter() != 0 {
+		t.Errorf("empty Size(), Area(), Perimeter() = %v %v %v, want zero", size, empty.Area(), empty.Perimeter())
+	}
+
+	// bits 0 and 1 of the index select max x and y
+	corners := box.Corners()
+
+	for i, want := range []Vec2{{-1, 0}, {3, 0}, {-1, 6}, {3, 6}} {
+		if !corners[i].Equals(&want) {
+			t.Errorf("%v.Corners()[%d] = %v, want %v", box, i, corners[i], want)
+		}
+	}
+}
+
+func TestAABB2ClosestPoint(t *testing.T) {
+	box := NewAABB2().Set(&Vec2{-1, -1}, &Vec2{1, 2})
+
+	tests := []struct {
+		p, want  Vec2
+		distance float32
+	}{
+		{Vec2{0, 0}, Vec2{0, 0}, 0},
+		{Vec2{1, 2}, Vec2{1, 2}, 0},
+		{Vec2{5, 0}, Vec2{1, 0}, 4},
+		{Vec2{4, 6}, Vec2{1, 2}, 5},
+	}
+
+	for _, test := range tests {
+		var got Vec2
+
+		if box.ClosestPoint(&test.p, &got); !got.Equals(&test.want) {
+			t.Errorf("ClosestPoint(%v) = %v, want %v", test.p, got, test.want)
+		}
+		if d := box.DistanceToPoint(&test.p); !EqualsTol(d, test.distance, 1e-6) {
+			t.Errorf("DistanceToPoint(%v) = %v, want %v", test.p, d, test.distance)
+		}
+	}
+}
+
+func TestAABB2Intersection(t *testing.T) {
+	box := NewAABB2().Set(&Vec2{0, 0}, &Vec2{2, 2})
+
+	tests := []struct {
+		name  string
+		other *AABB2
+		want  *AABB2
+	}{
+		{"overlapping", NewAABB2().Set(&Vec2{1, -1}, &Vec2{3, 1}), NewAABB2().Set(&Vec2{1, 0}, &Vec2{2, 1})},
+		{"contained", NewAABB2().Set(&Vec2{0.5, 0.5}, &Vec2{1, 1}), NewAABB2().Set(&Vec2{0.5, 0.5}, &Vec2{1, 1})},
+		{"touching", NewAABB2().Set(&Vec2{2, 0}, &Vec2{3, 2}), NewAABB2().Set(&Vec2{2, 0}, &Vec2{2, 2})},
+		{"disjoint", NewAABB2().Set(&Vec2{3, 0}, &Vec2{4, 2}), NewAABB2()},
+	}
+
+	for _, test := range tests {
+		if got := box.Clone().Intersection(test.other); !got.Equals(test.want) {
+			t.Errorf("%s %v.Intersection(%v) = %v, want %v", test.name, box, test.other, got, test.want)
+		}
+		if got, want := box.Intersects(test.other), !test.want.IsEmpty(); got != want {
+			t.Errorf("%s %v.Intersects(%v) = %v, want %v", test.name, box, test.other, got, want)
+		}
+	}
+}
+
+func TestAABB2ApplyMat32(t *testing.T) {
+	box := NewAABB2().Set(&Vec2{-1, -2}, &Vec2{1, 2})
+
+	// 90 degrees swaps the x and y extents before the translation
+	m := NewMat32().Compose(&Vec2{10, -1}, &Vec2{1, 1}, HALF_PI)
+	want := NewAABB2().Set(&Vec2{8, -2}, &Vec2{12, 0})
+
+	if got := box.Clone().ApplyMat32(m); !got.EqualsTol(want, 1e-6) {
+		t.Errorf("ApplyMat32() = %v, want %v", got, want)
+	}
+
+	// any rotation, scale and translation gives the bounds of the transformed corners
+	m.Compose(&Vec2{3, -4}, &Vec2{2, 0.5}, 0.7)
+	corners := box.Corners()
+
+	for _, c := range corners {
+		c.ApplyMat32(m)
+	}
+
+	want.FromPoints(corners)
+
+	if got := box.Clone().ApplyMat32(m); !got.EqualsTol(want, 1e-5) {
+		t.Errorf("ApplyMat32() = %v, want corner bounds %v", got, want)
+	}
+	if got := NewAABB2().ApplyMat32(m); !got.IsEmpty() {
+		t.Errorf("empty ApplyMat32() = %v, want empty", got)
+	}
+}
+
+func TestAABB2Equals(t *testing.T) {
+	box := NewAABB2().Set(&Vec2{-1, -2}, &Vec2{4, 5})
+
+	if !box.Equals(box.Clone()) {
+		t.Errorf("%v.Equals(itself) = false", box)
+	}
+
+	// a change in any of the four elements must be seen
+	for i := 0; i < 4; i++ {
+		other := box.Clone()
+
+		if i < 2 {
+			other.Min[i] += 0.5
+		} else {
+			other.Max[i-2] += 0.5
+		}
+
+		if box.Equals(other) || other.Equals(box) {
+			t.Errorf("%v.Equals(%v) = true, want false", box, other)
+		}
+	}
+}
+
+func TestAABB2EmptyFloat64(t *testing.T) {
+	// float64 points beyond the float32 range must still replace the empty bounds
+	p := Vec2d{1e300, -5e38}
+
+	for _, box := range []*AABB2d{NewAABB2d(), NewAABB2d().Set(&Vec2d{}, &Vec2d{}).Empty()} {
+		if !box.IsEmpty() {
+			t.Errorf("empty box = %v, want empty", box)
+		}
+		if box.ExpandPoint(&p); *box.Min != p || *box.Max != p {
+			t.Errorf("ExpandPoint(%v) = %v, want the point", p, box)
+		}
+	}
+	if got := NewAABB2(); got.Min[0] != Inf || got.Max[0] != -Inf {
+		t.Errorf("NewAABB2() = %v, want Inf and -Inf", got)
+	}
+}
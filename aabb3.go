@@ -2,29 +2,49 @@ package mathf
 
 import "fmt"
 
-// float32 Array representing an 3D axis aligned bounding box
-type AABB3 struct {
-	Min, Max *Vec3
+// 3D axis aligned bounding box of float32 or float64
+type AABB3T[T Float] struct {
+	Min, Max *Vec3T[T]
 }
 
-// returns new AABB3
+// float32 AABB3
+type AABB3 = AABB3T[float32]
+
+// float64 AABB3
+type AABB3d = AABB3T[float64]
+
+// returns new AABB3 with copies of min and max, call Empty for an empty box
 func NewAABB3(min, max *Vec3) *AABB3 {
 	this := new(AABB3)
 
-	this.Min = NewVec3(Inf, Inf, Inf)
-	this.Max = NewVec3(-Inf, -Inf, -Inf)
+	this.Min = new(Vec3).Copy(min)
+	this.Max = new(Vec3).Copy(max)
+
+	return this
+}
+
+// returns new AABB3d with copies of min and max, call Empty for an empty box
+func NewAABB3d(min, max *Vec3d) *AABB3d {
+	this := new(AABB3d)
+
+	this.Min = new(Vec3d).Copy(min)
+	this.Max = new(Vec3d).Copy(max)
 
 	return this
 }
 
 // returns a copy of this
-func (this *AABB3) Clone() *AABB3 {
+func (this *AABB3T[T]) Clone() *AABB3T[T] {
+	other := new(AABB3T[T])
+
+	other.Min = new(Vec3T[T]).Copy(this.Min)
+	other.Max = new(Vec3T[T]).Copy(this.Max)
 
-	return new(AABB3).Copy(this)
+	return other
 }
 
 // copies other
-func (this *AABB3) Copy(other *AABB3) *AABB3 {
+func (this *AABB3T[T]) Copy(other *AABB3T[T]) *AABB3T[T] {
 
 	this.Min.Copy(other.Min)
 	this.Max.Copy(other.Max)
@@ -33,7 +53,7 @@ func (this *AABB3) Copy(other *AABB3) *AABB3 {
 }
 
 // sets this from values
-func (this *AABB3) Set(min, max *Vec3) *AABB3 {
+func (this *AABB3T[T]) Set(min, max *Vec3T[T]) *AABB3T[T] {
 
 	this.Min.Copy(min)
 	this.Max.Copy(max)
@@ -42,7 +62,7 @@ func (this *AABB3) Set(min, max *Vec3) *AABB3 {
 }
 
 // expands this by point
-func (this *AABB3) ExpandPoint(v *Vec3) *AABB3 {
+func (this *AABB3T[T]) ExpandPoint(v *Vec3T[T]) *AABB3T[T] {
 
 	this.Min.Min(v)
 	this.Max.Max(v)
@@ -51,7 +71,7 @@ func (this *AABB3) ExpandPoint(v *Vec3) *AABB3 {
 }
 
 // expands this by vector
-func (this *AABB3) ExpandVec(v *Vec3) *AABB3 {
+func (this *AABB3T[T]) ExpandVec(v *Vec3T[T]) *AABB3T[T] {
 
 	this.Min.Sub(v)
 	this.Max.Add(v)
@@ -60,7 +80,7 @@ func (this *AABB3) ExpandVec(v *Vec3) *AABB3 {
 }
 
 // expands this by scalar
-func (this *AABB3) ExpandScalar(s float32) *AABB3 {
+func (this *AABB3T[T]) ExpandScalar(s T) *AABB3T[T] {
 
 	this.Min.SSub(s)
 	this.Max.SAdd(s)
@@ -69,7 +89,7 @@ func (this *AABB3) ExpandScalar(s float32) *AABB3 {
 }
 
 // merges this and other
-func (this *AABB3) Union(other *AABB3) *AABB3 {
+func (this *AABB3T[T]) Union(other *AABB3T[T]) *AABB3T[T] {
 
 	this.Min.Min(other.Min)
 	this.Max.Max(other.Max)
@@ -78,16 +98,92 @@ func (this *AABB3) Union(other *AABB3) *AABB3 {
 }
 
 // returns this as empty
-func (this *AABB3) Empty() *AABB3 {
+func (this *AABB3T[T]) Empty() *AABB3T[T] {
+	m := inf[T]()
 
-	this.Min.Set(Inf, Inf, Inf)
-	this.Max.Set(-Inf, -Inf, -Inf)
+	this.Min.Set(m, m, m)
+	this.Max.Set(-m, -m, -m)
 
 	return this
 }
 
+// checks if this is empty
+func (this *AABB3T[T]) IsEmpty() bool {
+
+	return this.Max[0] < this.Min[0] || this.Max[1] < this.Min[1] || this.Max[2] < this.Min[2]
+}
+
+// returns center of this, saves in target
+func (this *AABB3T[T]) Center(target *Vec3T[T]) *Vec3T[T] {
+
+	return target.VAdd(this.Min, this.Max).SMul(0.5)
+}
+
+// returns size of this, saves in target
+func (this *AABB3T[T]) Size(target *Vec3T[T]) *Vec3T[T] {
+
+	if this.IsEmpty() {
+		return target.Set(0, 0, 0)
+	}
+
+	return target.VSub(this.Max, this.Min)
+}
+
+// returns volume of this
+func (this *AABB3T[T]) Volume() T {
+
+	if this.IsEmpty() {
+		return 0
+	}
+
+	return (this.Max[0] - this.Min[0]) * (this.Max[1] - this.Min[1]) * (this.Max[2] - this.Min[2])
+}
+
+// returns surface area of this
+func (this *AABB3T[T]) SurfaceArea() T {
+
+	if this.IsEmpty() {
+		return 0
+	}
+
+	x := this.Max[0] - this.Min[0]
+	y := this.Max[1] - this.Min[1]
+	z := this.Max[2] - this.Min[2]
+
+	return 2 * (x*y + y*z + z*x)
+}
+
+// returns corner i from 0 to 7, bits 0, 1 and 2 of i select max x, y and z, saves in target
+func (this *AABB3T[T]) Corner(i int, target *Vec3T[T]) *Vec3T[T] {
+
+	target.Copy(this.Min)
+
+	if i&1 != 0 {
+		target[0] = this.Max[0]
+	}
+	if i&2 != 0 {
+		target[1] = this.Max[1]
+	}
+	if i&4 != 0 {
+		target[2] = this.Max[2]
+	}
+
+	return target
+}
+
+// returns array of the eight corners of this
+func (this *AABB3T[T]) Corners() []*Vec3T[T] {
+	array := make([]*Vec3T[T], 8)
+
+	for i := range array {
+		array[i] = this.Corner(i, new(Vec3T[T]))
+	}
+
+	return array
+}
+
 // checks if AABB contains point
-func (this *AABB3) Contains(v *Vec3) bool {
+func (this *AABB3T[T]) Contains(v *Vec3T[T]) bool {
 
 	if v[0] < this.Min[0] {
 		return false
@@ -113,7 +209,7 @@ func (this *AABB3) Contains(v *Vec3) bool {
 }
 
 // checks if this and other intersect
-func (this *AABB3) Intersects(other *AABB3) bool {
+func (this *AABB3T[T]) Intersects(other *AABB3T[T]) bool {
 
 	if other.Max[0] < this.Min[0] {
 		return false
@@ -138,8 +234,67 @@ func (this *AABB3) Intersects(other *AABB3) bool {
 	return true
 }
 
+// sets this to the overlap of this and other, empty if they do not intersect
+func (this *AABB3T[T]) Intersection(other *AABB3T[T]) *AABB3T[T] {
+
+	this.Min.Max(other.Min)
+	this.Max.Min(other.Max)
+
+	if this.IsEmpty() {
+		return this.Empty()
+	}
+
+	return this
+}
+
+// returns closest point in this to point, saves in target
+func (this *AABB3T[T]) ClosestPoint(v, target *Vec3T[T]) *Vec3T[T] {
+
+	return target.Copy(v).Clamp(this.Min, this.Max)
+}
+
+// returns distance from this to point, zero if point is inside
+func (this *AABB3T[T]) DistanceToPoint(v *Vec3T[T]) T {
+	var p Vec3T[T]
+
+	return this.ClosestPoint(v, &p).DistanceTo(v)
+}
+
+// transforms this by Mat4, saves the axis aligned bounds of the transformed box
+func (this *AABB3T[T]) ApplyMat4(m *Mat4T[T]) *AABB3T[T] {
+
+	if this.IsEmpty() {
+		return this
+	}
+
+	min := [3]T{m[12], m[13], m[14]}
+	max := min
+
+	for j := 0; j < 3; j++ {
+		a, b := this.Min[j], this.Max[j]
+
+		for i := 0; i < 3; i++ {
+			e := m[j*4+i]
+			x, y := e*a, e*b
+
+			if x < y {
+				min[i] += x
+				max[i] += y
+			} else {
+				min[i] += y
+				max[i] += x
+			}
+		}
+	}
+
+	this.Min.Set(min[0], min[1], min[2])
+	this.Max.Set(max[0], max[1], max[2])
+
+	return this
+}
+
 // sets min and max from array of points
-func (this *AABB3) FromPoints(array []*Vec3) *AABB3 {
+func (this *AABB3T[T]) FromPoints(array []*Vec3T[T]) *AABB3T[T] {
 	l := len(array)
 
 	if l == 0 {
@@ -187,31 +342,199 @@ func (this *AABB3) FromPoints(array []*Vec3) *AABB3 {
 	return this
 }
 
+// sets this from contiguous array of points
+func (this *AABB3T[T]) FromSlice(array []Vec3T[T]) *AABB3T[T] {
+
+	if len(array) == 0 {
+		return this.Empty()
+	}
+
+	min, max := array[0], array[0]
+
+	for _, v := range array[1:] {
+		if min[0] > v[0] {
+			min[0] = v[0]
+		}
+		if min[1] > v[1] {
+			min[1] = v[1]
+		}
+		if min[2] > v[2] {
+			min[2] = v[2]
+		}
+		if max[0] < v[0] {
+			max[0] = v[0]
+		}
+		if max[1] < v[1] {
+			max[1] = v[1]
+		}
+		if max[2] < v[2] {
+			max[2] = v[2]
+		}
+	}
+
+	this.Min.Copy(&min)
+	this.Max.Copy(&max)
+
+	return this
+}
+
 // sets min and max from center and size
-func (this *AABB3) FromCenterSize(center, size *Vec3) *AABB3 {
+func (this *AABB3T[T]) FromCenterSize(center, size *Vec3T[T]) *AABB3T[T] {
 	hx := size[0] * 0.5
 	hy := size[1] * 0.5
+	hz := size[2] * 0.5
 
 	this.Min[0] = center[0] - hx
 	this.Min[1] = center[1] - hy
+	this.Min[2] = center[2] - hz
 
 	this.Max[0] = center[0] + hx
 	this.Max[1] = center[1] + hy
+	this.Max[2] = center[2] + hz
 
 	return this
 }
 
-// checks if this equals other
-func (this *AABB3) Equals(other *AABB3) bool {
+// sets min and max from sphere
+func (this *AABB3T[T]) FromSphere(sphere *Sphere) *AABB3T[T] {
+	c, r := sphere.Center, T(sphere.Radius)
+	x, y, z := T(c[0]), T(c[1]), T(c[2])
 
-	this.Min.Equals(other.Min)
-	this.Max.Equals(other.Max)
+	this.Min.Set(x-r, y-r, z-r)
+	this.Max.Set(x+r, y+r, z+r)
 
-	return true
+	return this
+}
+
+// converts this to float32, saves in target
+func (this *AABB3T[T]) Float32(target *AABB3) *AABB3 {
+
+	this.Min.Float32(target.Min)
+	this.Max.Float32(target.Max)
+
+	return target
+}
+
+// converts this to float64, saves in target
+func (this *AABB3T[T]) Float64(target *AABB3d) *AABB3d {
+
+	this.Min.Float64(target.Min)
+	this.Max.Float64(target.Max)
+
+	return target
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *AABB3T[T]) Equals(other *AABB3T[T]) bool {
+
+	return this.Min.Equals(other.Min) && this.Max.Equals(other.Max)
+}
+
+// checks if this equals other within absolute tolerance tol
+func (this *AABB3T[T]) EqualsTol(other *AABB3T[T], tol T) bool {
+
+	return this.Min.EqualsTol(other.Min, tol) && this.Max.EqualsTol(other.Max, tol)
+}
+
+// checks if this equals other within tol relative to the largest magnitude of Min and Max
+func (this *AABB3T[T]) EqualsRel(other *AABB3T[T], tol T) bool {
+	a := [6]T{this.Min[0], this.Min[1], this.Min[2], this.Max[0], this.Max[1], this.Max[2]}
+	b := [6]T{other.Min[0], other.Min[1], other.Min[2], other.Max[0], other.Max[1], other.Max[2]}
+
+	return equalsRelSlice(a[:], b[:], tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *AABB3T[T]) EqualsULP(other *AABB3T[T], ulps int) bool {
+
+	return this.Min.EqualsULP(other.Min, ulps) && this.Max.EqualsULP(other.Max, ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *AABB3T[T]) MarshalText() ([]byte, error) {
+	e := this.elements()
+
+	return appendStructText(nil, aabb3Text, e[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *AABB3T[T]) UnmarshalText(text []byte) error {
+	var e [6]T
+
+	if err := parseStructText(text, aabb3Text, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns this as a JSON array of Min and Max, implements json.Marshaler
+func (this *AABB3T[T]) MarshalJSON() ([]byte, error) {
+	e := this.elements()
+
+	return appendJSON(nil, e[:])
+}
+
+// parses a JSON array of Min and Max into this, implements json.Unmarshaler
+func (this *AABB3T[T]) UnmarshalJSON(data []byte) error {
+	var e [6]T
+
+	if isJSONNull(data) {
+		return nil
+	}
+	if err := parseJSON(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Min and Max of this as little endian floats, implements encoding.BinaryMarshaler
+func (this *AABB3T[T]) MarshalBinary() ([]byte, error) {
+	e := this.elements()
+
+	return appendBinary(nil, e[:]), nil
+}
+
+// parses little endian floats of Min and Max into this, implements encoding.BinaryUnmarshaler
+func (this *AABB3T[T]) UnmarshalBinary(data []byte) error {
+	var e [6]T
+
+	if err := parseBinary(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Min and Max of this as marshaling elements, nil fields are zero
+func (this *AABB3T[T]) elements() [6]T {
+	lo, hi := valueOrZero(this.Min), valueOrZero(this.Max)
+
+	return [6]T{lo[0], lo[1], lo[2], hi[0], hi[1], hi[2]}
+}
+
+// sets Min and Max of this from marshaling elements, nil fields are allocated
+func (this *AABB3T[T]) setElements(e *[6]T) {
+	if this.Min == nil {
+		this.Min = new(Vec3T[T])
+	}
+	if this.Max == nil {
+		this.Max = new(Vec3T[T])
+	}
+
+	copy(this.Min[:], e[0:3])
+	copy(this.Max[:], e[3:6])
 }
 
 // returns this as string type
-func (this *AABB3) String() string {
+func (this *AABB3T[T]) String() string {
 
 	return fmt.Sprintf("AABB3[ Min: %s, Max: %s ]", this.Min, this.Max)
 }
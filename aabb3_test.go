@@ -0,0 +1,205 @@
+package mathf
+
+import (
+	"testing"
+)
+
+func TestNewAABB3(t *testing.T) {
+	min, max := Vec3{-1, -2, -3}, Vec3{4, 5, 6}
+	box := NewAABB3(&min, &max)
+
+	if *box.Min != min || *box.Max != max {
+		t.Errorf("NewAABB3(%v, %v) = %v", min, max, box)
+	}
+
+	min[0], max[0] = 10, 20
+
+	if box.Min[0] != -1 || box.Max[0] != 4 {
+		t.Errorf("NewAABB3 shares its arguments, got %v after changing them", box)
+	}
+
+	mind, maxd := Vec3d{-1, -2, -3}, Vec3d{4, 5, 6}
+
+	if boxd := NewAABB3d(&mind, &maxd); *boxd.Min != mind || *boxd.Max != maxd || boxd.Min == &mind {
+		t.Errorf("NewAABB3d(%v, %v) = %v", mind, maxd, boxd)
+	}
+}
+
+func TestAABB3Clone(t *testing.T) {
+	box := NewAABB3(&Vec3{-1, -2, -3}, &Vec3{4, 5, 6})
+	clone := box.Clone()
+
+	if !clone.Equals(box) || clone.Min == box.Min || clone.Max == box.Max {
+		t.Errorf("%v.Clone() = %v", box, clone)
+	}
+}
+
+func TestAABB3FromCenterSize(t *testing.T) {
+	want := NewAABB3(&Vec3{-1, 0, 1}, &Vec3{3, 6, 9})
+
+	if got := NewAABB3(&Vec3{}, &Vec3{}).FromCenterSize(&Vec3{1, 3, 5}, &Vec3{4, 6, 8}); !got.Equals(want) {
+		t.Errorf("FromCenterSize() = %v, want %v", got, want)
+	}
+}
+
+func TestAABB3Measures(t *testing.T) {
+	box := NewAABB3(&Vec3{-1, 0, 1}, &Vec3{3, 6, 2})
+	empty := NewAABB3(&Vec3{}, &Vec3{}).Empty()
+
+	var center, size Vec3
+
+	if box.Center(&center); !center.Equals(&Vec3{1, 3, 1.5}) {
+		t.Errorf("%v.Center() = %v, want [1 3 1.5]", box, center)
+	}
+	if box.Size(&size); !size.Equals(&Vec3{4, 6, 1}) {
+		t.Errorf("%v.Size() = %v, want [4 6 1]", box, size)
+	}
+	if v := box.Volume(); v != 24 {
+		t.Errorf("%v.Volume() = %v, want 24", box, v)
+	}
+	if a := box.SurfaceArea(); a != 68 {
+		t.Errorf("%v.SurfaceArea() = %v, want 68", box, a)
+	}
+	if empty.Size(&size); !size.Equals(&Vec3{}) || empty.Volume() != 0 || empty.SurfaceArea() != 0 {
+		t.Errorf("empty Size(), Volume(), SurfaceArea() = %v %v %v, want zero", size, empty.Volume(), empty.SurfaceArea())
+	}
+
+	// bits 0, 1 and 2 of the index select max x, y and z
+	corners := box.Corners()
+
+	for i, want := range []Vec3{{-1, 0, 1}, {3, 0, 1}, {-1, 6, 1}, {3, 6, 1}, {-1, 0, 2}, {3, 0, 2}, {-1, 6, 2}, {3, 6, 2}} {
+		if !corners[i].Equals(&want) {
+			t.Errorf("%v.Corners()[%d] = %v, want %v", box, i, corners[i], want)
+		}
+	}
+}
+
+func TestAABB3ClosestPoint(t *testing.T) {
+	box := NewAABB3(&Vec3{-1, -1, -1}, &Vec3{1, 2, 3})
+
+	tests := []struct {
+		p, want  Vec3
+		distance float32
+	}{
+		{Vec3{0, 0, 0}, Vec3{0, 0, 0}, 0},
+		{Vec3{1, 2, 3}, Vec3{1, 2, 3}, 0},
+		{Vec3{5, 0, 0}, Vec3{1, 0, 0}, 4},
+		{Vec3{0, -4, 7}, Vec3{0, -1, 3}, 5},
+		{Vec3{3, 4, 4}, Vec3{1, 2, 3}, 3},
+	}
+
+	for _, test := range tests {
+		var got Vec3
+
+		if box.ClosestPoint(&test.p, &got); !got.Equals(&test.want) {
+			t.Errorf("ClosestPoint(%v) = %v, want %v", test.p, got, test.want)
+		}
+		if d := box.DistanceToPoint(&test.p); !EqualsTol(d, test.distance, 1e-6) {
+			t.Errorf("DistanceToPoint(%v) = %v, want %v", test.p, d, test.distance)
+		}
+	}
+}
+
+func TestAABB3Intersection(t *testing.T) {
+	box := NewAABB3(&Vec3{0, 0, 0}, &Vec3{2, 2, 2})
+
+	tests := []struct {
+		name  string
+		other *AABB3
+		want  *AABB3
+	}{
+		{"overlapping", NewAABB3(&Vec3{1, -1, 1}, &Vec3{3, 1, 4}), NewAABB3(&Vec3{1, 0, 1}, &Vec3{2, 1, 2})},
+		{"contained", NewAABB3(&Vec3{0.5, 0.5, 0.5}, &Vec3{1, 1, 1}), NewAABB3(&Vec3{0.5, 0.5, 0.5}, &Vec3{1, 1, 1})},
+		{"touching", NewAABB3(&Vec3{2, 0, 0}, &Vec3{3, 2, 2}), NewAABB3(&Vec3{2, 0, 0}, &Vec3{2, 2, 2})},
+		{"disjoint", NewAABB3(&Vec3{3, 0, 0}, &Vec3{4, 2, 2}), NewAABB3(&Vec3{}, &Vec3{}).Empty()},
+	}
+
+	for _, test := range tests {
+		if got := box.Clone().Intersection(test.other); !got.Equals(test.want) {
+			t.Errorf("%s %v.Intersection(%v) = %v, want %v", test.name, box, test.other, got, test.want)
+		}
+		if got, want := box.Intersects(test.other), !test.want.IsEmpty(); got != want {
+			t.Errorf("%s %v.Intersects(%v) = %v, want %v", test.name, box, test.other, got, want)
+		}
+	}
+}
+
+func TestAABB3ApplyMat4(t *testing.T) {
+	box := NewAABB3(&Vec3{-1, -2, 0}, &Vec3{1, 2, 1})
+
+	// 90 degrees about z swaps the x and y extents before the translation
+	m := NewMat4().Compose(&Vec3{10, 0, -1}, &Vec3{1, 1, 1}, NewQuat().FromAxisAngle(&Vec3{0, 0, 1}, HALF_PI))
+	want := NewAABB3(&Vec3{8, -1, -1}, &Vec3{12, 1, 0})
+
+	if got := box.Clone().ApplyMat4(m); !got.EqualsTol(want, 1e-6) {
+		t.Errorf("ApplyMat4() = %v, want %v", got, want)
+	}
+
+	// any rotation, scale and translation gives the bounds of the transformed corners
+	m.Compose(&Vec3{3, -4, 5}, &Vec3{2, 0.5, 1.5}, NewQuat().FromAxisAngle(NewVec3(1, 2, 3).Normalize(), 0.7))
+	corners := box.Corners()
+
+	for _, c := range corners {
+		c.ApplyMat4(m)
+	}
+
+	want.FromPoints(corners)
+
+	if got := box.Clone().ApplyMat4(m); !got.EqualsTol(want, 1e-5) {
+		t.Errorf("ApplyMat4() = %v, want corner bounds %v", got, want)
+	}
+	if got := NewAABB3(&Vec3{}, &Vec3{}).Empty().ApplyMat4(m); !got.IsEmpty() {
+		t.Errorf("empty ApplyMat4() = %v, want empty", got)
+	}
+}
+
+func TestAABB3Equals(t *testing.T) {
+	box := NewAABB3(&Vec3{-1, -2, -3}, &Vec3{4, 5, 6})
+
+	if !box.Equals(box.Clone()) {
+		t.Errorf("%v.Equals(itself) = false", box)
+	}
+
+	// a change in any of the six elements must be seen
+	for i := 0; i < 6; i++ {
+		other := box.Clone()
+
+		if i < 3 {
+			other.Min[i] += 0.5
+		} else {
+			other.Max[i-3] += 0.5
+		}
+
+		if box.Equals(other) || other.Equals(box) {
+			t.Errorf("%v.Equals(%v) = true, want false", box, other)
+		}
+	}
+}
+
+func TestNewAABB3Empty(t *testing.T) {
+	// the baseline NewAABB3 ignored its arguments and returned an empty box, Empty still gives one
+	box := NewAABB3(&Vec3{1, 2, 3}, &Vec3{4, 5, 6})
+
+	if box.IsEmpty() {
+		t.Errorf("NewAABB3() = %v, want the given bounds", box)
+	}
+	if box.Empty(); !box.IsEmpty() {
+		t.Errorf("Empty() = %v, want empty", box)
+	}
+}
+
+func TestAABB3EmptyFloat64(t *testing.T) {
+	// float64 points beyond the float32 range must still replace the empty bounds
+	p := Vec3d{1e300, -1e300, 5e38}
+	box := NewAABB3d(&Vec3d{}, &Vec3d{}).Empty()
+
+	if !box.IsEmpty() {
+		t.Errorf("Empty() = %v, want empty", box)
+	}
+	if box.ExpandPoint(&p); *box.Min != p || *box.Max != p {
+		t.Errorf("Empty().ExpandPoint(%v) = %v, want the point", p, box)
+	}
+	if got := NewAABB3(&Vec3{}, &Vec3{}).Empty(); got.Min[0] != Inf || got.Max[0] != -Inf {
+		t.Errorf("float32 Empty() = %v, want Inf and -Inf", got)
+	}
+}
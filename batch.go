@@ -0,0 +1,338 @@
+package mathf
+
+import (
+	"math"
+	"unsafe"
+)
+
+// transforms src as points by Mat4, saves in dst and returns it, dst must be at least as long as src and may be src
+func TransformVec3s[T Float](dst, src []Vec3T[T], m *Mat4T[T]) []Vec3T[T] {
+	m11, m12, m13, m14 := m[0], m[4], m[8], m[12]
+	m21, m22, m23, m24 := m[1], m[5], m[9], m[13]
+	m31, m32, m33, m34 := m[2], m[6], m[10], m[14]
+	dst = dst[:len(src)]
+
+	for i, v := range src {
+		x, y, z := v[0], v[1], v[2]
+
+		dst[i] = Vec3T[T]{
+			m11*x + m12*y + m13*z + m14,
+			m21*x + m22*y + m23*z + m24,
+			m31*x + m32*y + m33*z + m34,
+		}
+	}
+
+	return dst
+}
+
+// transforms src as directions by Mat4, saves in dst and returns it, dst must be at least as long as src and may be src
+func TransformVec3Directions[T Float](dst, src []Vec3T[T], m *Mat4T[T]) []Vec3T[T] {
+	m11, m12, m13 := m[0], m[4], m[8]
+	m21, m22, m23 := m[1], m[5], m[9]
+	m31, m32, m33 := m[2], m[6], m[10]
+	dst = dst[:len(src)]
+
+	for i, v := range src {
+		x, y, z := v[0], v[1], v[2]
+
+		dst[i] = Vec3T[T]{
+			m11*x + m12*y + m13*z,
+			m21*x + m22*y + m23*z,
+			m31*x + m32*y + m33*z,
+		}
+	}
+
+	return dst
+}
+
+// transforms src by Mat4, saves in dst and returns it, dst must be at least as long as src and may be src
+func TransformVec4s[T Float](dst, src []Vec4T[T], m *Mat4T[T]) []Vec4T[T] {
+	dst = dst[:len(src)]
+
+	if useSIMD && len(src) > 0 {
+		if m32, ok := any(m).(*Mat4); ok {
+			transformVec4sSIMD((*Vec4)(unsafe.Pointer(&dst[0])), (*Vec4)(unsafe.Pointer(&src[0])), len(src), m32)
+			return dst
+		}
+	}
+
+	for i, v := range src {
+		x, y, z, w := v[0], v[1], v[2], v[3]
+
+		dst[i] = Vec4T[T]{
+			m[0]*x + m[4]*y + m[8]*z + m[12]*w,
+			m[1]*x + m[5]*y + m[9]*z + m[13]*w,
+			m[2]*x + m[6]*y + m[10]*z + m[14]*w,
+			m[3]*x + m[7]*y + m[11]*z + m[15]*w,
+		}
+	}
+
+	return dst
+}
+
+// normalizes src, saves in dst and returns it, zero vectors stay zero, dst must be at least as long as src and may be src
+func NormalizeVec3s[T Float](dst, src []Vec3T[T]) []Vec3T[T] {
+	dst = dst[:len(src)]
+
+	for i, v := range src {
+		l := v[0]*v[0] + v[1]*v[1] + v[2]*v[2]
+
+		if l == 0 {
+			dst[i] = v
+			continue
+		}
+
+		l = 1 / T(math.Sqrt(float64(l)))
+		dst[i] = Vec3T[T]{v[0] * l, v[1] * l, v[2] * l}
+	}
+
+	return dst
+}
+
+// adds a and b, saves in dst and returns it, dst must be at least as long as a and b must be as long as a
+func AddVec3s[T Float](dst, a, b []Vec3T[T]) []Vec3T[T] {
+	dst = dst[:len(a)]
+	b = b[:len(a)]
+
+	for i, v := range a {
+		dst[i] = Vec3T[T]{v[0] + b[i][0], v[1] + b[i][1], v[2] + b[i][2]}
+	}
+
+	return dst
+}
+
+// adds b scaled by s to a, saves in dst and returns it, dst must be at least as long as a and b must be as long as a
+func AddScaledVec3s[T Float](dst, a, b []Vec3T[T], s T) []Vec3T[T] {
+	dst = dst[:len(a)]
+	b = b[:len(a)]
+
+	for i, v := range a {
+		dst[i] = Vec3T[T]{v[0] + b[i][0]*s, v[1] + b[i][1]*s, v[2] + b[i][2]*s}
+	}
+
+	return dst
+}
+
+// scales src by s, saves in dst and returns it, dst must be at least as long as src and may be src
+func ScaleVec3s[T Float](dst, src []Vec3T[T], s T) []Vec3T[T] {
+	dst = dst[:len(src)]
+
+	for i, v := range src {
+		dst[i] = Vec3T[T]{v[0] * s, v[1] * s, v[2] * s}
+	}
+
+	return dst
+}
+
+// saves the dot products of a and b in dst and returns it, dst must be at least as long as a and b must be as long as a
+func DotVec3s[T Float](dst []T, a, b []Vec3T[T]) []T {
+	dst = dst[:len(a)]
+	b = b[:len(a)]
+
+	for i, v := range a {
+		dst[i] = v[0]*b[i][0] + v[1]*b[i][1] + v[2]*b[i][2]
+	}
+
+	return dst
+}
+
+// structure of arrays of 3D vectors, X, Y and Z must have the same length
+type Vec3SoA[T Float] struct {
+	X, Y, Z []T
+}
+
+// returns new Vec3SoA of length n
+func NewVec3SoA[T Float](n int) *Vec3SoA[T] {
+	this := new(Vec3SoA[T])
+
+	this.X = make([]T, n)
+	this.Y = make([]T, n)
+	this.Z = make([]T, n)
+
+	return this
+}
+
+// returns number of vectors in this
+func (this *Vec3SoA[T]) Len() int {
+
+	return len(this.X)
+}
+
+// returns vector i of this, saves in target
+func (this *Vec3SoA[T]) Get(i int, target *Vec3T[T]) *Vec3T[T] {
+
+	return target.Set(this.X[i], this.Y[i], this.Z[i])
+}
+
+// sets vector i of this
+func (this *Vec3SoA[T]) Set(i int, v *Vec3T[T]) *Vec3SoA[T] {
+
+	this.X[i], this.Y[i], this.Z[i] = v[0], v[1], v[2]
+
+	return this
+}
+
+// sets this from array of vectors, reuses memory when large enough
+func (this *Vec3SoA[T]) FromSlice(array []Vec3T[T]) *Vec3SoA[T] {
+	l := len(array)
+
+	if cap(this.X) < l || cap(this.Y) < l || cap(this.Z) < l {
+		this.X, this.Y, this.Z = make([]T, l), make([]T, l), make([]T, l)
+	}
+	this.X, this.Y, this.Z = this.X[:l], this.Y[:l], this.Z[:l]
+
+	for i, v := range array {
+		this.X[i], this.Y[i], this.Z[i] = v[0], v[1], v[2]
+	}
+
+	return this
+}
+
+// copies this into dst and returns it, dst must be at least as long as this
+func (this *Vec3SoA[T]) ToSlice(dst []Vec3T[T]) []Vec3T[T] {
+	dst = dst[:len(this.X)]
+	y, z := this.Y[:len(this.X)], this.Z[:len(this.X)]
+
+	for i, x := range this.X {
+		dst[i] = Vec3T[T]{x, y[i], z[i]}
+	}
+
+	return dst
+}
+
+// transforms this as points by Mat4
+func (this *Vec3SoA[T]) ApplyMat4(m *Mat4T[T]) *Vec3SoA[T] {
+	xs := this.X
+	ys, zs := this.Y[:len(xs)], this.Z[:len(xs)]
+
+	for i := range xs {
+		x, y, z := xs[i], ys[i], zs[i]
+
+		xs[i] = m[0]*x + m[4]*y + m[8]*z + m[12]
+		ys[i] = m[1]*x + m[5]*y + m[9]*z + m[13]
+		zs[i] = m[2]*x + m[6]*y + m[10]*z + m[14]
+	}
+
+	return this
+}
+
+// transforms this as directions by Mat4
+func (this *Vec3SoA[T]) ApplyMat4Direction(m *Mat4T[T]) *Vec3SoA[T] {
+	xs := this.X
+	ys, zs := this.Y[:len(xs)], this.Z[:len(xs)]
+
+	for i := range xs {
+		x, y, z := xs[i], ys[i], zs[i]
+
+		xs[i] = m[0]*x + m[4]*y + m[8]*z
+		ys[i] = m[1]*x + m[5]*y + m[9]*z
+		zs[i] = m[2]*x + m[6]*y + m[10]*z
+	}
+
+	return this
+}
+
+// normalizes each vector of this, zero vectors stay zero
+func (this *Vec3SoA[T]) Normalize() *Vec3SoA[T] {
+	xs := this.X
+	ys, zs := this.Y[:len(xs)], this.Z[:len(xs)]
+
+	for i := range xs {
+		l := xs[i]*xs[i] + ys[i]*ys[i] + zs[i]*zs[i]
+
+		if l == 0 {
+			continue
+		}
+
+		l = 1 / T(math.Sqrt(float64(l)))
+		xs[i] *= l
+		ys[i] *= l
+		zs[i] *= l
+	}
+
+	return this
+}
+
+// adds other to this, other must be at least as long as this
+func (this *Vec3SoA[T]) Add(other *Vec3SoA[T]) *Vec3SoA[T] {
+	n := len(this.X)
+
+	addSlice(this.X, other.X[:n])
+	addSlice(this.Y[:n], other.Y[:n])
+	addSlice(this.Z[:n], other.Z[:n])
+
+	return this
+}
+
+// scales this by s
+func (this *Vec3SoA[T]) SMul(s T) *Vec3SoA[T] {
+
+	scaleSlice(this.X, s)
+	scaleSlice(this.Y, s)
+	scaleSlice(this.Z, s)
+
+	return this
+}
+
+// saves the dot products of this and other in dst and returns it, dst and other must be at least as long as this
+func (this *Vec3SoA[T]) Dot(other *Vec3SoA[T], dst []T) []T {
+	xs := this.X
+	n := len(xs)
+	ys, zs := this.Y[:n], this.Z[:n]
+	ox, oy, oz := other.X[:n], other.Y[:n], other.Z[:n]
+	dst = dst[:n]
+
+	for i := range xs {
+		dst[i] = xs[i]*ox[i] + ys[i]*oy[i] + zs[i]*oz[i]
+	}
+
+	return dst
+}
+
+// returns the bounds of this, saves in target
+func (this *Vec3SoA[T]) Bounds(target *AABB3T[T]) *AABB3T[T] {
+	n := len(this.X)
+
+	if n == 0 {
+		return target.Empty()
+	}
+
+	target.Min[0], target.Max[0] = sliceMinMax(this.X)
+	target.Min[1], target.Max[1] = sliceMinMax(this.Y[:n])
+	target.Min[2], target.Max[2] = sliceMinMax(this.Z[:n])
+
+	return target
+}
+
+// adds b to a element wise, b must be as long as a
+func addSlice[T Float](a, b []T) {
+	b = b[:len(a)]
+
+	for i := range a {
+		a[i] += b[i]
+	}
+}
+
+// scales a by s element wise
+func scaleSlice[T Float](a []T, s T) {
+
+	for i := range a {
+		a[i] *= s
+	}
+}
+
+// returns smallest and largest value of a non empty slice
+func sliceMinMax[T Float](a []T) (T, T) {
+	min, max := a[0], a[0]
+
+	for _, v := range a[1:] {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+
+	return min, max
+}
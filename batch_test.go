@@ -0,0 +1,215 @@
+package mathf
+
+import (
+	"testing"
+)
+
+// number of vectors in the batch benchmarks
+const batchBenchSize = 10000
+
+// keeps benchmarked results alive
+var batchSink []Vec3
+
+// returns n deterministic vectors with a zero vector first
+func batchVec3s(n int) []Vec3 {
+	array := make([]Vec3, n)
+
+	for i := 1; i < n; i++ {
+		f := float32(i)
+		array[i] = Vec3{f*0.5 - 7, 3 - f*0.25, f * f * 0.01}
+	}
+
+	return array
+}
+
+// returns copies of array as separately allocated pointers
+func batchVec3Pointers(array []Vec3) []*Vec3 {
+	pointers := make([]*Vec3, len(array))
+
+	for i := range array {
+		pointers[i] = array[i].Clone()
+	}
+
+	return pointers
+}
+
+var batchMat4 = *NewMat4().Compose(&Vec3{1, -2, 3}, &Vec3{2, 2, 0.5}, NewQuat().FromAxisAngle(NewVec3(1, 1, 0).Normalize(), 0.7))
+
+func TestBatchMatchesPerElement(t *testing.T) {
+	m := batchMat4
+	a, b := batchVec3s(17), batchVec3s(18)[1:]
+
+	tests := []struct {
+		name    string
+		batch   func(dst []Vec3) []Vec3
+		element func(i int) Vec3
+	}{
+		{"TransformVec3s", func(dst []Vec3) []Vec3 { return TransformVec3s(dst, a, &m) }, func(i int) Vec3 { return *a[i].Clone().ApplyMat4(&m) }},
+		{"TransformVec3Directions", func(dst []Vec3) []Vec3 { return TransformVec3Directions(dst, a, &m) }, func(i int) Vec3 { return *a[i].Clone().ApplyMat4Direction(&m) }},
+		{"NormalizeVec3s", func(dst []Vec3) []Vec3 { return NormalizeVec3s(dst, a) }, func(i int) Vec3 { return *a[i].Clone().Normalize() }},
+		{"AddVec3s", func(dst []Vec3) []Vec3 { return AddVec3s(dst, a, b) }, func(i int) Vec3 { return *a[i].Clone().Add(&b[i]) }},
+		{"AddScaledVec3s", func(dst []Vec3) []Vec3 { return AddScaledVec3s(dst, a, b, 0.5) }, func(i int) Vec3 { return *a[i].Clone().Add(b[i].Clone().SMul(0.5)) }},
+		{"ScaleVec3s", func(dst []Vec3) []Vec3 { return ScaleVec3s(dst, a, -3) }, func(i int) Vec3 { return *a[i].Clone().SMul(-3) }},
+		{"Vec3SoA.ApplyMat4", func(dst []Vec3) []Vec3 { return NewVec3SoA[float32](0).FromSlice(a).ApplyMat4(&m).ToSlice(dst) }, func(i int) Vec3 { return *a[i].Clone().ApplyMat4(&m) }},
+		{"Vec3SoA.ApplyMat4Direction", func(dst []Vec3) []Vec3 {
+			return NewVec3SoA[float32](0).FromSlice(a).ApplyMat4Direction(&m).ToSlice(dst)
+		}, func(i int) Vec3 { return *a[i].Clone().ApplyMat4Direction(&m) }},
+		{"Vec3SoA.Normalize", func(dst []Vec3) []Vec3 { return NewVec3SoA[float32](0).FromSlice(a).Normalize().ToSlice(dst) }, func(i int) Vec3 { return *a[i].Clone().Normalize() }},
+		{"Vec3SoA.Add", func(dst []Vec3) []Vec3 {
+			return NewVec3SoA[float32](0).FromSlice(a).Add(NewVec3SoA[float32](0).FromSlice(b)).ToSlice(dst)
+		}, func(i int) Vec3 { return *a[i].Clone().Add(&b[i]) }},
+		{"Vec3SoA.SMul", func(dst []Vec3) []Vec3 { return NewVec3SoA[float32](0).FromSlice(a).SMul(-3).ToSlice(dst) }, func(i int) Vec3 { return *a[i].Clone().SMul(-3) }},
+	}
+
+	for _, test := range tests {
+		got := test.batch(make([]Vec3, len(a)))
+
+		if len(got) != len(a) {
+			t.Errorf("%s returned %d vectors, want %d", test.name, len(got), len(a))
+			continue
+		}
+
+		for i := range got {
+			if want := test.element(i); !got[i].EqualsULP(&want, 1) {
+				t.Errorf("%s[%d] = %v, want %v", test.name, i, got[i], want)
+			}
+		}
+	}
+
+	soa := NewVec3SoA[float32](0).FromSlice(a)
+	dots, soaDots := DotVec3s(make([]float32, len(a)), a, b), soa.Dot(NewVec3SoA[float32](0).FromSlice(b), make([]float32, len(a)))
+
+	for i := range a {
+		if want := a[i].Dot(&b[i]); dots[i] != want || soaDots[i] != want {
+			t.Errorf("dot %d = %v and %v, want %v", i, dots[i], soaDots[i], want)
+		}
+	}
+
+	want := NewAABB3(new(Vec3), new(Vec3)).FromPoints(batchVec3Pointers(a))
+
+	if got := NewAABB3(new(Vec3), new(Vec3)).FromSlice(a); !got.Equals(want) {
+		t.Errorf("AABB3.FromSlice = %v, want %v", got, want)
+	}
+	if got := soa.Bounds(NewAABB3(new(Vec3), new(Vec3))); !got.Equals(want) {
+		t.Errorf("Vec3SoA.Bounds = %v, want %v", got, want)
+	}
+
+	inPlace := append([]Vec3(nil), a...)
+
+	if TransformVec3s(inPlace, inPlace, &m); inPlace[5] != *a[5].Clone().ApplyMat4(&m) {
+		t.Errorf("in place TransformVec3s[5] = %v, want %v", inPlace[5], *a[5].Clone().ApplyMat4(&m))
+	}
+}
+
+func BenchmarkTransformVec3Pointers(b *testing.B) {
+	m := batchMat4
+	array := batchVec3Pointers(batchVec3s(batchBenchSize))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, v := range array {
+			v.ApplyMat4(&m)
+		}
+	}
+}
+
+func BenchmarkTransformVec3s(b *testing.B) {
+	m := batchMat4
+	array := batchVec3s(batchBenchSize)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		batchSink = TransformVec3s(array, array, &m)
+	}
+}
+
+func BenchmarkVec3SoAApplyMat4(b *testing.B) {
+	m := batchMat4
+	soa := NewVec3SoA[float32](0).FromSlice(batchVec3s(batchBenchSize))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		soa.ApplyMat4(&m)
+	}
+}
+
+func BenchmarkTransformVec4s(b *testing.B) {
+	m := batchMat4
+	array := make([]Vec4, batchBenchSize)
+
+	for i, v := range batchVec3s(batchBenchSize) {
+		array[i] = Vec4{v[0], v[1], v[2], 1}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		TransformVec4s(array, array, &m)
+	}
+}
+
+func BenchmarkNormalizeVec3Pointers(b *testing.B) {
+	array := batchVec3Pointers(batchVec3s(batchBenchSize))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, v := range array {
+			v.Normalize()
+		}
+	}
+}
+
+func BenchmarkNormalizeVec3s(b *testing.B) {
+	array := batchVec3s(batchBenchSize)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		batchSink = NormalizeVec3s(array, array)
+	}
+}
+
+func BenchmarkVec3SoANormalize(b *testing.B) {
+	soa := NewVec3SoA[float32](0).FromSlice(batchVec3s(batchBenchSize))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		soa.Normalize()
+	}
+}
+
+func BenchmarkAABB3FromPoints(b *testing.B) {
+	array := batchVec3Pointers(batchVec3s(batchBenchSize))
+	box := NewAABB3(new(Vec3), new(Vec3))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		box.FromPoints(array)
+	}
+}
+
+func BenchmarkAABB3FromSlice(b *testing.B) {
+	array := batchVec3s(batchBenchSize)
+	box := NewAABB3(new(Vec3), new(Vec3))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		box.FromSlice(array)
+	}
+}
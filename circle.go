@@ -0,0 +1,384 @@
+package mathf
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// bounding circle represented by a center and radius
+type Circle struct {
+	Center *Vec2
+	Radius float32
+}
+
+// returns new Circle
+func NewCircle(center *Vec2, radius float32) *Circle {
+	this := new(Circle)
+
+	this.Center = new(Vec2).Copy(center)
+	this.Radius = radius
+
+	return this
+}
+
+// returns a copy of this
+func (this *Circle) Clone() *Circle {
+
+	return NewCircle(this.Center, this.Radius)
+}
+
+// copies other
+func (this *Circle) Copy(other *Circle) *Circle {
+
+	this.Center.Copy(other.Center)
+	this.Radius = other.Radius
+
+	return this
+}
+
+// sets this from values
+func (this *Circle) Set(center *Vec2, radius float32) *Circle {
+
+	this.Center.Copy(center)
+	this.Radius = radius
+
+	return this
+}
+
+// returns this as empty
+func (this *Circle) Empty() *Circle {
+
+	this.Center.Set(0, 0)
+	this.Radius = -1
+
+	return this
+}
+
+// checks if this is empty
+func (this *Circle) IsEmpty() bool {
+
+	return this.Radius < 0
+}
+
+// expands this to contain point
+func (this *Circle) ExpandPoint(v *Vec2) *Circle {
+
+	if this.Radius < 0 {
+		this.Center.Copy(v)
+		this.Radius = 0
+
+		return this
+	}
+
+	d := this.Center.DistanceTo(v)
+	if d <= this.Radius {
+		return this
+	}
+
+	r := (this.Radius + d) * 0.5
+	this.Center.Lerp(v, (r-this.Radius)/d)
+	this.Radius = r
+
+	return this
+}
+
+// merges this and other
+func (this *Circle) Union(other *Circle) *Circle {
+
+	if other.Radius < 0 {
+		return this
+	}
+	if this.Radius < 0 {
+		return this.Copy(other)
+	}
+
+	d := this.Center.DistanceTo(other.Center)
+
+	if d+other.Radius <= this.Radius {
+		return this
+	}
+	if d+this.Radius <= other.Radius {
+		return this.Copy(other)
+	}
+
+	r := (d + this.Radius + other.Radius) * 0.5
+	this.Center.Lerp(other.Center, (r-this.Radius)/d)
+	this.Radius = r
+
+	return this
+}
+
+// checks if circle contains point
+func (this *Circle) Contains(v *Vec2) bool {
+
+	return this.Center.DistanceToSq(v) <= this.Radius*this.Radius
+}
+
+// checks if this and other intersect
+func (this *Circle) Intersects(other *Circle) bool {
+	r := this.Radius + other.Radius
+
+	return this.Center.DistanceToSq(other.Center) <= r*r
+}
+
+// checks if this and box intersect
+func (this *Circle) IntersectsAABB2(box *AABB2) bool {
+	var p Vec2
+
+	p.Copy(this.Center).Clamp(box.Min, box.Max)
+
+	return p.DistanceToSq(this.Center) <= this.Radius*this.Radius
+}
+
+// sets this to the circle enclosing box
+func (this *Circle) FromAABB2(box *AABB2) *Circle {
+
+	this.Center.VAdd(box.Min, box.Max).SMul(0.5)
+	this.Radius = this.Center.DistanceTo(box.Max)
+
+	return this
+}
+
+// transforms this by Mat32, radius is scaled by the largest axis scale
+func (this *Circle) ApplyMat32(m *Mat32) *Circle {
+	sx := m[0]*m[0] + m[1]*m[1]
+	sy := m[2]*m[2] + m[3]*m[3]
+
+	s := sx
+	if sy > s {
+		s = sy
+	}
+
+	this.Center.ApplyMat32(m)
+	this.Radius *= float32(math.Sqrt(float64(s)))
+
+	return this
+}
+
+// sets this from array of points using Ritter's approximate bounding circle
+func (this *Circle) FromPoints(array []*Vec2) *Circle {
+	l := len(array)
+
+	if l == 0 {
+		return this.Empty()
+	}
+
+	x := array[0]
+	y := x
+	d := float32(0)
+
+	for i := 1; i < l; i++ {
+		if dd := x.DistanceToSq(array[i]); dd > d {
+			y, d = array[i], dd
+		}
+	}
+
+	z := y
+	d = 0
+
+	for i := 0; i < l; i++ {
+		if dd := y.DistanceToSq(array[i]); dd > d {
+			z, d = array[i], dd
+		}
+	}
+
+	this.Center.VAdd(y, z).SMul(0.5)
+	this.Radius = float32(math.Sqrt(float64(d))) * 0.5
+
+	for i := 0; i < l; i++ {
+		this.ExpandPoint(array[i])
+	}
+
+	return this
+}
+
+// sets this from array of points using Welzl's minimal enclosing circle
+func (this *Circle) FromPointsMinimal(array []*Vec2) *Circle {
+	l := len(array)
+
+	if l == 0 {
+		return this.Empty()
+	}
+
+	points := make([]*Vec2, l)
+	copy(points, array)
+
+	for i := l - 1; i > 0; i-- {
+		j := rand.Intn(i + 1)
+		points[i], points[j] = points[j], points[i]
+	}
+
+	var boundary [3]*Vec2
+
+	return this.welzl(points, l, boundary[:0])
+}
+
+// minimal circle of the first n points with boundary on its edge
+func (this *Circle) welzl(points []*Vec2, n int, boundary []*Vec2) *Circle {
+
+	this.fromBoundary(boundary)
+
+	if len(boundary) == 3 {
+		return this
+	}
+
+	for i := 0; i < n; i++ {
+		if !this.containsEps(points[i]) {
+			this.welzl(points, i, append(boundary, points[i]))
+		}
+	}
+
+	return this
+}
+
+// checks if point is inside this with a relative tolerance
+func (this *Circle) containsEps(v *Vec2) bool {
+	r := this.Radius * (1 + 1e-5)
+
+	return this.Radius >= 0 && this.Center.DistanceToSq(v) <= r*r
+}
+
+// sets this to the smallest circle with up to three points on its edge
+func (this *Circle) fromBoundary(boundary []*Vec2) *Circle {
+
+	switch len(boundary) {
+	case 0:
+		return this.Empty()
+	case 1:
+		return this.Set(boundary[0], 0)
+	case 2:
+		this.Center.VAdd(boundary[0], boundary[1]).SMul(0.5)
+		this.Radius = this.Center.DistanceTo(boundary[0])
+		return this
+	}
+
+	a, b, c := boundary[0], boundary[1], boundary[2]
+	abx, aby := b[0]-a[0], b[1]-a[1]
+	acx, acy := c[0]-a[0], c[1]-a[1]
+
+	det := 2 * (abx*acy - aby*acx)
+	if Abs(det) < Epsilon {
+		this.Set(a, 0).ExpandPoint(b)
+		return this.ExpandPoint(c)
+	}
+
+	lab, lac := abx*abx+aby*aby, acx*acx+acy*acy
+	x := (acy*lab - aby*lac) / det
+	y := (abx*lac - acx*lab) / det
+
+	this.Center.Set(a[0]+x, a[1]+y)
+	this.Radius = float32(math.Sqrt(float64(x*x + y*y)))
+
+	return this
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *Circle) Equals(other *Circle) bool {
+
+	return this.Center.Equals(other.Center) && this.Radius == other.Radius
+}
+
+// checks if this equals other within absolute tolerance tol
+func (this *Circle) EqualsTol(other *Circle, tol float32) bool {
+
+	return this.Center.EqualsTol(other.Center, tol) && EqualsTol(this.Radius, other.Radius, tol)
+}
+
+// checks if this equals other within tol relative to the magnitude of its elements
+func (this *Circle) EqualsRel(other *Circle, tol float32) bool {
+
+	return this.Center.EqualsRel(other.Center, tol) && EqualsRel(this.Radius, other.Radius, tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *Circle) EqualsULP(other *Circle, ulps int) bool {
+
+	return this.Center.EqualsULP(other.Center, ulps) && EqualsULP(this.Radius, other.Radius, ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *Circle) MarshalText() ([]byte, error) {
+	e := this.elements()
+
+	return appendStructText(nil, circleText, e[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *Circle) UnmarshalText(text []byte) error {
+	var e [3]float32
+
+	if err := parseStructText(text, circleText, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns this as a JSON array of Center and Radius, implements json.Marshaler
+func (this *Circle) MarshalJSON() ([]byte, error) {
+	e := this.elements()
+
+	return appendJSON(nil, e[:])
+}
+
+// parses a JSON array of Center and Radius into this, implements json.Unmarshaler
+func (this *Circle) UnmarshalJSON(data []byte) error {
+	var e [3]float32
+
+	if isJSONNull(data) {
+		return nil
+	}
+	if err := parseJSON(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Center and Radius of this as little endian floats, implements encoding.BinaryMarshaler
+func (this *Circle) MarshalBinary() ([]byte, error) {
+	e := this.elements()
+
+	return appendBinary(nil, e[:]), nil
+}
+
+// parses little endian floats of Center and Radius into this, implements encoding.BinaryUnmarshaler
+func (this *Circle) UnmarshalBinary(data []byte) error {
+	var e [3]float32
+
+	if err := parseBinary(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Center and Radius of this as marshaling elements, nil fields are zero
+func (this *Circle) elements() [3]float32 {
+	center := valueOrZero(this.Center)
+
+	return [3]float32{center[0], center[1], this.Radius}
+}
+
+// sets Center and Radius of this from marshaling elements, nil fields are allocated
+func (this *Circle) setElements(e *[3]float32) {
+	if this.Center == nil {
+		this.Center = new(Vec2)
+	}
+
+	copy(this.Center[:], e[0:2])
+	this.Radius = e[2]
+}
+
+// returns this as string type
+func (this *Circle) String() string {
+
+	return fmt.Sprintf("Circle[ Center: %s, Radius: %f ]", this.Center, this.Radius)
+}
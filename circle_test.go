@@ -0,0 +1,160 @@
+package mathf
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// returns n points from a fixed seed inside the square from -size to size
+func randomPoints2(n int, size float32) []*Vec2 {
+	r := rand.New(rand.NewSource(1))
+	points := make([]*Vec2, n)
+
+	for i := range points {
+		points[i] = NewVec2((r.Float32()*2-1)*size, (r.Float32()*2-1)*size)
+	}
+
+	return points
+}
+
+// checks circle contains every point with a small tolerance
+func circleContainsAll(circle *Circle, points []*Vec2) bool {
+
+	for _, p := range points {
+		if circle.Center.DistanceTo(p) > circle.Radius*(1+1e-5)+1e-5 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestCircleFromPointsMinimal(t *testing.T) {
+	h := float32(math.Sqrt(3))
+	square := []*Vec2{NewVec2(-1, -1), NewVec2(1, -1), NewVec2(1, 1), NewVec2(-1, 1)}
+
+	tests := []struct {
+		name   string
+		points []*Vec2
+		want   Circle
+	}{
+		{"one point", []*Vec2{NewVec2(1, 2)}, Circle{&Vec2{1, 2}, 0}},
+		{"two points", []*Vec2{NewVec2(1, 2), NewVec2(3, 2)}, Circle{&Vec2{2, 2}, 1}},
+		{"equilateral triangle", []*Vec2{NewVec2(0, 0), NewVec2(2, 0), NewVec2(1, h)}, Circle{&Vec2{1, h / 3}, 2 / h}},
+		// the circumcircle of an obtuse triangle is larger than the circle on its longest edge
+		{"obtuse triangle", []*Vec2{NewVec2(0, 0), NewVec2(4, 0), NewVec2(2, 0.5)}, Circle{&Vec2{2, 0}, 2}},
+		{"collinear", []*Vec2{NewVec2(0, 0), NewVec2(1, 1), NewVec2(4, 4), NewVec2(2, 2)}, Circle{&Vec2{2, 2}, 2 * math.Sqrt2}},
+		{"square with inner points", append([]*Vec2{NewVec2(0.5, 0), NewVec2(0, -0.5)}, square...), Circle{&Vec2{}, math.Sqrt2}},
+	}
+
+	for _, test := range tests {
+		// the points are shuffled, so run each a few times
+		for i := 0; i < 10; i++ {
+			got := NewCircle(&Vec2{}, 0).FromPointsMinimal(test.points)
+
+			if !got.EqualsTol(&test.want, 1e-5) {
+				t.Errorf("%s FromPointsMinimal() = %v, want %v", test.name, got, &test.want)
+				break
+			}
+		}
+	}
+
+	points := randomPoints2(200, 5)
+	minimal := NewCircle(&Vec2{}, 0).FromPointsMinimal(points)
+	ritter := NewCircle(&Vec2{}, 0).FromPoints(points)
+
+	if !circleContainsAll(minimal, points) {
+		t.Errorf("FromPointsMinimal() = %v does not contain every point", minimal)
+	}
+	if minimal.Radius > ritter.Radius*(1+1e-5) {
+		t.Errorf("FromPointsMinimal() radius %v is larger than FromPoints() radius %v", minimal.Radius, ritter.Radius)
+	}
+	if got := NewCircle(&Vec2{1, 1}, 1).FromPointsMinimal(nil); !got.IsEmpty() {
+		t.Errorf("FromPointsMinimal(nil) = %v, want empty", got)
+	}
+}
+
+func TestCircleFromPoints(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []*Vec2
+	}{
+		{"one point", []*Vec2{NewVec2(1, 2)}},
+		{"two points", []*Vec2{NewVec2(1, 2), NewVec2(-3, 0)}},
+		{"line", []*Vec2{NewVec2(0, 0), NewVec2(1, 1), NewVec2(5, 5), NewVec2(2, 2)}},
+		{"random", randomPoints2(200, 5)},
+	}
+
+	for _, test := range tests {
+		if got := NewCircle(&Vec2{}, 0).FromPoints(test.points); !circleContainsAll(got, test.points) {
+			t.Errorf("%s FromPoints() = %v does not contain every point", test.name, got)
+		}
+	}
+
+	if got := NewCircle(&Vec2{1, 1}, 1).FromPoints(nil); !got.IsEmpty() {
+		t.Errorf("FromPoints(nil) = %v, want empty", got)
+	}
+}
+
+func TestCircleExpandPoint(t *testing.T) {
+	tests := []struct {
+		name   string
+		circle *Circle
+		p      Vec2
+		want   Circle
+	}{
+		{"empty", NewCircle(&Vec2{}, 0).Empty(), Vec2{1, 2}, Circle{&Vec2{1, 2}, 0}},
+		{"inside", NewCircle(&Vec2{}, 2), Vec2{1, 1}, Circle{&Vec2{}, 2}},
+		{"outside", NewCircle(&Vec2{}, 1), Vec2{3, 0}, Circle{&Vec2{1, 0}, 2}},
+	}
+
+	for _, test := range tests {
+		if got := test.circle.ExpandPoint(&test.p); !got.EqualsTol(&test.want, 1e-6) {
+			t.Errorf("%s ExpandPoint(%v) = %v, want %v", test.name, test.p, got, &test.want)
+		}
+	}
+}
+
+func TestCircleUnion(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *Circle
+		want Circle
+	}{
+		{"disjoint", NewCircle(&Vec2{}, 1), NewCircle(&Vec2{4, 0}, 1), Circle{&Vec2{2, 0}, 3}},
+		{"overlapping", NewCircle(&Vec2{}, 2), NewCircle(&Vec2{0, 2}, 1), Circle{&Vec2{0, 0.5}, 2.5}},
+		{"other inside", NewCircle(&Vec2{}, 3), NewCircle(&Vec2{1, 0}, 1), Circle{&Vec2{}, 3}},
+		{"this inside", NewCircle(&Vec2{1, 0}, 1), NewCircle(&Vec2{}, 3), Circle{&Vec2{}, 3}},
+		{"other empty", NewCircle(&Vec2{1, 0}, 1), NewCircle(&Vec2{}, 0).Empty(), Circle{&Vec2{1, 0}, 1}},
+		{"this empty", NewCircle(&Vec2{}, 0).Empty(), NewCircle(&Vec2{1, 0}, 1), Circle{&Vec2{1, 0}, 1}},
+	}
+
+	for _, test := range tests {
+		if got := test.a.Clone().Union(test.b); !got.EqualsTol(&test.want, 1e-6) {
+			t.Errorf("%s %v.Union(%v) = %v, want %v", test.name, test.a, test.b, got, &test.want)
+		}
+	}
+}
+
+func TestCircleIntersects(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *Circle
+		want bool
+	}{
+		{"overlapping", NewCircle(&Vec2{}, 1), NewCircle(&Vec2{1, 1}, 1), true},
+		{"touching", NewCircle(&Vec2{}, 1), NewCircle(&Vec2{0, 3}, 2), true},
+		{"separated", NewCircle(&Vec2{}, 1), NewCircle(&Vec2{0, 3.01}, 2), false},
+		{"contained", NewCircle(&Vec2{}, 5), NewCircle(&Vec2{1, 0}, 1), true},
+	}
+
+	for _, test := range tests {
+		if got := test.a.Intersects(test.b); got != test.want {
+			t.Errorf("%s %v.Intersects(%v) = %v, want %v", test.name, test.a, test.b, got, test.want)
+		}
+		if got := test.b.Intersects(test.a); got != test.want {
+			t.Errorf("%s %v.Intersects(%v) = %v, want %v", test.name, test.b, test.a, got, test.want)
+		}
+	}
+}
@@ -5,8 +5,14 @@ import (
 	"math"
 )
 
-// float32 Array representing a Color RGBA
-type Color [4]float32
+// Array representing a Color RGBA of float32 or float64
+type ColorT[T Float] [4]T
+
+// float32 Color
+type Color = ColorT[float32]
+
+// float64 Color
+type Colord = ColorT[float64]
 
 // returns new Color
 func NewColor(r, g, b, a float32) *Color {
@@ -17,14 +23,23 @@ func NewColor(r, g, b, a float32) *Color {
 	return this
 }
 
+// returns new Colord
+func NewColord(r, g, b, a float64) *Colord {
+	this := new(Colord)
+
+	this[0], this[1], this[2], this[3] = r, g, b, a
+
+	return this
+}
+
 // returns a copy of this
-func (this *Color) Clone() *Color {
+func (this *ColorT[T]) Clone() *ColorT[T] {
 
-	return new(Color).Copy(this)
+	return new(ColorT[T]).Copy(this)
 }
 
 // copies other
-func (this *Color) Copy(other *Color) *Color {
+func (this *ColorT[T]) Copy(other *ColorT[T]) *ColorT[T] {
 
 	this[0], this[1], this[2], this[3] = other[0], other[1], other[2], other[3]
 
@@ -32,7 +47,7 @@ func (this *Color) Copy(other *Color) *Color {
 }
 
 // sets this from values
-func (this *Color) Set(r, g, b, a float32) *Color {
+func (this *ColorT[T]) Set(r, g, b, a T) *ColorT[T] {
 
 	this[0], this[1], this[2], this[3] = r, g, b, a
 
@@ -40,7 +55,7 @@ func (this *Color) Set(r, g, b, a float32) *Color {
 }
 
 // adds other to this
-func (this *Color) Add(other *Color) *Color {
+func (this *ColorT[T]) Add(other *ColorT[T]) *ColorT[T] {
 
 	this[0] += other[0]
 	this[1] += other[1]
@@ -51,7 +66,7 @@ func (this *Color) Add(other *Color) *Color {
 }
 
 // adds a and b saves in this
-func (this *Color) CAdd(a, b *Color) *Color {
+func (this *ColorT[T]) CAdd(a, b *ColorT[T]) *ColorT[T] {
 
 	this[0] = a[0] + b[0]
 	this[1] = a[1] + b[1]
@@ -62,7 +77,7 @@ func (this *Color) CAdd(a, b *Color) *Color {
 }
 
 // adds scalar to this
-func (this *Color) SAdd(s float32) *Color {
+func (this *ColorT[T]) SAdd(s T) *ColorT[T] {
 
 	this[0] += s
 	this[1] += s
@@ -73,7 +88,7 @@ func (this *Color) SAdd(s float32) *Color {
 }
 
 // subtracts other from this
-func (this *Color) Sub(other *Color) *Color {
+func (this *ColorT[T]) Sub(other *ColorT[T]) *ColorT[T] {
 
 	this[0] += other[0]
 	this[1] += other[1]
@@ -83,7 +98,7 @@ func (this *Color) Sub(other *Color) *Color {
 }
 
 // subtracts a and b saves in this
-func (this *Color) CSub(a, b *Color) *Color {
+func (this *ColorT[T]) CSub(a, b *ColorT[T]) *ColorT[T] {
 
 	this[0] = a[0] - b[0]
 	this[1] = a[1] - b[1]
@@ -93,7 +108,7 @@ func (this *Color) CSub(a, b *Color) *Color {
 }
 
 // subtracts scalar from this
-func (this *Color) SSub(s float32) *Color {
+func (this *ColorT[T]) SSub(s T) *ColorT[T] {
 
 	this[0] -= s
 	this[1] -= s
@@ -104,7 +119,7 @@ func (this *Color) SSub(s float32) *Color {
 }
 
 // mutiples this by other
-func (this *Color) Mul(other *Color) *Color {
+func (this *ColorT[T]) Mul(other *ColorT[T]) *ColorT[T] {
 
 	this[0] *= other[0]
 	this[1] *= other[1]
@@ -115,7 +130,7 @@ func (this *Color) Mul(other *Color) *Color {
 }
 
 // mutiples a and b saves in this
-func (this *Color) CMul(a, b *Color) *Color {
+func (this *ColorT[T]) CMul(a, b *ColorT[T]) *ColorT[T] {
 
 	this[0] = a[0] * b[0]
 	this[1] = a[1] * b[1]
@@ -126,7 +141,7 @@ func (this *Color) CMul(a, b *Color) *Color {
 }
 
 // mutiples this by scalar
-func (this *Color) SMul(s float32) *Color {
+func (this *ColorT[T]) SMul(s T) *ColorT[T] {
 
 	this[0] *= s
 	this[1] *= s
@@ -137,7 +152,7 @@ func (this *Color) SMul(s float32) *Color {
 }
 
 // divides this by other
-func (this *Color) Div(other *Color) *Color {
+func (this *ColorT[T]) Div(other *ColorT[T]) *ColorT[T] {
 	r, g, b, a := other[0], other[1], other[2], other[3]
 
 	if r != 0 {
@@ -162,7 +177,7 @@ func (this *Color) Div(other *Color) *Color {
 }
 
 // divides a and b saves in this
-func (this *Color) CDiv(a, b *Color) *Color {
+func (this *ColorT[T]) CDiv(a, b *ColorT[T]) *ColorT[T] {
 	br, bg, bb, ba := b[0], b[1], b[2], b[3]
 
 	if br != 0 {
@@ -187,7 +202,7 @@ func (this *Color) CDiv(a, b *Color) *Color {
 }
 
 // divides this by scalar
-func (this *Color) SDiv(s float32) *Color {
+func (this *ColorT[T]) SDiv(s T) *ColorT[T] {
 	if s != 0 {
 		s = 1 / s
 	}
@@ -201,14 +216,14 @@ func (this *Color) SDiv(s float32) *Color {
 }
 
 // normalize vector, makes length of 1
-func (this *Color) Normalize() *Color {
+func (this *ColorT[T]) Normalize() *ColorT[T] {
 	l := this[0]*this[0] + this[1]*this[1] + this[2]*this[2] + this[3]*this[3]
 
 	if l == 0 {
 		return this
 	}
 
-	l = 1 / float32(math.Sqrt(float64(l)))
+	l = 1 / T(math.Sqrt(float64(l)))
 	this[0] *= l
 	this[1] *= l
 	this[2] *= l
@@ -218,31 +233,31 @@ func (this *Color) Normalize() *Color {
 }
 
 // returns length of this
-func (this *Color) Length() float32 {
+func (this *ColorT[T]) Length() T {
 	l := this[0]*this[0] + this[1]*this[1] + this[2]*this[2] + this[3]*this[3]
 
 	if l == 0 {
 		return 0
 	}
 
-	return float32(math.Sqrt(float64(l)))
+	return T(math.Sqrt(float64(l)))
 }
 
 // returns length squared of this
-func (this *Color) LengthSq() float32 {
+func (this *ColorT[T]) LengthSq() T {
 
 	return this[0]*this[0] + this[1]*this[1] + this[2]*this[2] + this[3]*this[3]
 }
 
 // sets length of vector, if length is zero returns zero vector
-func (this *Color) SetLength(length float32) *Color {
+func (this *ColorT[T]) SetLength(length T) *ColorT[T] {
 	l := this[0]*this[0] + this[1]*this[1] + this[2]*this[2] + this[3]*this[3]
 
 	if l == 0 {
 		return this
 	}
 
-	l = 1 / float32(math.Sqrt(float64(l)))
+	l = 1 / T(math.Sqrt(float64(l)))
 	this[0] *= length * l
 	this[1] *= length * l
 	this[2] *= length * l
@@ -252,19 +267,19 @@ func (this *Color) SetLength(length float32) *Color {
 }
 
 // returns dot product of this and other
-func (this *Color) Dot(other *Color) float32 {
+func (this *ColorT[T]) Dot(other *ColorT[T]) T {
 
 	return this[0]*other[0] + this[1]*other[1] + this[2]*other[2] + this[3]*other[3]
 }
 
 // returns dot product of a and b
-func (this *Color) CDot(a, b *Color) float32 {
+func (this *ColorT[T]) CDot(a, b *ColorT[T]) T {
 
 	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2] + a[3]*b[3]
 }
 
 // lerps this and other by x
-func (this *Color) Lerp(other *Color, x float32) *Color {
+func (this *ColorT[T]) Lerp(other *ColorT[T], x T) *ColorT[T] {
 
 	this[0] += (other[0] - this[0]) * x
 	this[1] += (other[1] - this[1]) * x
@@ -275,7 +290,7 @@ func (this *Color) Lerp(other *Color, x float32) *Color {
 }
 
 // lerps a and b by x, saves in this
-func (this *Color) CLerp(a, b *Color, x float32) *Color {
+func (this *ColorT[T]) CLerp(a, b *ColorT[T], x T) *ColorT[T] {
 
 	this[0] = a[0] + (b[0]-a[0])*x
 	this[1] = a[1] + (b[1]-a[1])*x
@@ -286,7 +301,7 @@ func (this *Color) CLerp(a, b *Color, x float32) *Color {
 }
 
 // sets this values from  min values of this and other
-func (this *Color) Min(other *Color) *Color {
+func (this *ColorT[T]) Min(other *ColorT[T]) *ColorT[T] {
 
 	if this[0] > other[0] {
 		this[0] = other[0]
@@ -305,7 +320,7 @@ func (this *Color) Min(other *Color) *Color {
 }
 
 // sets this values from max values of this and other
-func (this *Color) Max(other *Color) *Color {
+func (this *ColorT[T]) Max(other *ColorT[T]) *ColorT[T] {
 
 	if this[0] < other[0] {
 		this[0] = other[0]
@@ -324,7 +339,7 @@ func (this *Color) Max(other *Color) *Color {
 }
 
 // clamps this between min and max
-func (this *Color) Clamp(min, max *Color) *Color {
+func (this *ColorT[T]) Clamp(min, max *ColorT[T]) *ColorT[T] {
 
 	if this[0] < min[0] {
 		this[0] = min[0]
@@ -356,18 +371,18 @@ func (this *Color) Clamp(min, max *Color) *Color {
 }
 
 // clamps each element between 0 and 1
-func (this *Color) Clamp01() *Color {
+func (this *ColorT[T]) Clamp01() *ColorT[T] {
 
-	this[0] = Clamp01(this[0])
-	this[1] = Clamp01(this[1])
-	this[2] = Clamp01(this[2])
-	this[3] = Clamp01(this[3])
+	this[0] = clamp01(this[0])
+	this[1] = clamp01(this[1])
+	this[2] = clamp01(this[2])
+	this[3] = clamp01(this[3])
 
 	return this
 }
 
 // sets values from Vec2
-func (this *Color) FromVec2(v *Vec2) *Color {
+func (this *ColorT[T]) FromVec2(v *Vec2T[T]) *ColorT[T] {
 
 	this[0] = v[0]
 	this[1] = v[1]
@@ -378,7 +393,7 @@ func (this *Color) FromVec2(v *Vec2) *Color {
 }
 
 // sets values from Vec3
-func (this *Color) FromVec3(v *Vec3) *Color {
+func (this *ColorT[T]) FromVec3(v *Vec3T[T]) *ColorT[T] {
 
 	this[0] = v[0]
 	this[1] = v[1]
@@ -389,7 +404,7 @@ func (this *Color) FromVec3(v *Vec3) *Color {
 }
 
 // sets values from Vec4
-func (this *Color) FromVec4(v *Vec4) *Color {
+func (this *ColorT[T]) FromVec4(v *Vec4T[T]) *ColorT[T] {
 
 	this[0] = v[0]
 	this[1] = v[1]
@@ -399,8 +414,64 @@ func (this *Color) FromVec4(v *Vec4) *Color {
 	return this
 }
 
-// checks if this equals other
-func (this *Color) Equals(other *Color) bool {
+// returns sum of this and other by value
+func (this ColorT[T]) Plus(other ColorT[T]) ColorT[T] {
+
+	return ColorT[T]{this[0] + other[0], this[1] + other[1], this[2] + other[2], this[3] + other[3]}
+}
+
+// returns difference of this and other by value
+func (this ColorT[T]) Minus(other ColorT[T]) ColorT[T] {
+
+	return ColorT[T]{this[0] - other[0], this[1] - other[1], this[2] - other[2], this[3] - other[3]}
+}
+
+// returns component product of this and other by value
+func (this ColorT[T]) Times(other ColorT[T]) ColorT[T] {
+
+	return ColorT[T]{this[0] * other[0], this[1] * other[1], this[2] * other[2], this[3] * other[3]}
+}
+
+// returns this scaled by s by value
+func (this ColorT[T]) Scaled(s T) ColorT[T] {
+
+	return ColorT[T]{this[0] * s, this[1] * s, this[2] * s, this[3] * s}
+}
+
+// returns lerp of this and other by x by value
+func (this ColorT[T]) Lerped(other ColorT[T], x T) ColorT[T] {
+
+	return ColorT[T]{this[0] + (other[0]-this[0])*x, this[1] + (other[1]-this[1])*x, this[2] + (other[2]-this[2])*x, this[3] + (other[3]-this[3])*x}
+}
+
+// returns this clamped between 0 and 1 by value
+func (this ColorT[T]) Clamped01() ColorT[T] {
+
+	return *this.Clamp01()
+}
+
+// converts this to float32, saves in target
+func (this *ColorT[T]) Float32(target *Color) *Color {
+
+	for i, v := range this {
+		target[i] = float32(v)
+	}
+
+	return target
+}
+
+// converts this to float64, saves in target
+func (this *ColorT[T]) Float64(target *Colord) *Colord {
+
+	for i, v := range this {
+		target[i] = float64(v)
+	}
+
+	return target
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *ColorT[T]) Equals(other *ColorT[T]) bool {
 
 	if this[0] != other[0] {
 		return false
@@ -418,8 +489,62 @@ func (this *Color) Equals(other *Color) bool {
 	return true
 }
 
+// checks if this equals other within absolute tolerance tol
+func (this *ColorT[T]) EqualsTol(other *ColorT[T], tol T) bool {
+
+	return equalsTolSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other within tol relative to its largest element magnitude
+func (this *ColorT[T]) EqualsRel(other *ColorT[T], tol T) bool {
+
+	return equalsRelSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *ColorT[T]) EqualsULP(other *ColorT[T], ulps int) bool {
+
+	return equalsULPSlice(this[:], other[:], ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *ColorT[T]) MarshalText() ([]byte, error) {
+
+	return appendText(nil, colorText, this[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *ColorT[T]) UnmarshalText(text []byte) error {
+
+	return parseText(text, colorText, this[:])
+}
+
+// returns this as a JSON array, implements json.Marshaler
+func (this *ColorT[T]) MarshalJSON() ([]byte, error) {
+
+	return appendJSON(nil, this[:])
+}
+
+// parses a JSON array into this, implements json.Unmarshaler
+func (this *ColorT[T]) UnmarshalJSON(data []byte) error {
+
+	return parseJSON(data, this[:])
+}
+
+// returns this as little endian floats, implements encoding.BinaryMarshaler
+func (this *ColorT[T]) MarshalBinary() ([]byte, error) {
+
+	return appendBinary(nil, this[:]), nil
+}
+
+// parses little endian floats into this, implements encoding.BinaryUnmarshaler
+func (this *ColorT[T]) UnmarshalBinary(data []byte) error {
+
+	return parseBinary(data, this[:])
+}
+
 // returns this as string type
-func (this *Color) String() string {
+func (this *ColorT[T]) String() string {
 
 	return fmt.Sprintf("Color[ %f, %f, %f, %f ]", this[0], this[1], this[2], this[3])
 }
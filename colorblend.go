@@ -0,0 +1,263 @@
+package mathf
+
+import (
+	"math"
+)
+
+// Porter-Duff operators, source is composited with destination
+const (
+	COMPOSITE_OVER = iota
+	COMPOSITE_IN
+	COMPOSITE_OUT
+	COMPOSITE_ATOP
+	COMPOSITE_XOR
+)
+
+// blend modes as defined by the W3C compositing and blending spec, source is blended onto backdrop
+const (
+	BLEND_NORMAL = iota
+	BLEND_MULTIPLY
+	BLEND_SCREEN
+	BLEND_OVERLAY
+	BLEND_DARKEN
+	BLEND_LIGHTEN
+	BLEND_COLOR_DODGE
+	BLEND_COLOR_BURN
+	BLEND_HARD_LIGHT
+	BLEND_SOFT_LIGHT
+	BLEND_DIFFERENCE
+	BLEND_EXCLUSION
+	BLEND_HUE
+	BLEND_SATURATION
+	BLEND_COLOR
+	BLEND_LUMINOSITY
+)
+
+// multiplies rgb of this by alpha
+func (this *ColorT[T]) Premultiply() *ColorT[T] {
+
+	this[0] *= this[3]
+	this[1] *= this[3]
+	this[2] *= this[3]
+
+	return this
+}
+
+// divides rgb of this by alpha, if alpha is zero rgb is set to zero
+func (this *ColorT[T]) Unpremultiply() *ColorT[T] {
+
+	if this[3] == 0 {
+		this[0], this[1], this[2] = 0, 0, 0
+		return this
+	}
+
+	a := 1 / this[3]
+
+	this[0] *= a
+	this[1] *= a
+	this[2] *= a
+
+	return this
+}
+
+// composites src with dst by Porter-Duff operator op, all colors have straight alpha, saves in this
+func (this *ColorT[T]) Composite(src, dst *ColorT[T], op int) *ColorT[T] {
+	s, d := *src, *dst
+
+	s.Premultiply()
+	d.Premultiply()
+
+	return this.CompositePremultiplied(&s, &d, op).Unpremultiply()
+}
+
+// composites src with dst by Porter-Duff operator op, all colors are premultiplied, saves in this
+func (this *ColorT[T]) CompositePremultiplied(src, dst *ColorT[T], op int) *ColorT[T] {
+	as, ad := src[3], dst[3]
+	var fs, fd T
+
+	switch op {
+	case COMPOSITE_IN:
+		fs, fd = ad, 0
+	case COMPOSITE_OUT:
+		fs, fd = 1-ad, 0
+	case COMPOSITE_ATOP:
+		fs, fd = ad, 1-as
+	case COMPOSITE_XOR:
+		fs, fd = 1-ad, 1-as
+	default:
+		fs, fd = 1, 1-as
+	}
+
+	this[0] = src[0]*fs + dst[0]*fd
+	this[1] = src[1]*fs + dst[1]*fd
+	this[2] = src[2]*fs + dst[2]*fd
+	this[3] = as*fs + ad*fd
+
+	return this
+}
+
+// blends src onto backdrop by mode and composites the result over backdrop, all colors have straight alpha, saves in this
+func (this *ColorT[T]) Blend(src, backdrop *ColorT[T], mode int) *ColorT[T] {
+	as, ab := src[3], backdrop[3]
+	cs := [3]T{src[0], src[1], src[2]}
+	cb := [3]T{backdrop[0], backdrop[1], backdrop[2]}
+	mixed := blendRGB(cs, cb, mode)
+	a := as + ab*(1-as)
+
+	for i := range cs {
+		c := (1-ab)*cs[i] + ab*mixed[i]
+		this[i] = as*c + (1-as)*ab*cb[i]
+	}
+
+	this[3] = a
+
+	return this.Unpremultiply()
+}
+
+// returns source cs blended onto backdrop cb by mode
+func blendRGB[T Float](cs, cb [3]T, mode int) [3]T {
+
+	switch mode {
+	case BLEND_HUE:
+		return setLum(setSat(cs, sat(cb)), lum(cb))
+	case BLEND_SATURATION:
+		return setLum(setSat(cb, sat(cs)), lum(cb))
+	case BLEND_COLOR:
+		return setLum(cs, lum(cb))
+	case BLEND_LUMINOSITY:
+		return setLum(cb, lum(cs))
+	}
+
+	for i := range cs {
+		cs[i] = blendChannel(cs[i], cb[i], mode)
+	}
+
+	return cs
+}
+
+// returns source channel s blended onto backdrop channel b by separable mode
+func blendChannel[T Float](s, b T, mode int) T {
+
+	switch mode {
+	case BLEND_MULTIPLY:
+		return b * s
+	case BLEND_SCREEN:
+		return b + s - b*s
+	case BLEND_OVERLAY:
+		return blendChannel(b, s, BLEND_HARD_LIGHT)
+	case BLEND_DARKEN:
+		if b < s {
+			return b
+		}
+		return s
+	case BLEND_LIGHTEN:
+		if b > s {
+			return b
+		}
+		return s
+	case BLEND_COLOR_DODGE:
+		if b == 0 {
+			return 0
+		}
+		if s >= 1 {
+			return 1
+		}
+		if b >= 1-s {
+			return 1
+		}
+		return b / (1 - s)
+	case BLEND_COLOR_BURN:
+		if b == 1 {
+			return 1
+		}
+		if s <= 0 {
+			return 0
+		}
+		if 1-b >= s {
+			return 0
+		}
+		return 1 - (1-b)/s
+	case BLEND_HARD_LIGHT:
+		if s <= 0.5 {
+			return b * 2 * s
+		}
+		s = 2*s - 1
+		return b + s - b*s
+	case BLEND_SOFT_LIGHT:
+		if s <= 0.5 {
+			return b - (1-2*s)*b*(1-b)
+		}
+		d := T(math.Sqrt(float64(b)))
+		if b <= 0.25 {
+			d = ((16*b-12)*b + 4) * b
+		}
+		return b + (2*s-1)*(d-b)
+	case BLEND_DIFFERENCE:
+		return abs(b - s)
+	case BLEND_EXCLUSION:
+		return b + s - 2*b*s
+	}
+
+	return s
+}
+
+// returns luminosity of c for non separable blend modes
+func lum[T Float](c [3]T) T {
+
+	return 0.3*c[0] + 0.59*c[1] + 0.11*c[2]
+}
+
+// returns c with luminosity l, clipped into 0 to 1 keeping l
+func setLum[T Float](c [3]T, l T) [3]T {
+	d := l - lum(c)
+
+	c[0] += d
+	c[1] += d
+	c[2] += d
+
+	l = lum(c)
+	max, min := rgbMaxMin(c[0], c[1], c[2])
+
+	for i := range c {
+		if min < 0 {
+			c[i] = l + (c[i]-l)*l/(l-min)
+		}
+		if max > 1 {
+			c[i] = l + (c[i]-l)*(1-l)/(max-l)
+		}
+	}
+
+	return c
+}
+
+// returns saturation of c for non separable blend modes
+func sat[T Float](c [3]T) T {
+	max, min := rgbMaxMin(c[0], c[1], c[2])
+
+	return max - min
+}
+
+// returns c with saturation s
+func setSat[T Float](c [3]T, s T) [3]T {
+	lo, mid, hi := 0, 1, 2
+
+	if c[lo] > c[mid] {
+		lo, mid = mid, lo
+	}
+	if c[mid] > c[hi] {
+		mid, hi = hi, mid
+	}
+	if c[lo] > c[mid] {
+		lo, mid = mid, lo
+	}
+
+	if c[hi] > c[lo] {
+		c[mid] = (c[mid] - c[lo]) * s / (c[hi] - c[lo])
+		c[hi] = s
+	} else {
+		c[mid], c[hi] = 0, 0
+	}
+	c[lo] = 0
+
+	return c
+}
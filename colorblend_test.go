@@ -0,0 +1,156 @@
+package mathf
+
+import (
+	"testing"
+)
+
+func TestColorComposite(t *testing.T) {
+	red, blue := Color{1, 0, 0, 1}, Color{0, 0, 1, 1}
+	clear := Color{0, 0, 0, 0}
+
+	tests := []struct {
+		name     string
+		op       int
+		src, dst Color
+		want     Color
+	}{
+		{"over opaque", COMPOSITE_OVER, red, blue, red},
+		{"over clear src", COMPOSITE_OVER, clear, blue, blue},
+		{"over clear dst", COMPOSITE_OVER, red, clear, red},
+		{"over half", COMPOSITE_OVER, Color{1, 0, 0, 0.5}, blue, Color{0.5, 0, 0.5, 1}},
+		{"in opaque", COMPOSITE_IN, red, blue, red},
+		{"in clear dst", COMPOSITE_IN, red, clear, clear},
+		{"in clear src", COMPOSITE_IN, clear, blue, clear},
+		{"out opaque", COMPOSITE_OUT, red, blue, clear},
+		{"out clear dst", COMPOSITE_OUT, red, clear, red},
+		{"atop opaque", COMPOSITE_ATOP, red, blue, red},
+		{"atop clear src", COMPOSITE_ATOP, clear, blue, blue},
+		{"atop clear dst", COMPOSITE_ATOP, red, clear, clear},
+		{"xor opaque", COMPOSITE_XOR, red, blue, clear},
+		{"xor clear dst", COMPOSITE_XOR, red, clear, red},
+		{"xor clear src", COMPOSITE_XOR, clear, blue, blue},
+		{"xor half", COMPOSITE_XOR, Color{1, 0, 0, 0.5}, Color{0, 0, 1, 0.5}, Color{0.5, 0, 0.5, 0.5}},
+	}
+
+	for _, test := range tests {
+		if got := new(Color).Composite(&test.src, &test.dst, test.op); !got.EqualsTol(&test.want, 1e-6) {
+			t.Errorf("%s Composite(%v, %v) = %v, want %v", test.name, test.src, test.dst, *got, test.want)
+		}
+	}
+}
+
+func TestColorPremultiply(t *testing.T) {
+	c := Color{0.8, 0.4, 0.2, 0.5}
+	want := Color{0.4, 0.2, 0.1, 0.5}
+
+	if got := c.Clone().Premultiply(); !got.EqualsTol(&want, 1e-6) {
+		t.Errorf("%v.Premultiply() = %v, want %v", c, *got, want)
+	}
+	if got := want.Clone().Unpremultiply(); !got.EqualsTol(&c, 1e-6) {
+		t.Errorf("%v.Unpremultiply() = %v, want %v", want, *got, c)
+	}
+	if got := (&Color{0.3, 0.2, 0.1, 0}).Unpremultiply(); !got.Equals(&Color{0, 0, 0, 0}) {
+		t.Errorf("Unpremultiply() with zero alpha = %v, want zero", *got)
+	}
+}
+
+func TestColorBlendSeparable(t *testing.T) {
+	// W3C compositing and blending level 1 formulas evaluated by hand for each channel
+	backdrop := Color{0.2, 0.5, 0.8, 1}
+	src := Color{0.6, 0.3, 0.9, 1}
+
+	tests := []struct {
+		name string
+		mode int
+		want Color
+	}{
+		{"normal", BLEND_NORMAL, Color{0.6, 0.3, 0.9, 1}},
+		{"multiply", BLEND_MULTIPLY, Color{0.12, 0.15, 0.72, 1}},
+		{"screen", BLEND_SCREEN, Color{0.68, 0.65, 0.98, 1}},
+		{"overlay", BLEND_OVERLAY, Color{0.24, 0.3, 0.96, 1}},
+		{"darken", BLEND_DARKEN, Color{0.2, 0.3, 0.8, 1}},
+		{"lighten", BLEND_LIGHTEN, Color{0.6, 0.5, 0.9, 1}},
+		{"color dodge", BLEND_COLOR_DODGE, Color{0.5, 0.5 / 0.7, 1, 1}},
+		{"color burn", BLEND_COLOR_BURN, Color{0, 0, 1 - 0.2/0.9, 1}},
+		{"hard light", BLEND_HARD_LIGHT, Color{0.36, 0.3, 0.96, 1}},
+		{"soft light", BLEND_SOFT_LIGHT, Color{0.2496, 0.4, 0.87554175, 1}},
+		{"difference", BLEND_DIFFERENCE, Color{0.4, 0.2, 0.1, 1}},
+		{"exclusion", BLEND_EXCLUSION, Color{0.56, 0.5, 0.26, 1}},
+	}
+
+	for _, test := range tests {
+		if got := new(Color).Blend(&src, &backdrop, test.mode); !got.EqualsTol(&test.want, 1e-5) {
+			t.Errorf("%s Blend(%v, %v) = %v, want %v", test.name, src, backdrop, *got, test.want)
+		}
+	}
+
+	edges := []struct {
+		name string
+		mode int
+		s, b float32
+		want float32
+	}{
+		{"color dodge black backdrop", BLEND_COLOR_DODGE, 1, 0, 0},
+		{"color dodge white source", BLEND_COLOR_DODGE, 1, 0.5, 1},
+		{"color burn white backdrop", BLEND_COLOR_BURN, 0, 1, 1},
+		{"color burn black source", BLEND_COLOR_BURN, 0, 0.5, 0},
+		{"color burn partial", BLEND_COLOR_BURN, 0.8, 0.6, 0.5},
+		{"soft light dark source", BLEND_SOFT_LIGHT, 0.25, 0.6, 0.48},
+	}
+
+	for _, test := range edges {
+		if got := blendChannel(test.s, test.b, test.mode); !EqualsTol(got, test.want, 1e-6) {
+			t.Errorf("%s blendChannel(%v, %v) = %v, want %v", test.name, test.s, test.b, got, test.want)
+		}
+	}
+}
+
+func TestColorBlendNonSeparable(t *testing.T) {
+	// W3C SetLum, SetSat and ClipColor evaluated by hand
+	tests := []struct {
+		name          string
+		mode          int
+		src, backdrop Color
+		want          Color
+	}{
+		{"hue", BLEND_HUE, Color{0, 0, 1, 1}, Color{0.8, 0.4, 0.4, 1}, Color{0.476, 0.476, 0.876, 1}},
+		{"saturation", BLEND_SATURATION, Color{0.8, 0.4, 0.4, 1}, Color{0, 0, 1, 1}, Color{0.066, 0.066, 0.466, 1}},
+		{"saturation gray backdrop", BLEND_SATURATION, Color{1, 0, 0, 1}, Color{0.5, 0.5, 0.5, 1}, Color{0.5, 0.5, 0.5, 1}},
+		{"color", BLEND_COLOR, Color{1, 0, 0, 1}, Color{0.5, 0.5, 0.5, 1}, Color{1, 2.0 / 7, 2.0 / 7, 1}},
+		{"luminosity", BLEND_LUMINOSITY, Color{0.5, 0.5, 0.5, 1}, Color{1, 0, 0, 1}, Color{1, 2.0 / 7, 2.0 / 7, 1}},
+		{"luminosity clips low", BLEND_LUMINOSITY, Color{0, 0, 0, 1}, Color{0, 0, 1, 1}, Color{0, 0, 0, 1}},
+	}
+
+	for _, test := range tests {
+		if got := new(Color).Blend(&test.src, &test.backdrop, test.mode); !got.EqualsTol(&test.want, 1e-5) {
+			t.Errorf("%s Blend(%v, %v) = %v, want %v", test.name, test.src, test.backdrop, *got, test.want)
+		}
+	}
+}
+
+func TestColorBlendAlpha(t *testing.T) {
+	backdrop := Color{0.2, 0.5, 0.8, 1}
+
+	// a transparent source leaves the backdrop unchanged for every mode
+	for mode := BLEND_NORMAL; mode <= BLEND_LUMINOSITY; mode++ {
+		if got := new(Color).Blend(&Color{0.6, 0.3, 0.9, 0}, &backdrop, mode); !got.EqualsTol(&backdrop, 1e-6) {
+			t.Errorf("mode %d Blend(transparent, %v) = %v, want backdrop", mode, backdrop, *got)
+		}
+	}
+
+	// over a transparent backdrop the source is used as is
+	src := Color{0.6, 0.3, 0.9, 0.5}
+
+	for mode := BLEND_NORMAL; mode <= BLEND_LUMINOSITY; mode++ {
+		if got := new(Color).Blend(&src, &Color{0.1, 0.7, 0.4, 0}, mode); !got.EqualsTol(&src, 1e-6) {
+			t.Errorf("mode %d Blend(%v, transparent) = %v, want source", mode, src, *got)
+		}
+	}
+
+	// half alpha multiply mixes the blended color with the backdrop
+	want := Color{0.5 * (0.12 + 0.2), 0.5 * (0.15 + 0.5), 0.5 * (0.72 + 0.8), 1}
+
+	if got := new(Color).Blend(&Color{0.6, 0.3, 0.9, 0.5}, &backdrop, BLEND_MULTIPLY); !got.EqualsTol(&want, 1e-6) {
+		t.Errorf("half alpha multiply = %v, want %v", *got, want)
+	}
+}
@@ -0,0 +1,372 @@
+package mathf
+
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+	"strings"
+)
+
+// image/color Model converting any color.Color to Color
+var ColorModel color.Model = color.ModelFunc(colorModel)
+
+// converts c to Color for ColorModel
+func colorModel(c color.Color) color.Color {
+
+	if _, ok := c.(*Color); ok {
+		return c
+	}
+
+	return new(Color).FromColor(c)
+}
+
+// CSS named colors as 0xRRGGBB
+var colorNames = map[string]uint32{
+	"aliceblue":            0xf0f8ff,
+	"antiquewhite":         0xfaebd7,
+	"aqua":                 0x00ffff,
+	"aquamarine":           0x7fffd4,
+	"azure":                0xf0ffff,
+	"beige":                0xf5f5dc,
+	"bisque":               0xffe4c4,
+	"black":                0x000000,
+	"blanchedalmond":       0xffebcd,
+	"blue":                 0x0000ff,
+	"blueviolet":           0x8a2be2,
+	"brown":                0xa52a2a,
+	"burlywood":            0xdeb887,
+	"cadetblue":            0x5f9ea0,
+	"chartreuse":           0x7fff00,
+	"chocolate":            0xd2691e,
+	"coral":                0xff7f50,
+	"cornflowerblue":       0x6495ed,
+	"cornsilk":             0xfff8dc,
+	"crimson":              0xdc143c,
+	"cyan":                 0x00ffff,
+	"darkblue":             0x00008b,
+	"darkcyan":             0x008b8b,
+	"darkgoldenrod":        0xb8860b,
+	"darkgray":             0xa9a9a9,
+	"darkgreen":            0x006400,
+	"darkgrey":             0xa9a9a9,
+	"darkkhaki":            0xbdb76b,
+	"darkmagenta":          0x8b008b,
+	"darkolivegreen":       0x556b2f,
+	"darkorange":           0xff8c00,
+	"darkorchid":           0x9932cc,
+	"darkred":              0x8b0000,
+	"darksalmon":           0xe9967a,
+	"darkseagreen":         0x8fbc8f,
+	"darkslateblue":        0x483d8b,
+	"darkslategray":        0x2f4f4f,
+	"darkslategrey":        0x2f4f4f,
+	"darkturquoise":        0x00ced1,
+	"darkviolet":           0x9400d3,
+	"deeppink":             0xff1493,
+	"deepskyblue":          0x00bfff,
+	"dimgray":              0x696969,
+	"dimgrey":              0x696969,
+	"dodgerblue":           0x1e90ff,
+	"firebrick":            0xb22222,
+	"floralwhite":          0xfffaf0,
+	"forestgreen":          0x228b22,
+	"fuchsia":              0xff00ff,
+	"gainsboro":            0xdcdcdc,
+	"ghostwhite":           0xf8f8ff,
+	"gold":                 0xffd700,
+	"goldenrod":            0xdaa520,
+	"gray":                 0x808080,
+	"green":                0x008000,
+	"greenyellow":          0xadff2f,
+	"grey":                 0x808080,
+	"honeydew":             0xf0fff0,
+	"hotpink":              0xff69b4,
+	"indianred":            0xcd5c5c,
+	"indigo":               0x4b0082,
+	"ivory":                0xfffff0,
+	"khaki":                0xf0e68c,
+	"lavender":             0xe6e6fa,
+	"lavenderblush":        0xfff0f5,
+	"lawngreen":            0x7cfc00,
+	"lemonchiffon":         0xfffacd,
+	"lightblue":            0xadd8e6,
+	"lightcoral":           0xf08080,
+	"lightcyan":            0xe0ffff,
+	"lightgoldenrodyellow": 0xfafad2,
+	"lightgray":            0xd3d3d3,
+	"lightgreen":           0x90ee90,
+	"lightgrey":            0xd3d3d3,
+	"lightpink":            0xffb6c1,
+	"lightsalmon":          0xffa07a,
+	"lightseagreen":        0x20b2aa,
+	"lightskyblue":         0x87cefa,
+	"lightslategray":       0x778899,
+	"lightslategrey":       0x778899,
+	"lightsteelblue":       0xb0c4de,
+	"lightyellow":          0xffffe0,
+	"lime":                 0x00ff00,
+	"limegreen":            0x32cd32,
+	"linen":                0xfaf0e6,
+	"magenta":              0xff00ff,
+	"maroon":               0x800000,
+	"mediumaquamarine":     0x66cdaa,
+	"mediumblue":           0x0000cd,
+	"mediumorchid":         0xba55d3,
+	"mediumpurple":         0x9370db,
+	"mediumseagreen":       0x3cb371,
+	"mediumslateblue":      0x7b68ee,
+	"mediumspringgreen":    0x00fa9a,
+	"mediumturquoise":      0x48d1cc,
+	"mediumvioletred":      0xc71585,
+	"midnightblue":         0x191970,
+	"mintcream":            0xf5fffa,
+	"mistyrose":            0xffe4e1,
+	"moccasin":             0xffe4b5,
+	"navajowhite":          0xffdead,
+	"navy":                 0x000080,
+	"oldlace":              0xfdf5e6,
+	"olive":                0x808000,
+	"olivedrab":            0x6b8e23,
+	"orange":               0xffa500,
+	"orangered":            0xff4500,
+	"orchid":               0xda70d6,
+	"palegoldenrod":        0xeee8aa,
+	"palegreen":            0x98fb98,
+	"paleturquoise":        0xafeeee,
+	"palevioletred":        0xdb7093,
+	"papayawhip":           0xffefd5,
+	"peachpuff":            0xffdab9,
+	"peru":                 0xcd853f,
+	"pink":                 0xffc0cb,
+	"plum":                 0xdda0dd,
+	"powderblue":           0xb0e0e6,
+	"purple":               0x800080,
+	"rebeccapurple":        0x663399,
+	"red":                  0xff0000,
+	"rosybrown":            0xbc8f8f,
+	"royalblue":            0x4169e1,
+	"saddlebrown":          0x8b4513,
+	"salmon":               0xfa8072,
+	"sandybrown":           0xf4a460,
+	"seagreen":             0x2e8b57,
+	"seashell":             0xfff5ee,
+	"sienna":               0xa0522d,
+	"silver":               0xc0c0c0,
+	"skyblue":              0x87ceeb,
+	"slateblue":            0x6a5acd,
+	"slategray":            0x708090,
+	"slategrey":            0x708090,
+	"snow":                 0xfffafa,
+	"springgreen":          0x00ff7f,
+	"steelblue":            0x4682b4,
+	"tan":                  0xd2b48c,
+	"teal":                 0x008080,
+	"thistle":              0xd8bfd8,
+	"tomato":               0xff6347,
+	"turquoise":            0x40e0d0,
+	"violet":               0xee82ee,
+	"wheat":                0xf5deb3,
+	"white":                0xffffff,
+	"whitesmoke":           0xf5f5f5,
+	"yellow":               0xffff00,
+	"yellowgreen":          0x9acd32,
+}
+
+// returns x clamped between 0 and 1 scaled to 0 to max and rounded
+func quantize[T Float](x T, max uint32) uint32 {
+
+	return uint32(clamp01(x)*T(max) + 0.5)
+}
+
+// returns bits of x shifted down by shift as 0 to 1 with max the largest value
+func dequantize[T Float](x uint32, shift int, max uint32) T {
+
+	return T((x>>shift)&max) / T(max)
+}
+
+// returns this as 0xRRGGBBAA with 8 bits per element
+func (this *ColorT[T]) PackRGBA() uint32 {
+
+	return quantize(this[0], 255)<<24 | quantize(this[1], 255)<<16 | quantize(this[2], 255)<<8 | quantize(this[3], 255)
+}
+
+// sets this from 0xRRGGBBAA with 8 bits per element
+func (this *ColorT[T]) UnpackRGBA(x uint32) *ColorT[T] {
+
+	return this.Set(dequantize[T](x, 24, 255), dequantize[T](x, 16, 255), dequantize[T](x, 8, 255), dequantize[T](x, 0, 255))
+}
+
+// returns this as 0xAARRGGBB with 8 bits per element
+func (this *ColorT[T]) PackARGB() uint32 {
+
+	return quantize(this[3], 255)<<24 | quantize(this[0], 255)<<16 | quantize(this[1], 255)<<8 | quantize(this[2], 255)
+}
+
+// sets this from 0xAARRGGBB with 8 bits per element
+func (this *ColorT[T]) UnpackARGB(x uint32) *ColorT[T] {
+
+	return this.Set(dequantize[T](x, 16, 255), dequantize[T](x, 8, 255), dequantize[T](x, 0, 255), dequantize[T](x, 24, 255))
+}
+
+// returns this as 0xBBGGRRAA with 8 bits per element
+func (this *ColorT[T]) PackBGRA() uint32 {
+
+	return quantize(this[2], 255)<<24 | quantize(this[1], 255)<<16 | quantize(this[0], 255)<<8 | quantize(this[3], 255)
+}
+
+// sets this from 0xBBGGRRAA with 8 bits per element
+func (this *ColorT[T]) UnpackBGRA(x uint32) *ColorT[T] {
+
+	return this.Set(dequantize[T](x, 8, 255), dequantize[T](x, 16, 255), dequantize[T](x, 24, 255), dequantize[T](x, 0, 255))
+}
+
+// returns rgb of this as 5 bits red in the high bits, 6 bits green and 5 bits blue
+func (this *ColorT[T]) PackRGB565() uint16 {
+
+	return uint16(quantize(this[0], 31)<<11 | quantize(this[1], 63)<<5 | quantize(this[2], 31))
+}
+
+// sets rgb of this from 5 bits red in the high bits, 6 bits green and 5 bits blue, alpha is set to 1
+func (this *ColorT[T]) UnpackRGB565(x uint16) *ColorT[T] {
+	v := uint32(x)
+
+	return this.Set(dequantize[T](v, 11, 31), dequantize[T](v, 5, 63), dequantize[T](v, 0, 31), 1)
+}
+
+// returns this as 0xRGBA with 4 bits per element
+func (this *ColorT[T]) PackRGBA4444() uint16 {
+
+	return uint16(quantize(this[0], 15)<<12 | quantize(this[1], 15)<<8 | quantize(this[2], 15)<<4 | quantize(this[3], 15))
+}
+
+// sets this from 0xRGBA with 4 bits per element
+func (this *ColorT[T]) UnpackRGBA4444(x uint16) *ColorT[T] {
+	v := uint32(x)
+
+	return this.Set(dequantize[T](v, 12, 15), dequantize[T](v, 8, 15), dequantize[T](v, 4, 15), dequantize[T](v, 0, 15))
+}
+
+// returns this with 10 bits red in the low bits, 10 bits green, 10 bits blue and 2 bits alpha in the high bits
+func (this *ColorT[T]) PackRGB10A2() uint32 {
+
+	return quantize(this[3], 3)<<30 | quantize(this[2], 1023)<<20 | quantize(this[1], 1023)<<10 | quantize(this[0], 1023)
+}
+
+// sets this from 10 bits red in the low bits, 10 bits green, 10 bits blue and 2 bits alpha in the high bits
+func (this *ColorT[T]) UnpackRGB10A2(x uint32) *ColorT[T] {
+
+	return this.Set(dequantize[T](x, 0, 1023), dequantize[T](x, 10, 1023), dequantize[T](x, 20, 1023), dequantize[T](x, 30, 3))
+}
+
+// returns new Color from CSS hex #rgb, #rgba, #rrggbb, #rrggbbaa or a CSS color name
+func ParseColor(s string) (*Color, error) {
+	this := new(Color)
+
+	if err := this.Parse(s); err != nil {
+		return nil, err
+	}
+
+	return this, nil
+}
+
+// sets this from CSS hex #rgb, #rgba, #rrggbb, #rrggbbaa or a CSS color name, this is unchanged on error
+func (this *ColorT[T]) Parse(s string) error {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	if !strings.HasPrefix(s, "#") {
+		if s == "transparent" {
+			this.Set(0, 0, 0, 0)
+			return nil
+		}
+		if rgb, ok := colorNames[s]; ok {
+			this.UnpackRGBA(rgb<<8 | 0xff)
+			return nil
+		}
+
+		return fmt.Errorf("mathf: unknown color name %q", s)
+	}
+
+	hex := s[1:]
+	x, err := strconv.ParseUint(hex, 16, 32)
+
+	if err != nil {
+		return fmt.Errorf("mathf: invalid hex color %q", s)
+	}
+
+	switch len(hex) {
+	case 3:
+		x = x<<4 | 0xf
+		fallthrough
+	case 4:
+		x = (x&0xf000)<<16 | (x&0xff00)<<12 | (x&0x0ff0)<<8 | (x&0x00ff)<<4 | x&0x000f
+	case 6:
+		x = x<<8 | 0xff
+	case 8:
+	default:
+		return fmt.Errorf("mathf: invalid hex color %q", s)
+	}
+
+	this.UnpackRGBA(uint32(x))
+
+	return nil
+}
+
+// returns this as CSS hex #rrggbb, or #rrggbbaa if alpha is not 1
+func (this *ColorT[T]) Hex() string {
+	x := this.PackRGBA()
+
+	if x&0xff == 0xff {
+		return fmt.Sprintf("#%06x", x>>8)
+	}
+
+	return fmt.Sprintf("#%08x", x)
+}
+
+// returns alpha premultiplied 16 bit rgba of this clamped between 0 and 1, implements image/color Color
+func (this *ColorT[T]) RGBA() (r, g, b, a uint32) {
+	alpha := clamp01(this[3])
+
+	r = quantize(clamp01(this[0])*alpha, 0xffff)
+	g = quantize(clamp01(this[1])*alpha, 0xffff)
+	b = quantize(clamp01(this[2])*alpha, 0xffff)
+	a = quantize(alpha, 0xffff)
+
+	return r, g, b, a
+}
+
+// returns this as an 8 bit non premultiplied image/color NRGBA
+func (this *ColorT[T]) NRGBA() color.NRGBA {
+
+	return color.NRGBA{uint8(quantize(this[0], 255)), uint8(quantize(this[1], 255)), uint8(quantize(this[2], 255)), uint8(quantize(this[3], 255))}
+}
+
+// returns this as a 16 bit non premultiplied image/color NRGBA64
+func (this *ColorT[T]) NRGBA64() color.NRGBA64 {
+
+	return color.NRGBA64{uint16(quantize(this[0], 0xffff)), uint16(quantize(this[1], 0xffff)), uint16(quantize(this[2], 0xffff)), uint16(quantize(this[3], 0xffff))}
+}
+
+// sets this from an image/color Color, premultiplied colors are unpremultiplied
+func (this *ColorT[T]) FromColor(c color.Color) *ColorT[T] {
+
+	switch c := c.(type) {
+	case *Color:
+		return this.Set(T(c[0]), T(c[1]), T(c[2]), T(c[3]))
+	case *Colord:
+		return this.Set(T(c[0]), T(c[1]), T(c[2]), T(c[3]))
+	case color.NRGBA:
+		return this.Set(T(c.R)/255, T(c.G)/255, T(c.B)/255, T(c.A)/255)
+	case color.NRGBA64:
+		return this.Set(T(c.R)/0xffff, T(c.G)/0xffff, T(c.B)/0xffff, T(c.A)/0xffff)
+	}
+
+	r, g, b, a := c.RGBA()
+
+	if a == 0 {
+		return this.Set(0, 0, 0, 0)
+	}
+
+	alpha := T(a)
+
+	return this.Set(T(r)/alpha, T(g)/alpha, T(b)/alpha, alpha/0xffff)
+}
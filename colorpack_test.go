@@ -0,0 +1,194 @@
+package mathf
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestColorPackRoundTrip(t *testing.T) {
+	c := Color{1, 0.5, 0.25, 0.75}
+
+	tests := []struct {
+		name   string
+		packed uint32
+		want   uint32
+		unpack func(c *Color, x uint32) *Color
+		tol    float32
+	}{
+		{"RGBA", uint32(c.PackRGBA()), 0xff8040bf, func(c *Color, x uint32) *Color { return c.UnpackRGBA(x) }, 1.0 / 255},
+		{"ARGB", uint32(c.PackARGB()), 0xbfff8040, func(c *Color, x uint32) *Color { return c.UnpackARGB(x) }, 1.0 / 255},
+		{"BGRA", uint32(c.PackBGRA()), 0x4080ffbf, func(c *Color, x uint32) *Color { return c.UnpackBGRA(x) }, 1.0 / 255},
+		{"RGBA4444", uint32(c.PackRGBA4444()), 0xf84b, func(c *Color, x uint32) *Color { return c.UnpackRGBA4444(uint16(x)) }, 1.0 / 15},
+		{"RGB10A2", uint32(c.PackRGB10A2()), 2<<30 | 256<<20 | 512<<10 | 1023, func(c *Color, x uint32) *Color { return c.UnpackRGB10A2(x) }, 1.0 / 3},
+	}
+
+	for _, test := range tests {
+		if test.packed != test.want {
+			t.Errorf("%v.Pack%s() = %#x, want %#x", c, test.name, test.packed, test.want)
+		}
+		if got := test.unpack(new(Color), test.packed); !got.EqualsTol(&c, test.tol) {
+			t.Errorf("Unpack%s(%#x) = %v, want %v", test.name, test.packed, got, c)
+		}
+	}
+
+	// every 8 bit value survives an unpack and pack unchanged
+	for x := uint32(0); x < 256; x++ {
+		v := x<<24 | (255-x)<<16 | x<<8 | 255 - x
+
+		if got := new(Color).UnpackRGBA(v).PackRGBA(); got != v {
+			t.Errorf("UnpackRGBA(%#x).PackRGBA() = %#x", v, got)
+		}
+	}
+
+	if got := (&Color{1, 0, 1, 1}).PackRGB565(); got != 0xf81f {
+		t.Errorf("Color{1, 0, 1, 1}.PackRGB565() = %#x, want 0xf81f", got)
+	}
+	if got, want := new(Color).UnpackRGB565(0x07e0), (Color{0, 1, 0, 1}); !got.Equals(&want) {
+		t.Errorf("UnpackRGB565(0x07e0) = %v, want %v", got, want)
+	}
+	if got := (&Color{2, -1, 0.5, 1}).PackRGBA(); got != 0xff0080ff {
+		t.Errorf("Color{2, -1, 0.5, 1}.PackRGBA() = %#x, want 0xff0080ff", got)
+	}
+}
+
+func TestColorParse(t *testing.T) {
+	tests := []struct {
+		s    string
+		want uint32
+	}{
+		{"#f80", 0xff8800ff},
+		{"#F80", 0xff8800ff},
+		{"#f808", 0xff880088},
+		{"#ff8000", 0xff8000ff},
+		{"  #FF8000 ", 0xff8000ff},
+		{"#ff800080", 0xff800080},
+		{"red", 0xff0000ff},
+		{"Red", 0xff0000ff},
+		{"cornflowerblue", 0x6495edff},
+		{"rebeccapurple", 0x663399ff},
+		{"grey", 0x808080ff},
+		{"transparent", 0x00000000},
+	}
+
+	for _, test := range tests {
+		c, err := ParseColor(test.s)
+
+		if err != nil {
+			t.Errorf("ParseColor(%q) error %v", test.s, err)
+			continue
+		}
+		if got := c.PackRGBA(); got != test.want {
+			t.Errorf("ParseColor(%q) = %#x, want %#x", test.s, got, test.want)
+		}
+	}
+
+	for _, s := range []string{"", "#", "#12", "#12345", "#1234567", "#123456789", "#ggg", "notacolor", "ff8000"} {
+		c := Color{0.1, 0.2, 0.3, 0.4}
+		before := c
+
+		if err := c.Parse(s); err == nil {
+			t.Errorf("Parse(%q) = nil error, want error", s)
+		}
+		if !c.Equals(&before) {
+			t.Errorf("Parse(%q) changed the color to %v", s, c)
+		}
+	}
+}
+
+func TestColorHex(t *testing.T) {
+	tests := []struct {
+		color Color
+		want  string
+	}{
+		{Color{1, 0.5, 0, 1}, "#ff8000"},
+		{Color{0, 0, 0, 1}, "#000000"},
+		{Color{1, 1, 1, 0.5}, "#ffffff80"},
+		{Color{0, 0, 0, 0}, "#00000000"},
+	}
+
+	for _, test := range tests {
+		if got := test.color.Hex(); got != test.want {
+			t.Errorf("%v.Hex() = %v, want %v", test.color, got, test.want)
+		}
+
+		c, err := ParseColor(test.want)
+
+		if err != nil || c.Hex() != test.want {
+			t.Errorf("ParseColor(%q).Hex() = %v, %v", test.want, c, err)
+		}
+	}
+}
+
+func TestColorRGBA(t *testing.T) {
+	tests := []struct {
+		color      Color
+		r, g, b, a uint32
+	}{
+		{Color{1, 1, 1, 1}, 0xffff, 0xffff, 0xffff, 0xffff},
+		{Color{1, 0.5, 0, 0.5}, 0x8000, 0x4000, 0, 0x8000},
+		{Color{1, 1, 1, 0}, 0, 0, 0, 0},
+		{Color{4, -1, 0.5, 0.5}, 0x8000, 0, 0x4000, 0x8000},
+		{Color{1, 1, 1, 2}, 0xffff, 0xffff, 0xffff, 0xffff},
+	}
+
+	for _, test := range tests {
+		r, g, b, a := test.color.RGBA()
+
+		if r != test.r || g != test.g || b != test.b || a != test.a {
+			t.Errorf("%v.RGBA() = %#x %#x %#x %#x, want %#x %#x %#x %#x", test.color, r, g, b, a, test.r, test.g, test.b, test.a)
+		}
+	}
+
+	// premultiplied channels never exceed alpha, even when the color is out of range
+	for _, c := range []Color{{4, 2, 1.5, 0.25}, {1, 1, 1, 0.1}, {-1, 8, 0.3, 0.9}, {0.2, 0.4, 0.6, 1}} {
+		r, g, b, a := c.RGBA()
+
+		if r > a || g > a || b > a {
+			t.Errorf("%v.RGBA() = %#x %#x %#x %#x, want r, g, b <= a", c, r, g, b, a)
+		}
+	}
+
+	var _ color.Color = &Color{}
+}
+
+func TestColorFromColor(t *testing.T) {
+	tests := []struct {
+		name string
+		c    color.Color
+		want Color
+	}{
+		{"RGBA", color.RGBA{0x80, 0x40, 0, 0x80}, Color{1, 0.5, 0, 128.0 / 255}},
+		{"RGBA64", color.RGBA64{0x4000, 0x2000, 0, 0x8000}, Color{0.5, 0.25, 0, 0x8000 / 65535.0}},
+		{"RGBA transparent", color.RGBA{0, 0, 0, 0}, Color{0, 0, 0, 0}},
+		{"NRGBA", color.NRGBA{0xff, 0x80, 0, 0x80}, Color{1, 128.0 / 255, 0, 128.0 / 255}},
+		{"NRGBA64", color.NRGBA64{0xffff, 0, 0x8000, 0xffff}, Color{1, 0, 0x8000 / 65535.0, 1}},
+		{"Gray", color.Gray{0x80}, Color{128.0 / 255, 128.0 / 255, 128.0 / 255, 1}},
+		{"Color", &Color{2, 0.5, -1, 0.25}, Color{2, 0.5, -1, 0.25}},
+		{"Colord", &Colord{0.1, 0.2, 0.3, 0.4}, Color{0.1, 0.2, 0.3, 0.4}},
+	}
+
+	for _, test := range tests {
+		if got := new(Color).FromColor(test.c); !got.EqualsTol(&test.want, 1e-4) {
+			t.Errorf("FromColor(%s %v) = %v, want %v", test.name, test.c, got, test.want)
+		}
+	}
+
+	// NRGBA keeps full precision rgb where premultiplied 8 bit would lose it
+	n := color.NRGBA{0x12, 0x34, 0x56, 0x01}
+
+	if got := new(Color).FromColor(n).NRGBA(); got != n {
+		t.Errorf("FromColor(%v).NRGBA() = %v, want %v", n, got, n)
+	}
+	if got := ColorModel.Convert(color.NRGBA{0xff, 0, 0, 0xff}); *got.(*Color) != (Color{1, 0, 0, 1}) {
+		t.Errorf("ColorModel.Convert(red) = %v, want %v", got, Color{1, 0, 0, 1})
+	}
+
+	c := &Color{0.5, 0.25, 1, 1}
+
+	if got := ColorModel.Convert(c); got != color.Color(c) {
+		t.Errorf("ColorModel.Convert(%v) = %v, want the same *Color", c, got)
+	}
+	if got, want := c.NRGBA64(), (color.NRGBA64{0x8000, 0x4000, 0xffff, 0xffff}); got != want {
+		t.Errorf("%v.NRGBA64() = %v, want %v", c, got, want)
+	}
+}
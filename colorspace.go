@@ -0,0 +1,403 @@
+package mathf
+
+import (
+	"math"
+)
+
+// D65 reference white of the linear sRGB to XYZ matrix
+const (
+	whiteX = 0.95047
+	whiteY = 1.0
+	whiteZ = 1.08883
+)
+
+// returns sRGB encoded x as linear
+func SRGBToLinear(x float32) float32 {
+
+	return srgbToLinear(x)
+}
+
+// returns sRGB encoded x as linear, negative values are mirrored
+func srgbToLinear[T Float](x T) T {
+	c := math.Abs(float64(x))
+
+	if c <= 0.04045 {
+		c /= 12.92
+	} else {
+		c = math.Pow((c+0.055)/1.055, 2.4)
+	}
+
+	return T(math.Copysign(c, float64(x)))
+}
+
+// returns linear x as sRGB encoded
+func LinearToSRGB(x float32) float32 {
+
+	return linearToSRGB(x)
+}
+
+// returns linear x as sRGB encoded, negative values are mirrored
+func linearToSRGB[T Float](x T) T {
+	c := math.Abs(float64(x))
+
+	if c <= 0.0031308 {
+		c *= 12.92
+	} else {
+		c = 1.055*math.Pow(c, 1/2.4) - 0.055
+	}
+
+	return T(math.Copysign(c, float64(x)))
+}
+
+// converts this from sRGB encoded to linear, alpha is unchanged
+func (this *ColorT[T]) ToLinear() *ColorT[T] {
+
+	this[0] = srgbToLinear(this[0])
+	this[1] = srgbToLinear(this[1])
+	this[2] = srgbToLinear(this[2])
+
+	return this
+}
+
+// converts this from linear to sRGB encoded, alpha is unchanged
+func (this *ColorT[T]) ToSRGB() *ColorT[T] {
+
+	this[0] = linearToSRGB(this[0])
+	this[1] = linearToSRGB(this[1])
+	this[2] = linearToSRGB(this[2])
+
+	return this
+}
+
+// returns hue in degrees, saturation and value of this, saves in target
+func (this *ColorT[T]) ToHSV(target *Vec3T[T]) *Vec3T[T] {
+	r, g, b := this[0], this[1], this[2]
+	max, min := rgbMaxMin(r, g, b)
+	s := T(0)
+
+	if max != 0 {
+		s = (max - min) / max
+	}
+
+	return target.Set(rgbHue(r, g, b, max, min), s, max)
+}
+
+// sets rgb of this from hue in degrees, saturation and value, alpha is unchanged
+func (this *ColorT[T]) FromHSV(hsv *Vec3T[T]) *ColorT[T] {
+	h, s, v := hsv[0], hsv[1], hsv[2]
+	c := v * s
+
+	return this.fromHueChroma(h, c, v-c)
+}
+
+// returns hue in degrees, saturation and lightness of this, saves in target
+func (this *ColorT[T]) ToHSL(target *Vec3T[T]) *Vec3T[T] {
+	r, g, b := this[0], this[1], this[2]
+	max, min := rgbMaxMin(r, g, b)
+	l := (max + min) * 0.5
+	s := T(0)
+
+	if d := max - min; d != 0 {
+		s = d / (1 - abs(2*l-1))
+	}
+
+	return target.Set(rgbHue(r, g, b, max, min), s, l)
+}
+
+// sets rgb of this from hue in degrees, saturation and lightness, alpha is unchanged
+func (this *ColorT[T]) FromHSL(hsl *Vec3T[T]) *ColorT[T] {
+	h, s, l := hsl[0], hsl[1], hsl[2]
+	c := (1 - abs(2*l-1)) * s
+
+	return this.fromHueChroma(h, c, l-c*0.5)
+}
+
+// sets rgb of this from hue in degrees, chroma and the amount added to each channel
+func (this *ColorT[T]) fromHueChroma(h, c, m T) *ColorT[T] {
+	h = T(math.Mod(float64(h), 360))
+
+	if h < 0 {
+		h += 360
+	}
+
+	h /= 60
+	x := c * (1 - abs(T(math.Mod(float64(h), 2))-1))
+	var r, g, b T
+
+	switch {
+	case h < 1:
+		r, g, b = c, x, 0
+	case h < 2:
+		r, g, b = x, c, 0
+	case h < 3:
+		r, g, b = 0, c, x
+	case h < 4:
+		r, g, b = 0, x, c
+	case h < 5:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+
+	this[0], this[1], this[2] = r+m, g+m, b+m
+
+	return this
+}
+
+// returns largest and smallest of r, g and b
+func rgbMaxMin[T Float](r, g, b T) (T, T) {
+	max, min := r, r
+
+	if g > max {
+		max = g
+	}
+	if b > max {
+		max = b
+	}
+	if g < min {
+		min = g
+	}
+	if b < min {
+		min = b
+	}
+
+	return max, min
+}
+
+// returns hue in degrees of r, g and b, 0 for grays
+func rgbHue[T Float](r, g, b, max, min T) T {
+	d := max - min
+	var h T
+
+	if d == 0 {
+		return 0
+	}
+
+	switch max {
+	case r:
+		h = (g - b) / d
+		if h < 0 {
+			h += 6
+		}
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+
+	return h * 60
+}
+
+// returns CIE XYZ of this, this should be linear, saves in target
+func (this *ColorT[T]) ToXYZ(target *Vec3T[T]) *Vec3T[T] {
+	r, g, b := this[0], this[1], this[2]
+
+	return target.Set(
+		0.4124564*r+0.3575761*g+0.1804375*b,
+		0.2126729*r+0.7151522*g+0.0721750*b,
+		0.0193339*r+0.1191920*g+0.9503041*b,
+	)
+}
+
+// sets rgb of this as linear from CIE XYZ, alpha is unchanged
+func (this *ColorT[T]) FromXYZ(xyz *Vec3T[T]) *ColorT[T] {
+	x, y, z := xyz[0], xyz[1], xyz[2]
+
+	this[0] = 3.2404542*x - 1.5371385*y - 0.4985314*z
+	this[1] = -0.9692660*x + 1.8760108*y + 0.0415560*z
+	this[2] = 0.0556434*x - 0.2040259*y + 1.0572252*z
+
+	return this
+}
+
+// returns CIELAB of this with a D65 white, this should be linear, saves in target
+func (this *ColorT[T]) ToLab(target *Vec3T[T]) *Vec3T[T] {
+	var xyz Vec3T[T]
+
+	this.ToXYZ(&xyz)
+
+	fx := labF(float64(xyz[0]) / whiteX)
+	fy := labF(float64(xyz[1]) / whiteY)
+	fz := labF(float64(xyz[2]) / whiteZ)
+
+	return target.Set(T(116*fy-16), T(500*(fx-fy)), T(200*(fy-fz)))
+}
+
+// sets rgb of this as linear from CIELAB with a D65 white, alpha is unchanged
+func (this *ColorT[T]) FromLab(lab *Vec3T[T]) *ColorT[T] {
+	fy := (float64(lab[0]) + 16) / 116
+	fx := fy + float64(lab[1])/500
+	fz := fy - float64(lab[2])/200
+	xyz := Vec3T[T]{T(labFInverse(fx) * whiteX), T(labFInverse(fy) * whiteY), T(labFInverse(fz) * whiteZ)}
+
+	return this.FromXYZ(&xyz)
+}
+
+// CIELAB companding function
+func labF(t float64) float64 {
+
+	if t > 216.0/24389 {
+		return math.Cbrt(t)
+	}
+
+	return t*24389/27/116 + 16.0/116
+}
+
+// inverse of labF
+func labFInverse(t float64) float64 {
+
+	if t > 6.0/29 {
+		return t * t * t
+	}
+
+	return (t*116 - 16) * 27 / 24389
+}
+
+// returns Oklab of this, this should be linear, saves in target
+func (this *ColorT[T]) ToOklab(target *Vec3T[T]) *Vec3T[T] {
+	r, g, b := float64(this[0]), float64(this[1]), float64(this[2])
+
+	l := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	m := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	s := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+
+	return target.Set(
+		T(0.2104542553*l+0.7936177850*m-0.0040720468*s),
+		T(1.9779984951*l-2.4285922050*m+0.4505937099*s),
+		T(0.0259040371*l+0.7827717662*m-0.8086757660*s),
+	)
+}
+
+// sets rgb of this as linear from Oklab, alpha is unchanged
+func (this *ColorT[T]) FromOklab(lab *Vec3T[T]) *ColorT[T] {
+	L, a, b := float64(lab[0]), float64(lab[1]), float64(lab[2])
+
+	l := L + 0.3963377774*a + 0.2158037573*b
+	m := L - 0.1055613458*a - 0.0638541728*b
+	s := L - 0.0894841775*a - 1.2914855480*b
+	l, m, s = l*l*l, m*m*m, s*s*s
+
+	this[0] = T(4.0767416621*l - 3.3077115913*m + 0.2309699292*s)
+	this[1] = T(-1.2684380046*l + 2.6097574011*m - 0.3413193965*s)
+	this[2] = T(-0.0041960863*l - 0.7034186147*m + 1.7076147010*s)
+
+	return this
+}
+
+// returns lightness, chroma and hue in degrees of Oklab of this, this should be linear, saves in target
+func (this *ColorT[T]) ToOkLCh(target *Vec3T[T]) *Vec3T[T] {
+
+	this.ToOklab(target)
+
+	return labToLCh(target)
+}
+
+// sets rgb of this as linear from lightness, chroma and hue in degrees of Oklab, alpha is unchanged
+func (this *ColorT[T]) FromOkLCh(lch *Vec3T[T]) *ColorT[T] {
+	lab := *lch
+
+	return this.FromOklab(lchToLab(&lab))
+}
+
+// converts lab to lightness, chroma and hue in degrees
+func labToLCh[T Float](lab *Vec3T[T]) *Vec3T[T] {
+	a, b := float64(lab[1]), float64(lab[2])
+	h := math.Atan2(b, a) * 180 / math.Pi
+
+	if h < 0 {
+		h += 360
+	}
+
+	return lab.Set(lab[0], T(math.Hypot(a, b)), T(h))
+}
+
+// converts lightness, chroma and hue in degrees to lab
+func lchToLab[T Float](lch *Vec3T[T]) *Vec3T[T] {
+	c, h := float64(lch[1]), float64(lch[2])*math.Pi/180
+
+	return lch.Set(lch[0], T(c*math.Cos(h)), T(c*math.Sin(h)))
+}
+
+// returns CIEDE2000 color difference of this and other, both should be linear
+func (this *ColorT[T]) DeltaE2000(other *ColorT[T]) T {
+	var a, b Vec3T[T]
+
+	return DeltaE2000Lab(this.ToLab(&a), other.ToLab(&b))
+}
+
+// returns CIEDE2000 color difference of CIELAB colors a and b
+func DeltaE2000Lab[T Float](a, b *Vec3T[T]) T {
+	const pow25_7 = 6103515625
+	l1, a1, b1 := float64(a[0]), float64(a[1]), float64(a[2])
+	l2, a2, b2 := float64(b[0]), float64(b[1]), float64(b[2])
+
+	cBar := (math.Hypot(a1, b1) + math.Hypot(a2, b2)) * 0.5
+	cBar7 := math.Pow(cBar, 7)
+	g := 0.5 * (1 - math.Sqrt(cBar7/(cBar7+pow25_7)))
+	a1, a2 = a1*(1+g), a2*(1+g)
+
+	c1, c2 := math.Hypot(a1, b1), math.Hypot(a2, b2)
+	h1, h2 := labHue(a1, b1), labHue(a2, b2)
+
+	dL := l2 - l1
+	dC := c2 - c1
+	dh := 0.0
+
+	if c1*c2 != 0 {
+		dh = h2 - h1
+
+		if dh > 180 {
+			dh -= 360
+		} else if dh < -180 {
+			dh += 360
+		}
+	}
+
+	dH := 2 * math.Sqrt(c1*c2) * math.Sin(dh*math.Pi/360)
+
+	lBar := (l1 + l2) * 0.5
+	cBar = (c1 + c2) * 0.5
+	hBar := h1 + h2
+
+	if c1*c2 != 0 {
+		if math.Abs(h1-h2) > 180 {
+			if hBar < 360 {
+				hBar += 360
+			} else {
+				hBar -= 360
+			}
+		}
+		hBar *= 0.5
+	}
+
+	rad := math.Pi / 180
+	t := 1 - 0.17*math.Cos((hBar-30)*rad) + 0.24*math.Cos(2*hBar*rad) + 0.32*math.Cos((3*hBar+6)*rad) - 0.20*math.Cos((4*hBar-63)*rad)
+	dTheta := 30 * math.Exp(-((hBar-275)/25)*((hBar-275)/25))
+	cBar7 = math.Pow(cBar, 7)
+	rC := 2 * math.Sqrt(cBar7/(cBar7+pow25_7))
+	l50 := (lBar - 50) * (lBar - 50)
+	sL := 1 + 0.015*l50/math.Sqrt(20+l50)
+	sC := 1 + 0.045*cBar
+	sH := 1 + 0.015*cBar*t
+	rT := -math.Sin(2*dTheta*rad) * rC
+
+	dL, dC, dH = dL/sL, dC/sC, dH/sH
+
+	return T(math.Sqrt(dL*dL + dC*dC + dH*dH + rT*dC*dH))
+}
+
+// returns hue angle in degrees from 0 to 360 of a and b
+func labHue(a, b float64) float64 {
+
+	if a == 0 && b == 0 {
+		return 0
+	}
+
+	h := math.Atan2(b, a) * 180 / math.Pi
+
+	if h < 0 {
+		h += 360
+	}
+
+	return h
+}
@@ -0,0 +1,151 @@
+package mathf
+
+import (
+	"testing"
+)
+
+func TestSRGBTransfer(t *testing.T) {
+	tests := []struct {
+		srgb, linear float32
+	}{
+		{0, 0},
+		{0.04045, 0.04045 / 12.92},
+		{0.5, 0.21404114},
+		{0.8, 0.6038273},
+		{1, 1},
+	}
+
+	for _, test := range tests {
+		if got := SRGBToLinear(test.srgb); !EqualsTol(got, test.linear, 1e-6) {
+			t.Errorf("SRGBToLinear(%v) = %v, want %v", test.srgb, got, test.linear)
+		}
+		if got := LinearToSRGB(test.linear); !EqualsTol(got, test.srgb, 1e-6) {
+			t.Errorf("LinearToSRGB(%v) = %v, want %v", test.linear, got, test.srgb)
+		}
+	}
+}
+
+func TestColorSpaceReference(t *testing.T) {
+	tests := []struct {
+		name       string
+		color      Color
+		hsv, hsl   Vec3
+		lab, oklab Vec3
+	}{
+		{"white", Color{1, 1, 1, 1}, Vec3{0, 0, 1}, Vec3{0, 0, 1}, Vec3{100, 0, 0}, Vec3{1, 0, 0}},
+		{"black", Color{0, 0, 0, 1}, Vec3{0, 0, 0}, Vec3{0, 0, 0}, Vec3{0, 0, 0}, Vec3{0, 0, 0}},
+		{"red", Color{1, 0, 0, 1}, Vec3{0, 1, 1}, Vec3{0, 1, 0.5}, Vec3{53.2408, 80.0925, 67.2032}, Vec3{0.627955, 0.224863, 0.125846}},
+		{"green", Color{0, 1, 0, 1}, Vec3{120, 1, 1}, Vec3{120, 1, 0.5}, Vec3{87.7347, -86.1827, 83.1793}, Vec3{0.866440, -0.233888, 0.179498}},
+		{"blue", Color{0, 0, 1, 1}, Vec3{240, 1, 1}, Vec3{240, 1, 0.5}, Vec3{32.2970, 79.1875, -107.8602}, Vec3{0.452014, -0.032457, -0.311528}},
+	}
+
+	for _, test := range tests {
+		var got Vec3
+
+		if test.color.ToHSV(&got); !got.EqualsTol(&test.hsv, 1e-5) {
+			t.Errorf("%s ToHSV = %v, want %v", test.name, got, test.hsv)
+		}
+		if test.color.ToHSL(&got); !got.EqualsTol(&test.hsl, 1e-5) {
+			t.Errorf("%s ToHSL = %v, want %v", test.name, got, test.hsl)
+		}
+		if test.color.ToLab(&got); !got.EqualsTol(&test.lab, 2e-3) {
+			t.Errorf("%s ToLab = %v, want %v", test.name, got, test.lab)
+		}
+		if test.color.ToOklab(&got); !got.EqualsTol(&test.oklab, 1e-5) {
+			t.Errorf("%s ToOklab = %v, want %v", test.name, got, test.oklab)
+		}
+	}
+}
+
+func TestColorSpaceRoundTrip(t *testing.T) {
+	colors := []Color{
+		{0, 0, 0, 1},
+		{1, 1, 1, 1},
+		{0.2, 0.4, 0.6, 0.5},
+		{0.9, 0.1, 0.3, 1},
+		{0.05, 0.8, 0.75, 0},
+		{0.5, 0.5, 0.5, 1},
+		{0.003, 0.001, 0.002, 1},
+	}
+
+	conversions := []struct {
+		name string
+		run  func(c *Color) *Color
+	}{
+		{"sRGB", func(c *Color) *Color { return c.Clone().ToSRGB().ToLinear() }},
+		{"HSV", func(c *Color) *Color { var v Vec3; return c.Clone().FromHSV(c.ToHSV(&v)) }},
+		{"HSL", func(c *Color) *Color { var v Vec3; return c.Clone().FromHSL(c.ToHSL(&v)) }},
+		{"XYZ", func(c *Color) *Color { var v Vec3; return c.Clone().FromXYZ(c.ToXYZ(&v)) }},
+		{"Lab", func(c *Color) *Color { var v Vec3; return c.Clone().FromLab(c.ToLab(&v)) }},
+		{"Oklab", func(c *Color) *Color { var v Vec3; return c.Clone().FromOklab(c.ToOklab(&v)) }},
+		{"OkLCh", func(c *Color) *Color { var v Vec3; return c.Clone().FromOkLCh(c.ToOkLCh(&v)) }},
+	}
+
+	for _, conversion := range conversions {
+		for _, c := range colors {
+			if got := conversion.run(&c); !got.EqualsTol(&c, 1e-5) {
+				t.Errorf("%s round trip of %v = %v", conversion.name, c, *got)
+			}
+		}
+	}
+}
+
+// CIEDE2000 test data from Sharma, Wu and Dalal, "The CIEDE2000 Color-Difference Formula:
+// Implementation Notes, Supplementary Test Data, and Mathematical Observations", 2005
+var deltaE2000Tests = []struct {
+	a, b Vec3d
+	want float64
+}{
+	{Vec3d{50.0000, 2.6772, -79.7751}, Vec3d{50.0000, 0.0000, -82.7485}, 2.0425},
+	{Vec3d{50.0000, 3.1571, -77.2803}, Vec3d{50.0000, 0.0000, -82.7485}, 2.8615},
+	{Vec3d{50.0000, 2.8361, -74.0200}, Vec3d{50.0000, 0.0000, -82.7485}, 3.4412},
+	{Vec3d{50.0000, -1.3802, -84.2814}, Vec3d{50.0000, 0.0000, -82.7485}, 1.0000},
+	{Vec3d{50.0000, -1.1848, -84.8006}, Vec3d{50.0000, 0.0000, -82.7485}, 1.0000},
+	{Vec3d{50.0000, -0.9009, -85.5211}, Vec3d{50.0000, 0.0000, -82.7485}, 1.0000},
+	{Vec3d{50.0000, 0.0000, 0.0000}, Vec3d{50.0000, -1.0000, 2.0000}, 2.3669},
+	{Vec3d{50.0000, -1.0000, 2.0000}, Vec3d{50.0000, 0.0000, 0.0000}, 2.3669},
+	{Vec3d{50.0000, 2.4900, -0.0010}, Vec3d{50.0000, -2.4900, 0.0009}, 7.1792},
+	{Vec3d{50.0000, 2.4900, -0.0010}, Vec3d{50.0000, -2.4900, 0.0010}, 7.1792},
+	{Vec3d{50.0000, 2.4900, -0.0010}, Vec3d{50.0000, -2.4900, 0.0011}, 7.2195},
+	{Vec3d{50.0000, 2.4900, -0.0010}, Vec3d{50.0000, -2.4900, 0.0012}, 7.2195},
+	{Vec3d{50.0000, -0.0010, 2.4900}, Vec3d{50.0000, 0.0009, -2.4900}, 4.8045},
+	{Vec3d{50.0000, -0.0010, 2.4900}, Vec3d{50.0000, 0.0010, -2.4900}, 4.8045},
+	{Vec3d{50.0000, -0.0010, 2.4900}, Vec3d{50.0000, 0.0011, -2.4900}, 4.7461},
+	{Vec3d{50.0000, 2.5000, 0.0000}, Vec3d{50.0000, 0.0000, -2.5000}, 4.3065},
+	{Vec3d{50.0000, 2.5000, 0.0000}, Vec3d{73.0000, 25.0000, -18.0000}, 27.1492},
+	{Vec3d{50.0000, 2.5000, 0.0000}, Vec3d{61.0000, -5.0000, 29.0000}, 22.8977},
+	{Vec3d{50.0000, 2.5000, 0.0000}, Vec3d{56.0000, -27.0000, -3.0000}, 31.9030},
+	{Vec3d{50.0000, 2.5000, 0.0000}, Vec3d{58.0000, 24.0000, 15.0000}, 19.4535},
+	{Vec3d{50.0000, 2.5000, 0.0000}, Vec3d{50.0000, 3.1736, 0.5854}, 1.0000},
+	{Vec3d{50.0000, 2.5000, 0.0000}, Vec3d{50.0000, 3.2972, 0.0000}, 1.0000},
+	{Vec3d{50.0000, 2.5000, 0.0000}, Vec3d{50.0000, 1.8634, 0.5757}, 1.0000},
+	{Vec3d{50.0000, 2.5000, 0.0000}, Vec3d{50.0000, 3.2592, 0.3350}, 1.0000},
+	{Vec3d{60.2574, -34.0099, 36.2677}, Vec3d{60.4626, -34.1751, 39.4387}, 1.2644},
+	{Vec3d{63.0109, -31.0961, -5.8663}, Vec3d{62.8187, -29.7946, -4.0864}, 1.2630},
+	{Vec3d{61.2901, 3.7196, -5.3901}, Vec3d{61.4292, 2.2480, -4.9620}, 1.8731},
+	{Vec3d{35.0831, -44.1164, 3.7933}, Vec3d{35.0232, -40.0716, 1.5901}, 1.8645},
+	{Vec3d{22.7233, 20.0904, -46.6940}, Vec3d{23.0331, 14.9730, -42.5619}, 2.0373},
+	{Vec3d{36.4612, 47.8580, 18.3852}, Vec3d{36.2715, 50.5065, 21.2231}, 1.4146},
+	{Vec3d{90.8027, -2.0831, 1.4410}, Vec3d{91.1528, -1.6435, 0.0447}, 1.4441},
+	{Vec3d{90.9257, -0.5406, -0.9208}, Vec3d{88.6381, -0.8985, -0.7239}, 1.5381},
+	{Vec3d{6.7747, -0.2908, -2.4247}, Vec3d{5.8714, -0.0985, -2.2286}, 0.6377},
+	{Vec3d{2.0776, 0.0795, -1.1350}, Vec3d{0.9033, -0.0636, -0.5514}, 0.9082},
+}
+
+func TestDeltaE2000(t *testing.T) {
+
+	for i, test := range deltaE2000Tests {
+		if got := DeltaE2000Lab(&test.a, &test.b); !equalsTol(got, test.want, 5e-5) {
+			t.Errorf("pair %d: DeltaE2000Lab(%v, %v) = %.4f, want %.4f", i+1, test.a, test.b, got, test.want)
+		}
+		if got := DeltaE2000Lab(&test.b, &test.a); !equalsTol(got, test.want, 5e-5) {
+			t.Errorf("pair %d: DeltaE2000Lab(%v, %v) = %.4f, want %.4f", i+1, test.b, test.a, got, test.want)
+		}
+	}
+
+	red, also := Color{1, 0, 0, 1}, Color{1, 0, 0, 0.2}
+
+	if got := red.DeltaE2000(&also); got != 0 {
+		t.Errorf("DeltaE2000 of colors differing in alpha = %v, want 0", got)
+	}
+}
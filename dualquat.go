@@ -0,0 +1,322 @@
+package mathf
+
+import (
+	"fmt"
+	"math"
+)
+
+// dual quaternion for rigid transforms, elements 0 to 3 are the real Quat and 4 to 7 the dual Quat
+type DualQuat [8]float32
+
+// returns new identity DualQuat
+func NewDualQuat() *DualQuat {
+	this := new(DualQuat)
+
+	this[3] = 1
+
+	return this
+}
+
+// returns a copy of this
+func (this *DualQuat) Clone() *DualQuat {
+
+	return new(DualQuat).Copy(this)
+}
+
+// copies other
+func (this *DualQuat) Copy(other *DualQuat) *DualQuat {
+
+	this[0], this[1], this[2], this[3] = other[0], other[1], other[2], other[3]
+	this[4], this[5], this[6], this[7] = other[4], other[5], other[6], other[7]
+
+	return this
+}
+
+// sets this from real and dual parts
+func (this *DualQuat) Set(real, dual *Quat) *DualQuat {
+
+	this[0], this[1], this[2], this[3] = real[0], real[1], real[2], real[3]
+	this[4], this[5], this[6], this[7] = dual[0], dual[1], dual[2], dual[3]
+
+	return this
+}
+
+// returns this as identity
+func (this *DualQuat) Identity() *DualQuat {
+
+	this[0], this[1], this[2], this[3] = 0, 0, 0, 1
+	this[4], this[5], this[6], this[7] = 0, 0, 0, 0
+
+	return this
+}
+
+// returns real part of this, saves in target
+func (this *DualQuat) Real(target *Quat) *Quat {
+
+	return target.Set(this[0], this[1], this[2], this[3])
+}
+
+// returns dual part of this, saves in target
+func (this *DualQuat) Dual(target *Quat) *Quat {
+
+	return target.Set(this[4], this[5], this[6], this[7])
+}
+
+// sets this from rotation and translation, rotation should be normalized
+func (this *DualQuat) FromRotationTranslation(rotation *Quat, translation *Vec3) *DualQuat {
+	x, y, z, w := rotation[0], rotation[1], rotation[2], rotation[3]
+	tx, ty, tz := translation[0]*0.5, translation[1]*0.5, translation[2]*0.5
+
+	this[0], this[1], this[2], this[3] = x, y, z, w
+
+	this[4] = tx*w + ty*z - tz*y
+	this[5] = ty*w + tz*x - tx*z
+	this[6] = tz*w + tx*y - ty*x
+	this[7] = -tx*x - ty*y - tz*z
+
+	return this
+}
+
+// sets this from the rotation and position of Mat4, scale is ignored
+func (this *DualQuat) FromMat4(m *Mat4) *DualQuat {
+	var position, scale Vec3
+	var rotation Quat
+
+	m.Decompose(&position, &scale, &rotation)
+
+	return this.FromRotationTranslation(&rotation, &position)
+}
+
+// returns rotation of this, saves in target
+func (this *DualQuat) GetRotation(target *Quat) *Quat {
+
+	return this.Real(target)
+}
+
+// returns translation of this, saves in target
+func (this *DualQuat) GetTranslation(target *Vec3) *Vec3 {
+	x, y, z, w := this[0], this[1], this[2], this[3]
+	dx, dy, dz, dw := this[4], this[5], this[6], this[7]
+
+	return target.Set(
+		2*(w*dx-dw*x+y*dz-z*dy),
+		2*(w*dy-dw*y+z*dx-x*dz),
+		2*(w*dz-dw*z+x*dy-y*dx),
+	)
+}
+
+// mutiples this by other
+func (this *DualQuat) Mul(other *DualQuat) *DualQuat {
+
+	return this.DMul(this, other)
+}
+
+// mutiples a and b saves in this, b is applied first
+func (this *DualQuat) DMul(a, b *DualQuat) *DualQuat {
+	ax, ay, az, aw := a[0], a[1], a[2], a[3]
+	adx, ady, adz, adw := a[4], a[5], a[6], a[7]
+	bx, by, bz, bw := b[0], b[1], b[2], b[3]
+	bdx, bdy, bdz, bdw := b[4], b[5], b[6], b[7]
+
+	this[0] = ax*bw + aw*bx + ay*bz - az*by
+	this[1] = ay*bw + aw*by + az*bx - ax*bz
+	this[2] = az*bw + aw*bz + ax*by - ay*bx
+	this[3] = aw*bw - ax*bx - ay*by - az*bz
+
+	this[4] = ax*bdw + aw*bdx + ay*bdz - az*bdy + adx*bw + adw*bx + ady*bz - adz*by
+	this[5] = ay*bdw + aw*bdy + az*bdx - ax*bdz + ady*bw + adw*by + adz*bx - adx*bz
+	this[6] = az*bdw + aw*bdz + ax*bdy - ay*bdx + adz*bw + adw*bz + adx*by - ady*bx
+	this[7] = aw*bdw - ax*bdx - ay*bdy - az*bdz + adw*bw - adx*bx - ady*by - adz*bz
+
+	return this
+}
+
+// mutiples this by scalar
+func (this *DualQuat) SMul(s float32) *DualQuat {
+
+	for i := range this {
+		this[i] *= s
+	}
+
+	return this
+}
+
+// returns dot product of the real parts of this and other
+func (this *DualQuat) Dot(other *DualQuat) float32 {
+
+	return this[0]*other[0] + this[1]*other[1] + this[2]*other[2] + this[3]*other[3]
+}
+
+// conjugates both parts of this, if this is normalized this is its inverse
+func (this *DualQuat) Conjugate() *DualQuat {
+
+	this[0], this[1], this[2] = -this[0], -this[1], -this[2]
+	this[4], this[5], this[6] = -this[4], -this[5], -this[6]
+
+	return this
+}
+
+// normalizes this so the real part has unit length and is orthogonal to the dual part
+func (this *DualQuat) Normalize() *DualQuat {
+	l := this[0]*this[0] + this[1]*this[1] + this[2]*this[2] + this[3]*this[3]
+
+	if l == 0 {
+		return this.Identity()
+	}
+
+	this.SMul(1 / float32(math.Sqrt(float64(l))))
+
+	d := this[0]*this[4] + this[1]*this[5] + this[2]*this[6] + this[3]*this[7]
+
+	this[4] -= this[0] * d
+	this[5] -= this[1] * d
+	this[6] -= this[2] * d
+	this[7] -= this[3] * d
+
+	return this
+}
+
+// raises this to the power of x along its screw axis, this should be normalized
+func (this *DualQuat) Pow(x float32) *DualQuat {
+	rx, ry, rz, rw := this[0], this[1], this[2], this[3]
+	dx, dy, dz, dw := this[4], this[5], this[6], this[7]
+	s := float32(math.Sqrt(float64(rx*rx + ry*ry + rz*rz)))
+
+	if s < Epsilon {
+		this[4], this[5], this[6], this[7] = dx*x, dy*x, dz*x, dw*x
+		return this
+	}
+
+	invS := 1 / s
+	lx, ly, lz := rx*invS, ry*invS, rz*invS
+	angle := 2 * float32(math.Atan2(float64(s), float64(rw)))
+	pitch := -2 * dw * invS
+	h := pitch * 0.5 * rw
+	mx, my, mz := (dx-lx*h)*invS, (dy-ly*h)*invS, (dz-lz*h)*invS
+
+	angle *= x
+	pitch *= x
+	sin := float32(math.Sin(float64(angle * 0.5)))
+	cos := float32(math.Cos(float64(angle * 0.5)))
+	h = pitch * 0.5 * cos
+
+	this[0], this[1], this[2], this[3] = lx*sin, ly*sin, lz*sin, cos
+	this[4] = mx*sin + lx*h
+	this[5] = my*sin + ly*h
+	this[6] = mz*sin + lz*h
+	this[7] = -pitch * 0.5 * sin
+
+	return this
+}
+
+// screw linear interpolation of this and other by x, both should be normalized
+func (this *DualQuat) ScLerp(other *DualQuat, x float32) *DualQuat {
+
+	return this.DScLerp(this, other, x)
+}
+
+// screw linear interpolation of a and b by x, saves in this
+func (this *DualQuat) DScLerp(a, b *DualQuat, x float32) *DualQuat {
+	var d DualQuat
+
+	d.Copy(a).Conjugate().Mul(b)
+
+	if d[3] < 0 {
+		d.SMul(-1)
+	}
+
+	d.Pow(x)
+
+	return this.DMul(a, &d)
+}
+
+// sets this to the dual quaternion linear blend of array by weights
+func (this *DualQuat) Blend(array []*DualQuat, weights []float32) *DualQuat {
+	var blend DualQuat
+
+	for i, dq := range array {
+		w := weights[i]
+
+		if dq.Dot(array[0]) < 0 {
+			w = -w
+		}
+
+		for j := range blend {
+			blend[j] += dq[j] * w
+		}
+	}
+
+	return this.Copy(&blend).Normalize()
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *DualQuat) Equals(other *DualQuat) bool {
+
+	for i := range this {
+		if this[i] != other[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checks if this equals other within absolute tolerance tol
+func (this *DualQuat) EqualsTol(other *DualQuat, tol float32) bool {
+
+	return equalsTolSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other within tol relative to its largest element magnitude
+func (this *DualQuat) EqualsRel(other *DualQuat, tol float32) bool {
+
+	return equalsRelSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *DualQuat) EqualsULP(other *DualQuat, ulps int) bool {
+
+	return equalsULPSlice(this[:], other[:], ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *DualQuat) MarshalText() ([]byte, error) {
+
+	return appendText(nil, dualText, this[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *DualQuat) UnmarshalText(text []byte) error {
+
+	return parseText(text, dualText, this[:])
+}
+
+// returns this as a JSON array, implements json.Marshaler
+func (this *DualQuat) MarshalJSON() ([]byte, error) {
+
+	return appendJSON(nil, this[:])
+}
+
+// parses a JSON array into this, implements json.Unmarshaler
+func (this *DualQuat) UnmarshalJSON(data []byte) error {
+
+	return parseJSON(data, this[:])
+}
+
+// returns this as little endian floats, implements encoding.BinaryMarshaler
+func (this *DualQuat) MarshalBinary() ([]byte, error) {
+
+	return appendBinary(nil, this[:]), nil
+}
+
+// parses little endian floats into this, implements encoding.BinaryUnmarshaler
+func (this *DualQuat) UnmarshalBinary(data []byte) error {
+
+	return parseBinary(data, this[:])
+}
+
+// returns this as string type
+func (this *DualQuat) String() string {
+
+	return fmt.Sprintf("DualQuat[ %f, %f, %f, %f, %f, %f, %f, %f ]", this[0], this[1], this[2], this[3], this[4], this[5], this[6], this[7])
+}
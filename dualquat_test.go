@@ -0,0 +1,192 @@
+package mathf
+
+import (
+	"math"
+	"testing"
+)
+
+// returns DualQuat rotating by angle about axis through pivot
+func newDualQuatAboutPivot(axis, pivot *Vec3, angle float32) *DualQuat {
+	rotation := NewQuat().FromAxisAngle(axis, angle)
+	translation := pivot.Clone().Sub(pivot.Clone().ApplyQuat(rotation))
+
+	return new(DualQuat).FromRotationTranslation(rotation, translation)
+}
+
+// checks a and b are the same rigid transform, q and -q are equal
+func dualQuatEqualsTransform(a, b *DualQuat, tol float32) bool {
+
+	return a.EqualsTol(b, tol) || a.EqualsTol(b.Clone().SMul(-1), tol)
+}
+
+func TestDualQuatRotationTranslation(t *testing.T) {
+	rotation := NewQuat().FromAxisAngle(NewVec3(1, 2, 3).Normalize(), 1.1)
+	translation := Vec3{4, -5, 6}
+	dq := new(DualQuat).FromRotationTranslation(rotation, &translation)
+
+	var q Quat
+	var v Vec3
+
+	if dq.GetRotation(&q); !q.EqualsTol(rotation, 1e-6) {
+		t.Errorf("GetRotation() = %v, want %v", q, *rotation)
+	}
+	if dq.GetTranslation(&v); !v.EqualsTol(&translation, 1e-5) {
+		t.Errorf("GetTranslation() = %v, want %v", v, translation)
+	}
+
+	m := NewMat4().FromDualQuat(dq)
+	want := NewMat4().Compose(&translation, &Vec3{1, 1, 1}, rotation)
+
+	if !m.EqualsTol(want, 1e-5) {
+		t.Errorf("Mat4.FromDualQuat() = %v, want %v", *m, *want)
+	}
+	if got := new(DualQuat).FromMat4(want); !dualQuatEqualsTransform(got, dq, 1e-5) {
+		t.Errorf("FromMat4() = %v, want %v", *got, *dq)
+	}
+
+	p := Vec3{0.5, 1, -2}
+
+	if got, want := p.Clone().ApplyDualQuat(dq), p.Clone().ApplyMat4(m); !got.EqualsTol(want, 1e-5) {
+		t.Errorf("ApplyDualQuat(%v) = %v, want %v", p, *got, *want)
+	}
+}
+
+func TestDualQuatMul(t *testing.T) {
+	translate := new(DualQuat).FromRotationTranslation(NewQuat(), &Vec3{1, 0, 0})
+	rotate := new(DualQuat).FromRotationTranslation(NewQuat().FromAxisAngle(&Vec3{0, 0, 1}, HALF_PI), &Vec3{})
+
+	// b is applied first, so the point is rotated to y and then moved along x
+	p := Vec3{1, 0, 0}
+	want := Vec3{1, 1, 0}
+
+	if got := p.Clone().ApplyDualQuat(translate.Clone().Mul(rotate)); !got.EqualsTol(&want, 1e-6) {
+		t.Errorf("translate.Mul(rotate) moves %v to %v, want %v", p, *got, want)
+	}
+
+	a := newDualQuatAboutPivot(NewVec3(1, 1, 0).Normalize(), &Vec3{0, 2, 1}, 0.8)
+	b := new(DualQuat).FromRotationTranslation(NewQuat().FromAxisAngle(&Vec3{0, 1, 0}, -2), &Vec3{3, 0, -1})
+	product := new(Mat4).MMul(NewMat4().FromDualQuat(a), NewMat4().FromDualQuat(b))
+
+	if got := NewMat4().FromDualQuat(new(DualQuat).DMul(a, b)); !got.EqualsTol(product, 1e-5) {
+		t.Errorf("DMul(a, b) = %v, want Mat4 product %v", *got, *product)
+	}
+	if got := a.Clone().Mul(a.Clone().Conjugate()); !got.EqualsTol(NewDualQuat(), 1e-6) {
+		t.Errorf("a.Mul(a.Conjugate()) = %v, want identity", *got)
+	}
+}
+
+func TestDualQuatScLerp(t *testing.T) {
+	pivot, axis := Vec3{1, 0, 0}, Vec3{0, 0, 1}
+	angle := float32(2 * math.Pi / 3)
+
+	tests := []struct {
+		name string
+		a, b *DualQuat
+		x    float32
+		want *DualQuat
+	}{
+		{"start", NewDualQuat(), newDualQuatAboutPivot(&axis, &pivot, angle), 0, NewDualQuat()},
+		{"end", NewDualQuat(), newDualQuatAboutPivot(&axis, &pivot, angle), 1, newDualQuatAboutPivot(&axis, &pivot, angle)},
+		// halfway about a fixed pivot is half the angle about the same pivot, not the lerped translation
+		{"pivot midpoint", NewDualQuat(), newDualQuatAboutPivot(&axis, &pivot, angle), 0.5, newDualQuatAboutPivot(&axis, &pivot, angle*0.5)},
+		{"pivot quarter", NewDualQuat(), newDualQuatAboutPivot(&axis, &pivot, angle), 0.25, newDualQuatAboutPivot(&axis, &pivot, angle*0.25)},
+		{"screw midpoint",
+			NewDualQuat(),
+			new(DualQuat).FromRotationTranslation(NewQuat().FromAxisAngle(&axis, HALF_PI), &Vec3{0, 0, 2}),
+			0.5,
+			new(DualQuat).FromRotationTranslation(NewQuat().FromAxisAngle(&axis, HALF_PI*0.5), &Vec3{0, 0, 1})},
+		{"translation midpoint",
+			new(DualQuat).FromRotationTranslation(NewQuat(), &Vec3{2, 0, 0}),
+			new(DualQuat).FromRotationTranslation(NewQuat(), &Vec3{0, 4, 0}),
+			0.5,
+			new(DualQuat).FromRotationTranslation(NewQuat(), &Vec3{1, 2, 0})},
+		{"shortest path",
+			NewDualQuat(),
+			newDualQuatAboutPivot(&axis, &pivot, angle).SMul(-1),
+			0.5,
+			newDualQuatAboutPivot(&axis, &pivot, angle*0.5)},
+	}
+
+	for _, test := range tests {
+		if got := test.a.Clone().ScLerp(test.b, test.x); !dualQuatEqualsTransform(got, test.want, 1e-5) {
+			t.Errorf("%s ScLerp(%v) = %v, want %v", test.name, test.x, *got, *test.want)
+		}
+	}
+}
+
+func TestDualQuatPow(t *testing.T) {
+	dq := newDualQuatAboutPivot(NewVec3(1, -2, 0.5).Normalize(), &Vec3{3, 1, -2}, 1.3)
+	screw := dq.Clone().Mul(new(DualQuat).FromRotationTranslation(NewQuat(), &Vec3{0.2, -0.4, 0.1}))
+	shift := new(DualQuat).FromRotationTranslation(NewQuat(), &Vec3{2, -6, 4})
+
+	for _, dq := range []*DualQuat{dq, screw.Normalize(), shift} {
+		if got := dq.Clone().Pow(1); !got.EqualsTol(dq, 1e-5) {
+			t.Errorf("%v.Pow(1) = %v, want unchanged", *dq, *got)
+		}
+		if got := dq.Clone().Pow(0); !got.EqualsTol(NewDualQuat(), 1e-6) {
+			t.Errorf("%v.Pow(0) = %v, want identity", *dq, *got)
+		}
+		if got, want := dq.Clone().Pow(2), dq.Clone().Mul(dq); !dualQuatEqualsTransform(got, want, 1e-5) {
+			t.Errorf("%v.Pow(2) = %v, want %v", *dq, *got, *want)
+		}
+
+		half := dq.Clone().Pow(0.5)
+
+		if got := half.Clone().Mul(half); !dualQuatEqualsTransform(got, dq, 1e-5) {
+			t.Errorf("%v.Pow(0.5) squared = %v, want %v", *dq, *got, *dq)
+		}
+	}
+
+	want := new(DualQuat).FromRotationTranslation(NewQuat(), &Vec3{1, -3, 2})
+
+	if got := shift.Clone().Pow(0.5); !got.EqualsTol(want, 1e-6) {
+		t.Errorf("pure translation Pow(0.5) = %v, want %v", *got, *want)
+	}
+}
+
+func TestDualQuatNormalize(t *testing.T) {
+	dq := newDualQuatAboutPivot(NewVec3(0, 1, 1).Normalize(), &Vec3{-1, 2, 0}, 0.9)
+
+	if got := dq.Clone().SMul(3.5).Normalize(); !got.EqualsTol(dq, 1e-6) {
+		t.Errorf("scaled Normalize() = %v, want %v", *got, *dq)
+	}
+
+	// a dual part with a component along the real part is projected out
+	skewed := dq.Clone()
+
+	for i := 0; i < 4; i++ {
+		skewed[4+i] += 0.25 * skewed[i]
+	}
+
+	if got := skewed.Normalize(); !got.EqualsTol(dq, 1e-6) {
+		t.Errorf("skewed Normalize() = %v, want %v", *got, *dq)
+	}
+	if got := new(DualQuat).Normalize(); !got.Equals(NewDualQuat()) {
+		t.Errorf("zero Normalize() = %v, want identity", *got)
+	}
+}
+
+func TestDualQuatBlend(t *testing.T) {
+	a := new(DualQuat).FromRotationTranslation(NewQuat(), &Vec3{2, 0, 0})
+	b := new(DualQuat).FromRotationTranslation(NewQuat(), &Vec3{0, 4, 0})
+	r := newDualQuatAboutPivot(&Vec3{0, 0, 1}, &Vec3{1, 0, 0}, 1)
+
+	tests := []struct {
+		name    string
+		array   []*DualQuat
+		weights []float32
+		want    *DualQuat
+	}{
+		{"single", []*DualQuat{r}, []float32{1}, r},
+		{"first only", []*DualQuat{a, b}, []float32{1, 0}, a},
+		{"translations", []*DualQuat{a, b}, []float32{0.5, 0.5}, new(DualQuat).FromRotationTranslation(NewQuat(), &Vec3{1, 2, 0})},
+		{"unnormalized weights", []*DualQuat{a, b}, []float32{3, 1}, new(DualQuat).FromRotationTranslation(NewQuat(), &Vec3{1.5, 1, 0})},
+		{"antipodal", []*DualQuat{r, r.Clone().SMul(-1)}, []float32{0.5, 0.5}, r},
+	}
+
+	for _, test := range tests {
+		if got := new(DualQuat).Blend(test.array, test.weights); !dualQuatEqualsTransform(got, test.want, 1e-5) {
+			t.Errorf("%s Blend() = %v, want %v", test.name, *got, *test.want)
+		}
+	}
+}
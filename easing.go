@@ -0,0 +1,373 @@
+package mathf
+
+import (
+	"math"
+)
+
+// Penner back overshoot and elastic periods
+const (
+	easeBack         = 1.70158
+	easeBackInOut    = easeBack * 1.525
+	easeElastic      = 2 * math.Pi / 3
+	easeElasticInOut = 2 * math.Pi / 4.5
+)
+
+// CSS timing function keywords as cubic bezier easings
+var (
+	CSSEase      = CubicBezier(0.25, 0.1, 0.25, 1)
+	CSSEaseIn    = CubicBezier(0.42, 0, 1, 1)
+	CSSEaseOut   = CubicBezier(0, 0, 0.58, 1)
+	CSSEaseInOut = CubicBezier(0.42, 0, 0.58, 1)
+)
+
+// linear ease
+func EaseLinear(x float32) float32 {
+
+	return x
+}
+
+// quadratic ease in
+func EaseInQuad(x float32) float32 {
+
+	return easeInPow(x, 2)
+}
+
+// quadratic ease out
+func EaseOutQuad(x float32) float32 {
+
+	return easeOutPow(x, 2)
+}
+
+// quadratic ease in out
+func EaseInOutQuad(x float32) float32 {
+
+	return easeInOutPow(x, 2)
+}
+
+// cubic ease in
+func EaseInCubic(x float32) float32 {
+
+	return easeInPow(x, 3)
+}
+
+// cubic ease out
+func EaseOutCubic(x float32) float32 {
+
+	return easeOutPow(x, 3)
+}
+
+// cubic ease in out
+func EaseInOutCubic(x float32) float32 {
+
+	return easeInOutPow(x, 3)
+}
+
+// quartic ease in
+func EaseInQuart(x float32) float32 {
+
+	return easeInPow(x, 4)
+}
+
+// quartic ease out
+func EaseOutQuart(x float32) float32 {
+
+	return easeOutPow(x, 4)
+}
+
+// quartic ease in out
+func EaseInOutQuart(x float32) float32 {
+
+	return easeInOutPow(x, 4)
+}
+
+// quintic ease in
+func EaseInQuint(x float32) float32 {
+
+	return easeInPow(x, 5)
+}
+
+// quintic ease out
+func EaseOutQuint(x float32) float32 {
+
+	return easeOutPow(x, 5)
+}
+
+// quintic ease in out
+func EaseInOutQuint(x float32) float32 {
+
+	return easeInOutPow(x, 5)
+}
+
+// returns x to the power of n
+func easeInPow(x float32, n int) float32 {
+	y := x
+
+	for i := 1; i < n; i++ {
+		y *= x
+	}
+
+	return y
+}
+
+// returns power ease in mirrored to end at 1
+func easeOutPow(x float32, n int) float32 {
+
+	return 1 - easeInPow(1-x, n)
+}
+
+// returns power ease in for the first half and ease out for the second
+func easeInOutPow(x float32, n int) float32 {
+
+	if x < 0.5 {
+		return easeInPow(2*x, n) * 0.5
+	}
+
+	return 1 - easeInPow(2-2*x, n)*0.5
+}
+
+// sine ease in
+func EaseInSine(x float32) float32 {
+
+	return float32(1 - math.Cos(float64(x)*math.Pi*0.5))
+}
+
+// sine ease out
+func EaseOutSine(x float32) float32 {
+
+	return float32(math.Sin(float64(x) * math.Pi * 0.5))
+}
+
+// sine ease in out
+func EaseInOutSine(x float32) float32 {
+
+	return float32((1 - math.Cos(float64(x)*math.Pi)) * 0.5)
+}
+
+// exponential ease in
+func EaseInExpo(x float32) float32 {
+
+	if x <= 0 {
+		return 0
+	}
+
+	return float32(math.Exp2(10*float64(x) - 10))
+}
+
+// exponential ease out
+func EaseOutExpo(x float32) float32 {
+
+	if x >= 1 {
+		return 1
+	}
+
+	return float32(1 - math.Exp2(-10*float64(x)))
+}
+
+// exponential ease in out
+func EaseInOutExpo(x float32) float32 {
+
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+	if x < 0.5 {
+		return float32(math.Exp2(20*float64(x)-10) * 0.5)
+	}
+
+	return float32((2 - math.Exp2(10-20*float64(x))) * 0.5)
+}
+
+// circular ease in
+func EaseInCirc(x float32) float32 {
+
+	return float32(1 - math.Sqrt(1-float64(x*x)))
+}
+
+// circular ease out
+func EaseOutCirc(x float32) float32 {
+	y := float64(x - 1)
+
+	return float32(math.Sqrt(1 - y*y))
+}
+
+// circular ease in out
+func EaseInOutCirc(x float32) float32 {
+	y := float64(2 * x)
+
+	if x < 0.5 {
+		return float32((1 - math.Sqrt(1-y*y)) * 0.5)
+	}
+
+	y -= 2
+
+	return float32((math.Sqrt(1-y*y) + 1) * 0.5)
+}
+
+// back ease in, overshoots below 0 before the start
+func EaseInBack(x float32) float32 {
+	y := float64(x)
+
+	return float32(y * y * ((easeBack+1)*y - easeBack))
+}
+
+// back ease out, overshoots above 1 before the end
+func EaseOutBack(x float32) float32 {
+
+	return 1 - EaseInBack(1-x)
+}
+
+// back ease in out, overshoots at both ends
+func EaseInOutBack(x float32) float32 {
+	y := 2 * float64(x)
+
+	if x < 0.5 {
+		return float32(y * y * ((easeBackInOut+1)*y - easeBackInOut) * 0.5)
+	}
+
+	y -= 2
+
+	return float32((y*y*((easeBackInOut+1)*y+easeBackInOut) + 2) * 0.5)
+}
+
+// elastic ease in, oscillates around 0 before the start
+func EaseInElastic(x float32) float32 {
+
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+
+	y := float64(x)
+
+	return float32(-math.Exp2(10*y-10) * math.Sin((10*y-10.75)*easeElastic))
+}
+
+// elastic ease out, oscillates around 1 before the end
+func EaseOutElastic(x float32) float32 {
+
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+
+	y := float64(x)
+
+	return float32(math.Exp2(-10*y)*math.Sin((10*y-0.75)*easeElastic) + 1)
+}
+
+// elastic ease in out, oscillates at both ends
+func EaseInOutElastic(x float32) float32 {
+
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+
+	y := float64(x)
+	s := math.Sin((20*y - 11.125) * easeElasticInOut)
+
+	if x < 0.5 {
+		return float32(-math.Exp2(20*y-10) * s * 0.5)
+	}
+
+	return float32(math.Exp2(10-20*y)*s*0.5 + 1)
+}
+
+// bounce ease in
+func EaseInBounce(x float32) float32 {
+
+	return 1 - EaseOutBounce(1-x)
+}
+
+// bounce ease out
+func EaseOutBounce(x float32) float32 {
+	const n, d = 7.5625, 2.75
+	y := float64(x)
+
+	switch {
+	case y < 1/d:
+		return float32(n * y * y)
+	case y < 2/d:
+		y -= 1.5 / d
+		return float32(n*y*y + 0.75)
+	case y < 2.5/d:
+		y -= 2.25 / d
+		return float32(n*y*y + 0.9375)
+	}
+
+	y -= 2.625 / d
+
+	return float32(n*y*y + 0.984375)
+}
+
+// bounce ease in out
+func EaseInOutBounce(x float32) float32 {
+
+	if x < 0.5 {
+		return (1 - EaseOutBounce(1-2*x)) * 0.5
+	}
+
+	return (1 + EaseOutBounce(2*x-1)) * 0.5
+}
+
+// returns CSS style cubic bezier easing through (0, 0), (x1, y1), (x2, y2) and (1, 1), x1 and x2 are clamped to 0 to 1
+func CubicBezier(x1, y1, x2, y2 float32) func(float32) float32 {
+	cx := 3 * float64(clamp01(x1))
+	bx := 3*float64(clamp01(x2)) - 2*cx
+	ax := 1 - cx - bx
+	cy := 3 * float64(y1)
+	by := 3*float64(y2) - 2*cy
+	ay := 1 - cy - by
+
+	return func(x float32) float32 {
+		if x <= 0 {
+			return 0
+		}
+		if x >= 1 {
+			return 1
+		}
+
+		target := float64(x)
+		t := target
+
+		// newton's method, falls back to bisection when the slope is flat
+		for i := 0; i < 8; i++ {
+			e := ((ax*t+bx)*t+cx)*t - target
+
+			if math.Abs(e) < 1e-7 {
+				return float32(((ay*t+by)*t + cy) * t)
+			}
+
+			d := (3*ax*t+2*bx)*t + cx
+
+			if math.Abs(d) < 1e-6 {
+				break
+			}
+
+			t -= e / d
+		}
+
+		lo, hi := 0.0, 1.0
+		t = target
+
+		for i := 0; i < 64 && hi-lo > 1e-9; i++ {
+			if ((ax*t+bx)*t+cx)*t < target {
+				lo = t
+			} else {
+				hi = t
+			}
+
+			t = (lo + hi) * 0.5
+		}
+
+		return float32(((ay*t+by)*t + cy) * t)
+	}
+}
@@ -0,0 +1,103 @@
+package mathf
+
+import (
+	"testing"
+)
+
+// easings in In, Out and InOut forms
+var easingTests = []struct {
+	name           string
+	in, out, inOut func(float32) float32
+}{
+	{"Quad", EaseInQuad, EaseOutQuad, EaseInOutQuad},
+	{"Cubic", EaseInCubic, EaseOutCubic, EaseInOutCubic},
+	{"Quart", EaseInQuart, EaseOutQuart, EaseInOutQuart},
+	{"Quint", EaseInQuint, EaseOutQuint, EaseInOutQuint},
+	{"Sine", EaseInSine, EaseOutSine, EaseInOutSine},
+	{"Expo", EaseInExpo, EaseOutExpo, EaseInOutExpo},
+	{"Circ", EaseInCirc, EaseOutCirc, EaseInOutCirc},
+	{"Back", EaseInBack, EaseOutBack, EaseInOutBack},
+	{"Elastic", EaseInElastic, EaseOutElastic, EaseInOutElastic},
+	{"Bounce", EaseInBounce, EaseOutBounce, EaseInOutBounce},
+}
+
+func TestEasingEndpoints(t *testing.T) {
+
+	for _, test := range easingTests {
+		forms := []struct {
+			name string
+			ease func(float32) float32
+		}{
+			{"In", test.in},
+			{"Out", test.out},
+			{"InOut", test.inOut},
+		}
+
+		for _, form := range forms {
+			if got := form.ease(0); !EqualsTol(got, 0, 1e-6) {
+				t.Errorf("Ease%s%s(0) = %v, want 0", form.name, test.name, got)
+			}
+			if got := form.ease(1); !EqualsTol(got, 1, 1e-6) {
+				t.Errorf("Ease%s%s(1) = %v, want 1", form.name, test.name, got)
+			}
+		}
+
+		if got := test.inOut(0.5); !EqualsTol(got, 0.5, 1e-6) {
+			t.Errorf("EaseInOut%s(0.5) = %v, want 0.5", test.name, got)
+		}
+
+		for _, x := range []float32{0.1, 0.3, 0.5, 0.7, 0.95} {
+			if in, out := test.in(x), test.out(1-x); !EqualsTol(in, 1-out, 1e-5) {
+				t.Errorf("EaseIn%s(%v) = %v, want 1 - EaseOut%s(%v) = %v", test.name, x, in, test.name, 1-x, 1-out)
+			}
+			if a, b := test.inOut(x), test.inOut(1-x); !EqualsTol(a, 1-b, 1e-5) {
+				t.Errorf("EaseInOut%s(%v) = %v, want 1 - EaseInOut%s(%v) = %v", test.name, x, a, test.name, 1-x, 1-b)
+			}
+		}
+	}
+
+	for _, x := range []float32{0, 0.25, 1} {
+		if got := EaseLinear(x); got != x {
+			t.Errorf("EaseLinear(%v) = %v, want %v", x, got, x)
+		}
+	}
+}
+
+func TestCubicBezier(t *testing.T) {
+	tests := []struct {
+		name string
+		ease func(float32) float32
+		x    float32
+		want float32
+	}{
+		{"linear", CubicBezier(0, 0, 1, 1), 0.3, 0.3},
+		{"linear", CubicBezier(0.25, 0.25, 0.75, 0.75), 0.6, 0.6},
+		{"ease", CSSEase, 0.5, 0.8024034},
+		{"ease-in-out", CSSEaseInOut, 0.5, 0.5},
+		{"ease-in", CSSEaseIn, 0, 0},
+		{"ease-out", CSSEaseOut, 1, 1},
+		{"below range", CSSEase, -1, 0},
+		{"above range", CSSEase, 2, 1},
+	}
+
+	for _, test := range tests {
+		if got := test.ease(test.x); !EqualsTol(got, test.want, 1e-5) {
+			t.Errorf("%s(%v) = %v, want %v", test.name, test.x, got, test.want)
+		}
+	}
+
+	// the CSS keyword curves have control points inside 0 to 1, so they never turn back
+	for _, ease := range []func(float32) float32{CSSEase, CSSEaseIn, CSSEaseOut, CSSEaseInOut} {
+		last := ease(0)
+
+		for x := float32(0.01); x <= 1; x += 0.01 {
+			y := ease(x)
+
+			if y < last {
+				t.Errorf("CSS curve decreases from %v to %v at %v", last, y, x)
+			}
+
+			last = y
+		}
+	}
+}
@@ -0,0 +1,306 @@
+package mathf
+
+import (
+	"fmt"
+	"math"
+)
+
+// rotation orders, EULER_XYZ composes the rotation as X * Y * Z so Z is applied to a vector first
+const (
+	EULER_XYZ = iota
+	EULER_YXZ
+	EULER_ZXY
+	EULER_ZYX
+	EULER_YZX
+	EULER_XZY
+)
+
+// euler angles in radians with a rotation order
+type Euler struct {
+	X, Y, Z float32
+	Order   int
+}
+
+// returns new Euler
+func NewEuler(x, y, z float32, order int) *Euler {
+	this := new(Euler)
+
+	this.X, this.Y, this.Z, this.Order = x, y, z, order
+
+	return this
+}
+
+// returns a copy of this
+func (this *Euler) Clone() *Euler {
+
+	return new(Euler).Copy(this)
+}
+
+// copies other
+func (this *Euler) Copy(other *Euler) *Euler {
+
+	this.X, this.Y, this.Z, this.Order = other.X, other.Y, other.Z, other.Order
+
+	return this
+}
+
+// sets this from values
+func (this *Euler) Set(x, y, z float32, order int) *Euler {
+
+	this.X, this.Y, this.Z, this.Order = x, y, z, order
+
+	return this
+}
+
+// sets angles from the rotation matrix elements, keeps order
+func (this *Euler) fromRotation(m11, m12, m13, m21, m22, m23, m31, m32, m33 float32) *Euler {
+	// asin of s given the other two elements of its row, accurate near +-1 where asin is not
+	asin := func(s, r1, r2 float32) float32 {
+		return float32(math.Atan2(float64(s), math.Hypot(float64(r1), float64(r2))))
+	}
+	atan2 := func(y, x float32) float32 { return float32(math.Atan2(float64(y), float64(x))) }
+	limit := float32(0.999999)
+
+	switch this.Order {
+	case EULER_XYZ:
+		this.Y = asin(m13, m11, m12)
+		if Abs(m13) < limit {
+			this.X = atan2(-m23, m33)
+			this.Z = atan2(-m12, m11)
+		} else {
+			this.X = atan2(m32, m22)
+			this.Z = 0
+		}
+	case EULER_YXZ:
+		this.X = asin(-m23, m21, m22)
+		if Abs(m23) < limit {
+			this.Y = atan2(m13, m33)
+			this.Z = atan2(m21, m22)
+		} else {
+			this.Y = atan2(-m31, m11)
+			this.Z = 0
+		}
+	case EULER_ZXY:
+		this.X = asin(m32, m31, m33)
+		if Abs(m32) < limit {
+			this.Y = atan2(-m31, m33)
+			this.Z = atan2(-m12, m22)
+		} else {
+			this.Y = 0
+			this.Z = atan2(m21, m11)
+		}
+	case EULER_ZYX:
+		this.Y = asin(-m31, m32, m33)
+		if Abs(m31) < limit {
+			this.X = atan2(m32, m33)
+			this.Z = atan2(m21, m11)
+		} else {
+			this.X = 0
+			this.Z = atan2(-m12, m22)
+		}
+	case EULER_YZX:
+		this.Z = asin(m21, m22, m23)
+		if Abs(m21) < limit {
+			this.X = atan2(-m23, m22)
+			this.Y = atan2(-m31, m11)
+		} else {
+			this.X = 0
+			this.Y = atan2(m13, m33)
+		}
+	case EULER_XZY:
+		this.Z = asin(-m12, m11, m13)
+		if Abs(m12) < limit {
+			this.X = atan2(m32, m22)
+			this.Y = atan2(m13, m11)
+		} else {
+			this.X = atan2(-m23, m33)
+			this.Y = 0
+		}
+	}
+
+	return this
+}
+
+// sets angles from rotation Mat3, keeps order
+func (this *Euler) FromMat3(m *Mat3) *Euler {
+
+	return this.fromRotation(m[0], m[3], m[6], m[1], m[4], m[7], m[2], m[5], m[8])
+}
+
+// sets angles from the rotation of an unscaled Mat4, keeps order
+func (this *Euler) FromMat4(m *Mat4) *Euler {
+
+	return this.fromRotation(m[0], m[4], m[8], m[1], m[5], m[9], m[2], m[6], m[10])
+}
+
+// sets angles from Quat, keeps order
+func (this *Euler) FromQuat(q *Quat) *Euler {
+	var m Mat3
+
+	return this.FromMat3(m.FromQuat(q))
+}
+
+// converts this to the same rotation with a different order
+func (this *Euler) Reorder(order int) *Euler {
+	var q Quat
+
+	q.FromEuler(this)
+	this.Order = order
+
+	return this.FromQuat(&q)
+}
+
+// returns the rotation matrix of this as column major Mat3 elements
+func (this *Euler) rotation() [9]float32 {
+	a, b := float32(math.Cos(float64(this.X))), float32(math.Sin(float64(this.X)))
+	c, d := float32(math.Cos(float64(this.Y))), float32(math.Sin(float64(this.Y)))
+	e, f := float32(math.Cos(float64(this.Z))), float32(math.Sin(float64(this.Z)))
+	var m11, m12, m13, m21, m22, m23, m31, m32, m33 float32
+
+	switch this.Order {
+	case EULER_XYZ:
+		ae, af, be, bf := a*e, a*f, b*e, b*f
+
+		m11, m12, m13 = c*e, -c*f, d
+		m21, m22, m23 = af+be*d, ae-bf*d, -b*c
+		m31, m32, m33 = bf-ae*d, be+af*d, a*c
+	case EULER_YXZ:
+		ce, cf, de, df := c*e, c*f, d*e, d*f
+
+		m11, m12, m13 = ce+df*b, de*b-cf, a*d
+		m21, m22, m23 = a*f, a*e, -b
+		m31, m32, m33 = cf*b-de, df+ce*b, a*c
+	case EULER_ZXY:
+		ce, cf, de, df := c*e, c*f, d*e, d*f
+
+		m11, m12, m13 = ce-df*b, -a*f, de+cf*b
+		m21, m22, m23 = cf+de*b, a*e, df-ce*b
+		m31, m32, m33 = -a*d, b, a*c
+	case EULER_ZYX:
+		ae, af, be, bf := a*e, a*f, b*e, b*f
+
+		m11, m12, m13 = c*e, be*d-af, ae*d+bf
+		m21, m22, m23 = c*f, bf*d+ae, af*d-be
+		m31, m32, m33 = -d, b*c, a*c
+	case EULER_YZX:
+		ac, ad, bc, bd := a*c, a*d, b*c, b*d
+
+		m11, m12, m13 = c*e, bd-ac*f, bc*f+ad
+		m21, m22, m23 = f, a*e, -b*e
+		m31, m32, m33 = -d*e, ad*f+bc, ac-bd*f
+	case EULER_XZY:
+		ac, ad, bc, bd := a*c, a*d, b*c, b*d
+
+		m11, m12, m13 = c*e, -f, d*e
+		m21, m22, m23 = ac*f+bd, a*e, ad*f-bc
+		m31, m32, m33 = bc*f-ad, b*e, bd*f+ac
+	default:
+		m11, m22, m33 = 1, 1, 1
+	}
+
+	return [9]float32{m11, m21, m31, m12, m22, m32, m13, m23, m33}
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *Euler) Equals(other *Euler) bool {
+
+	return this.X == other.X && this.Y == other.Y && this.Z == other.Z && this.Order == other.Order
+}
+
+// checks if this equals other within absolute tolerance tol
+func (this *Euler) EqualsTol(other *Euler, tol float32) bool {
+
+	return this.Order == other.Order && EqualsTol(this.X, other.X, tol) && EqualsTol(this.Y, other.Y, tol) && EqualsTol(this.Z, other.Z, tol)
+}
+
+// checks if this equals other within tol relative to the magnitude of its elements
+func (this *Euler) EqualsRel(other *Euler, tol float32) bool {
+
+	return this.Order == other.Order && EqualsRel(this.X, other.X, tol) && EqualsRel(this.Y, other.Y, tol) && EqualsRel(this.Z, other.Z, tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *Euler) EqualsULP(other *Euler, ulps int) bool {
+
+	return this.Order == other.Order && EqualsULP(this.X, other.X, ulps) && EqualsULP(this.Y, other.Y, ulps) && EqualsULP(this.Z, other.Z, ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *Euler) MarshalText() ([]byte, error) {
+	e := this.elements()
+
+	return appendText(nil, eulerText, e[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *Euler) UnmarshalText(text []byte) error {
+	var e [4]float32
+
+	if err := parseText(text, eulerText, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns this as a JSON array of X, Y, Z and Order, implements json.Marshaler
+func (this *Euler) MarshalJSON() ([]byte, error) {
+	e := this.elements()
+
+	return appendJSON(nil, e[:])
+}
+
+// parses a JSON array of X, Y, Z and Order into this, implements json.Unmarshaler
+func (this *Euler) UnmarshalJSON(data []byte) error {
+	var e [4]float32
+
+	if isJSONNull(data) {
+		return nil
+	}
+	if err := parseJSON(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns X, Y, Z and Order of this as little endian floats, implements encoding.BinaryMarshaler
+func (this *Euler) MarshalBinary() ([]byte, error) {
+	e := this.elements()
+
+	return appendBinary(nil, e[:]), nil
+}
+
+// parses little endian floats of X, Y, Z and Order into this, implements encoding.BinaryUnmarshaler
+func (this *Euler) UnmarshalBinary(data []byte) error {
+	var e [4]float32
+
+	if err := parseBinary(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns X, Y, Z and Order of this as marshaling elements
+func (this *Euler) elements() [4]float32 {
+
+	return [4]float32{this.X, this.Y, this.Z, float32(this.Order)}
+}
+
+// sets X, Y, Z and Order of this from marshaling elements
+func (this *Euler) setElements(e *[4]float32) {
+	this.X, this.Y, this.Z, this.Order = e[0], e[1], e[2], int(e[3])
+}
+
+// returns this as string type
+func (this *Euler) String() string {
+
+	return fmt.Sprintf("Euler[ %f, %f, %f, %d ]", this.X, this.Y, this.Z, this.Order)
+}
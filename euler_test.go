@@ -0,0 +1,138 @@
+package mathf
+
+import (
+	"testing"
+)
+
+// rotation orders with the axes they compose, EULER_XYZ is X * Y * Z
+var eulerOrderTests = []struct {
+	name  string
+	order int
+	axes  [3]int
+}{
+	{"XYZ", EULER_XYZ, [3]int{0, 1, 2}},
+	{"YXZ", EULER_YXZ, [3]int{1, 0, 2}},
+	{"ZXY", EULER_ZXY, [3]int{2, 0, 1}},
+	{"ZYX", EULER_ZYX, [3]int{2, 1, 0}},
+	{"YZX", EULER_YZX, [3]int{1, 2, 0}},
+	{"XZY", EULER_XZY, [3]int{0, 2, 1}},
+}
+
+// angles away from gimbal lock, the middle angle of each order is within -PI/2 and PI/2
+var eulerAngleTests = [][3]float32{
+	{0, 0, 0},
+	{0.1, 0.2, 0.3},
+	{-1.2, 0.7, 2.5},
+	{3, -1.4, -0.5},
+	{-2.8, 1.1, 1.9},
+}
+
+// returns the quaternion of e composed from single axis rotations in its order
+func eulerAxisQuat(e *Euler, axes [3]int) *Quat {
+	angles := [3]float32{e.X, e.Y, e.Z}
+	q := NewQuat()
+
+	for _, axis := range axes {
+		var v Vec3
+		v[axis] = 1
+		q.Mul(NewQuat().FromAxisAngle(&v, angles[axis]))
+	}
+
+	return q
+}
+
+func TestEulerOrders(t *testing.T) {
+
+	for _, order := range eulerOrderTests {
+		for _, angles := range eulerAngleTests {
+			e := NewEuler(angles[0], angles[1], angles[2], order.order)
+			want := eulerAxisQuat(e, order.axes)
+
+			if got := NewQuat().FromEuler(e); !got.EqualsRotation(want, 1e-5) {
+				t.Errorf("%s %v: Quat.FromEuler = %v, want %v", order.name, angles, *got, *want)
+			}
+
+			var fromQuat, got Mat3
+
+			fromQuat.FromQuat(want)
+
+			if got.FromEuler(e); !got.EqualsTol(&fromQuat, 1e-5) {
+				t.Errorf("%s %v: Mat3.FromEuler = %v, want %v", order.name, angles, got, fromQuat)
+			}
+
+			var m4 Mat4
+			var m3 Mat3
+
+			if m3.FromMat4(m4.FromEuler(e)); !m3.EqualsTol(&fromQuat, 1e-5) {
+				t.Errorf("%s %v: Mat4.FromEuler = %v, want %v", order.name, angles, m4, fromQuat)
+			}
+		}
+	}
+}
+
+func TestEulerRoundTrip(t *testing.T) {
+
+	for _, order := range eulerOrderTests {
+		for _, angles := range eulerAngleTests {
+			// the middle axis of the order gets the middle angle so it stays clear of gimbal lock
+			var a [3]float32
+			a[order.axes[0]], a[order.axes[1]], a[order.axes[2]] = angles[0], angles[1], angles[2]
+			e := NewEuler(a[0], a[1], a[2], order.order)
+
+			var q Quat
+			var m3 Mat3
+			var m4 Mat4
+
+			conversions := []struct {
+				name string
+				got  *Euler
+			}{
+				{"Quat", NewEuler(0, 0, 0, order.order).FromQuat(q.FromEuler(e))},
+				{"Mat3", NewEuler(0, 0, 0, order.order).FromMat3(m3.FromEuler(e))},
+				{"Mat4", NewEuler(0, 0, 0, order.order).FromMat4(m4.FromEuler(e))},
+			}
+
+			for _, c := range conversions {
+				if !c.got.EqualsTol(e, 1e-4) {
+					t.Errorf("%s %v: Euler.From%s round trip = %v", order.name, *e, c.name, *c.got)
+				}
+			}
+		}
+	}
+}
+
+func TestEulerGimbalLock(t *testing.T) {
+
+	for _, order := range eulerOrderTests {
+		for _, middle := range []float32{HALF_PI, -HALF_PI} {
+			var a [3]float32
+			a[order.axes[0]], a[order.axes[1]], a[order.axes[2]] = 0.4, middle, 0.9
+			e := NewEuler(a[0], a[1], a[2], order.order)
+
+			var q, again Quat
+
+			q.FromEuler(e)
+			got := NewEuler(0, 0, 0, order.order).FromQuat(&q)
+
+			if again.FromEuler(got); !again.EqualsRotation(&q, 1e-4) {
+				t.Errorf("%s %v: FromQuat = %v, which is rotation %v, want %v", order.name, *e, *got, again, q)
+			}
+		}
+	}
+}
+
+func TestEulerReorder(t *testing.T) {
+	e := NewEuler(0.3, -0.6, 1.2, EULER_XYZ)
+	want := NewQuat().FromEuler(e)
+
+	for _, order := range eulerOrderTests {
+		got := e.Clone().Reorder(order.order)
+
+		if got.Order != order.order {
+			t.Errorf("Reorder(%s) order = %v, want %v", order.name, got.Order, order.order)
+		}
+		if q := NewQuat().FromEuler(got); !q.EqualsRotation(want, 1e-5) {
+			t.Errorf("Reorder(%s) = %v, which is rotation %v, want %v", order.name, *got, *q, *want)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package mathf
+
+import "fmt"
+
+const (
+	OUTSIDE = iota
+	INTERSECT
+	INSIDE
+)
+
+// frustum represented by six planes facing inwards, left, right, bottom, top, near and far
+type Frustum struct {
+	Planes [6]*Plane
+}
+
+// returns new Frustum
+func NewFrustum() *Frustum {
+	this := new(Frustum)
+
+	for i := range this.Planes {
+		this.Planes[i] = NewPlane(new(Vec3), 0)
+	}
+
+	return this
+}
+
+// returns a copy of this
+func (this *Frustum) Clone() *Frustum {
+
+	return NewFrustum().Copy(this)
+}
+
+// copies other
+func (this *Frustum) Copy(other *Frustum) *Frustum {
+
+	for i, p := range other.Planes {
+		this.Planes[i].Copy(p)
+	}
+
+	return this
+}
+
+// sets planes from a projection or view projection Mat4 with OpenGL clip space
+func (this *Frustum) FromMat4(m *Mat4) *Frustum {
+
+	return this.FromMat4Clip(m, 0)
+}
+
+// sets planes from a projection or view projection Mat4 built with clip space options, an infinite far plane never culls
+func (this *Frustum) FromMat4Clip(m *Mat4, flags int) *Frustum {
+	m11, m12, m13, m14 := m[0], m[4], m[8], m[12]
+	m21, m22, m23, m24 := m[1], m[5], m[9], m[13]
+	m31, m32, m33, m34 := m[2], m[6], m[10], m[14]
+	m41, m42, m43, m44 := m[3], m[7], m[11], m[15]
+	dn, df := clipDepthRange[float32](flags)
+	s := float32(1)
+
+	if dn > df {
+		s = -1
+	}
+
+	this.Planes[0].SetComponents(m41+m11, m42+m12, m43+m13, m44+m14).Normalize()
+	this.Planes[1].SetComponents(m41-m11, m42-m12, m43-m13, m44-m14).Normalize()
+	this.Planes[2].SetComponents(m41+m21, m42+m22, m43+m23, m44+m24).Normalize()
+	this.Planes[3].SetComponents(m41-m21, m42-m22, m43-m23, m44-m24).Normalize()
+	this.Planes[4].SetComponents(s*(m31-dn*m41), s*(m32-dn*m42), s*(m33-dn*m43), s*(m34-dn*m44)).Normalize()
+
+	if flags&CLIP_INFINITE_FAR != 0 {
+		this.Planes[5].SetComponents(0, 0, 0, Inf)
+	} else {
+		this.Planes[5].SetComponents(s*(df*m41-m31), s*(df*m42-m32), s*(df*m43-m33), s*(df*m44-m34)).Normalize()
+	}
+
+	return this
+}
+
+// checks if point is inside this
+func (this *Frustum) ContainsPoint(v *Vec3) bool {
+
+	for _, p := range this.Planes {
+		if p.DistanceToPoint(v) < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// returns OUTSIDE, INTERSECT or INSIDE for sphere
+func (this *Frustum) ClassifySphere(sphere *Sphere) int {
+	result := INSIDE
+	r := sphere.Radius
+
+	for _, p := range this.Planes {
+		d := p.DistanceToPoint(sphere.Center)
+
+		if d < -r {
+			return OUTSIDE
+		}
+		if d < r {
+			result = INTERSECT
+		}
+	}
+
+	return result
+}
+
+// checks if sphere is inside or intersects this
+func (this *Frustum) IntersectsSphere(sphere *Sphere) bool {
+
+	return this.ClassifySphere(sphere) != OUTSIDE
+}
+
+// returns OUTSIDE, INTERSECT or INSIDE for box
+func (this *Frustum) ClassifyAABB3(box *AABB3) int {
+	result := INSIDE
+	var pv, nv Vec3
+
+	for _, p := range this.Planes {
+		n := p.Normal
+
+		for i := 0; i < 3; i++ {
+			if n[i] > 0 {
+				pv[i], nv[i] = box.Max[i], box.Min[i]
+			} else {
+				pv[i], nv[i] = box.Min[i], box.Max[i]
+			}
+		}
+
+		if p.DistanceToPoint(&pv) < 0 {
+			return OUTSIDE
+		}
+		if p.DistanceToPoint(&nv) < 0 {
+			result = INTERSECT
+		}
+	}
+
+	return result
+}
+
+// checks if box is inside or intersects this
+func (this *Frustum) IntersectsAABB3(box *AABB3) bool {
+
+	return this.ClassifyAABB3(box) != OUTSIDE
+}
+
+// returns this as string type
+func (this *Frustum) String() string {
+
+	return fmt.Sprintf("Frustum[ Left: %s, Right: %s, Bottom: %s, Top: %s, Near: %s, Far: %s ]",
+		this.Planes[0], this.Planes[1], this.Planes[2], this.Planes[3], this.Planes[4], this.Planes[5])
+}
@@ -0,0 +1,177 @@
+package mathf
+
+import (
+	"testing"
+)
+
+// clip space options covering every depth convention
+var clipFlagTests = []struct {
+	name  string
+	flags int
+}{
+	{"OpenGL", 0},
+	{"zero to one", CLIP_ZERO_TO_ONE},
+	{"reverse z", CLIP_REVERSE_Z},
+	{"reverse z zero to one", CLIP_ZERO_TO_ONE | CLIP_REVERSE_Z},
+	{"infinite far", CLIP_INFINITE_FAR},
+	{"infinite far zero to one", CLIP_ZERO_TO_ONE | CLIP_INFINITE_FAR},
+	{"infinite reverse z", CLIP_ZERO_TO_ONE | CLIP_REVERSE_Z | CLIP_INFINITE_FAR},
+	{"flip y", CLIP_ZERO_TO_ONE | CLIP_FLIP_Y},
+}
+
+func TestFrustumFromMat4Clip(t *testing.T) {
+	view := NewMat4().Compose(&Vec3{1, 2, 3}, &Vec3{1, 1, 1}, NewQuat().FromAxisAngle(&Vec3{0, 1, 0}, 0.3))
+	inverse := view.Clone().Inverse()
+
+	points := []struct {
+		name          string
+		v             Vec3
+		finite, infin bool
+	}{
+		{"inside", Vec3{0, 0, -10}, true, true},
+		{"behind camera", Vec3{0, 0, 5}, false, false},
+		{"before near", Vec3{0, 0, -0.5}, false, false},
+		{"beyond far", Vec3{0, 0, -200}, false, true},
+		{"far away", Vec3{0, 0, -1e6}, false, true},
+		{"left of view", Vec3{-20, 0, -10}, false, false},
+		{"above view", Vec3{0, 20, -10}, false, false},
+	}
+
+	for _, test := range clipFlagTests {
+		var projection, m Mat4
+		frustum := NewFrustum()
+
+		projection.MakePerspectiveClip(Pi*0.5, 1, 1, 100, test.flags)
+		frustum.FromMat4Clip(m.MMul(&projection, view), test.flags)
+
+		for _, p := range points {
+			v := p.v
+			want := p.finite
+
+			if test.flags&CLIP_INFINITE_FAR != 0 {
+				want = p.infin
+			}
+			if got := frustum.ContainsPoint(v.ApplyMat4(inverse)); got != want {
+				t.Errorf("%s: ContainsPoint(%s) = %v, want %v", test.name, p.name, got, want)
+			}
+		}
+
+		sphere := NewSphere(NewVec3(0, 0, -1e6), 10)
+
+		if got := frustum.IntersectsSphere(sphere.ApplyMat4(inverse)); got != (test.flags&CLIP_INFINITE_FAR != 0) {
+			t.Errorf("%s: IntersectsSphere(far away) = %v", test.name, got)
+		}
+	}
+}
+
+func TestFrustumFromMat4MatchesOpenGL(t *testing.T) {
+	var projection Mat4
+
+	projection.MakePerspective(1, 1.5, 0.1, 50)
+
+	a, b := NewFrustum().FromMat4(&projection), NewFrustum().FromMat4Clip(&projection, 0)
+
+	for i := range a.Planes {
+		if *a.Planes[i].Normal != *b.Planes[i].Normal || a.Planes[i].Constant != b.Planes[i].Constant {
+			t.Errorf("plane %d: FromMat4 = %v, FromMat4Clip(0) = %v", i, a.Planes[i], b.Planes[i])
+		}
+	}
+}
+
+func TestFrustumClassify(t *testing.T) {
+	var projection Mat4
+
+	// a 90 degree square view, so the side planes are x = ±z and y = ±z
+	projection.MakePerspective(Pi*0.5, 1, 1, 100)
+	frustum := NewFrustum().FromMat4(&projection)
+
+	points := []struct {
+		v    Vec3
+		want bool
+	}{
+		{Vec3{0, 0, -10}, true},
+		{Vec3{9.9, -9.9, -10}, true},
+		{Vec3{10.1, 0, -10}, false},
+		{Vec3{0, 0, -0.9}, false},
+		{Vec3{0, 0, -100.1}, false},
+		{Vec3{0, 0, 5}, false},
+	}
+
+	for _, test := range points {
+		if got := frustum.ContainsPoint(&test.v); got != test.want {
+			t.Errorf("ContainsPoint(%v) = %v, want %v", test.v, got, test.want)
+		}
+	}
+
+	spheres := []struct {
+		name   string
+		sphere *Sphere
+		want   int
+	}{
+		{"inside", NewSphere(&Vec3{0, 0, -50}, 1), INSIDE},
+		{"across near", NewSphere(&Vec3{0, 0, -1}, 0.5), INTERSECT},
+		{"across left", NewSphere(&Vec3{-10.5, 0, -10}, 1), INTERSECT},
+		{"left", NewSphere(&Vec3{-13, 0, -10}, 1), OUTSIDE},
+		{"behind", NewSphere(&Vec3{0, 0, 5}, 1), OUTSIDE},
+		{"beyond far", NewSphere(&Vec3{0, 0, -102}, 1), OUTSIDE},
+	}
+
+	for _, test := range spheres {
+		if got := frustum.ClassifySphere(test.sphere); got != test.want {
+			t.Errorf("%s ClassifySphere(%v) = %v, want %v", test.name, test.sphere, got, test.want)
+		}
+		if got := frustum.IntersectsSphere(test.sphere); got != (test.want != OUTSIDE) {
+			t.Errorf("%s IntersectsSphere(%v) = %v", test.name, test.sphere, got)
+		}
+	}
+
+	boxes := []struct {
+		name string
+		box  *AABB3
+		want int
+	}{
+		{"inside", NewAABB3(&Vec3{-1, -1, -20}, &Vec3{1, 1, -10}), INSIDE},
+		{"across far", NewAABB3(&Vec3{-1, -1, -110}, &Vec3{1, 1, -90}), INTERSECT},
+		{"across top", NewAABB3(&Vec3{-1, 9, -11}, &Vec3{1, 11, -10}), INTERSECT},
+		{"containing", NewAABB3(&Vec3{-500, -500, -500}, &Vec3{500, 500, 500}), INTERSECT},
+		{"behind", NewAABB3(&Vec3{-1, -1, 1}, &Vec3{1, 1, 2}), OUTSIDE},
+		{"right", NewAABB3(&Vec3{12, -1, -11}, &Vec3{14, 1, -10}), OUTSIDE},
+	}
+
+	for _, test := range boxes {
+		if got := frustum.ClassifyAABB3(test.box); got != test.want {
+			t.Errorf("%s ClassifyAABB3(%v) = %v, want %v", test.name, test.box, got, test.want)
+		}
+		if got := frustum.IntersectsAABB3(test.box); got != (test.want != OUTSIDE) {
+			t.Errorf("%s IntersectsAABB3(%v) = %v", test.name, test.box, got)
+		}
+	}
+}
+
+func TestFrustumFromViewProjection(t *testing.T) {
+	var projection, m Mat4
+
+	// the camera sits at x = 5 and looks down -z
+	view := NewMat4().Compose(&Vec3{5, 0, 0}, &Vec3{1, 1, 1}, NewQuat())
+	projection.MakePerspective(Pi*0.5, 1, 1, 100)
+	frustum := NewFrustum().FromMat4(m.MMul(&projection, view.Clone().Inverse()))
+
+	if !frustum.ContainsPoint(&Vec3{5, 0, -10}) || !frustum.ContainsPoint(&Vec3{14.9, 0, -10}) {
+		t.Errorf("FromMat4(view projection) does not contain points in front of the camera")
+	}
+	if frustum.ContainsPoint(&Vec3{-5.1, 0, -10}) || frustum.ContainsPoint(&Vec3{5, 0, 1}) {
+		t.Errorf("FromMat4(view projection) contains points outside the view")
+	}
+
+	for i, p := range frustum.Planes {
+		if l := p.Normal.Length(); !EqualsTol(l, 1, 1e-6) {
+			t.Errorf("plane %d normal length = %v, want 1", i, l)
+		}
+	}
+
+	clone := frustum.Clone()
+
+	if clone.Planes[0] == frustum.Planes[0] || !clone.Planes[0].Equals(frustum.Planes[0]) {
+		t.Errorf("Clone() = %v, want a copy of %v", clone, frustum)
+	}
+}
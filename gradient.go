@@ -0,0 +1,229 @@
+package mathf
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// gradient interpolation spaces, stop colors are linear
+const (
+	GRADIENT_LINEAR = iota
+	GRADIENT_SRGB
+	GRADIENT_HSV
+	GRADIENT_OKLAB
+)
+
+// color of a Gradient at Position from 0 to 1
+type GradientStop struct {
+	Position float32
+	Color    Color
+}
+
+// multi stop color gradient, Stops must be sorted by Position
+type Gradient struct {
+	Stops []GradientStop
+	Space int
+}
+
+// returns new Gradient interpolating in space from stops, stops are copied and sorted
+func NewGradient(stops []GradientStop, space int) *Gradient {
+	this := new(Gradient)
+
+	this.Space = space
+
+	return this.SetStops(stops)
+}
+
+// returns a copy of this
+func (this *Gradient) Clone() *Gradient {
+
+	return NewGradient(this.Stops, this.Space)
+}
+
+// sets stops of this, stops are copied and sorted
+func (this *Gradient) SetStops(stops []GradientStop) *Gradient {
+
+	this.Stops = append(this.Stops[:0], stops...)
+
+	sort.SliceStable(this.Stops, func(i, j int) bool {
+		return this.Stops[i].Position < this.Stops[j].Position
+	})
+
+	return this
+}
+
+// adds a stop to this keeping Stops sorted, equal positions keep insertion order
+func (this *Gradient) AddStop(position float32, color *Color) *Gradient {
+	i := sort.Search(len(this.Stops), func(i int) bool {
+		return this.Stops[i].Position > position
+	})
+
+	this.Stops = append(this.Stops, GradientStop{})
+	copy(this.Stops[i+1:], this.Stops[i:])
+	this.Stops[i] = GradientStop{position, *color}
+
+	return this
+}
+
+// returns color at x, clamped to the first and last stops, saves in target
+func (this *Gradient) Evaluate(x float32, target *Color) *Color {
+	stops := this.Stops
+	l := len(stops)
+
+	if l == 0 {
+		return target.Set(0, 0, 0, 0)
+	}
+	if x <= stops[0].Position {
+		return target.Copy(&stops[0].Color)
+	}
+	if x >= stops[l-1].Position {
+		return target.Copy(&stops[l-1].Color)
+	}
+
+	i := sort.Search(l, func(i int) bool {
+		return stops[i].Position > x
+	})
+	a, b := &stops[i-1], &stops[i]
+
+	return target.CInterpolate(&a.Color, &b.Color, (x-a.Position)/(b.Position-a.Position), this.Space)
+}
+
+// samples this evenly from 0 to 1 into dst and returns it, for lookup tables
+func (this *Gradient) Sample(dst []Color) []Color {
+	l := len(dst)
+
+	if l == 1 {
+		this.Evaluate(0, &dst[0])
+	}
+
+	for i := 0; l > 1 && i < l; i++ {
+		this.Evaluate(float32(i)/float32(l-1), &dst[i])
+	}
+
+	return dst
+}
+
+// returns this as string type
+func (this *Gradient) String() string {
+
+	return fmt.Sprintf("Gradient[ Stops: %v, Space: %d ]", this.Stops, this.Space)
+}
+
+// interpolates linear colors a and b by x in gradient space, alpha is lerped, saves in this
+func (this *ColorT[T]) CInterpolate(a, b *ColorT[T], x T, space int) *ColorT[T] {
+	alpha := lerp(x, a[3], b[3])
+
+	switch space {
+	case GRADIENT_SRGB:
+		ca, cb := *a, *b
+
+		this.CLerp(ca.ToSRGB(), cb.ToSRGB(), x).ToLinear()
+	case GRADIENT_HSV:
+		var ha, hb Vec3T[T]
+		ca, cb := *a, *b
+
+		ca.ToSRGB().ToHSV(&ha)
+		cb.ToSRGB().ToHSV(&hb)
+
+		if ha[1] == 0 {
+			ha[0] = hb[0]
+		}
+		if hb[1] == 0 {
+			hb[0] = ha[0]
+		}
+
+		d := T(math.Mod(float64(hb[0]-ha[0]), 360))
+
+		if d > 180 {
+			d -= 360
+		} else if d < -180 {
+			d += 360
+		}
+
+		ha.Set(ha[0]+d*x, lerp(x, ha[1], hb[1]), lerp(x, ha[2], hb[2]))
+		this.FromHSV(&ha).ToLinear()
+	case GRADIENT_OKLAB:
+		var la, lb Vec3T[T]
+
+		a.ToOklab(&la)
+		b.ToOklab(&lb)
+		this.FromOklab(la.Lerp(&lb, x))
+	default:
+		this.CLerp(a, b, x)
+	}
+
+	this[3] = alpha
+
+	return this
+}
+
+// rotates hue of this by degrees in HSV of the stored elements, alpha is unchanged
+func (this *ColorT[T]) RotateHue(degrees T) *ColorT[T] {
+	var hsv Vec3T[T]
+
+	this.ToHSV(&hsv)
+	hsv[0] += degrees
+
+	return this.FromHSV(&hsv)
+}
+
+// sets rgb of this from cosine palette a + b * cos(2 * Pi * (c * x + d)), alpha is set to 1
+func (this *ColorT[T]) FromCosine(x T, a, b, c, d *Vec3T[T]) *ColorT[T] {
+
+	for i := 0; i < 3; i++ {
+		this[i] = a[i] + b[i]*T(math.Cos(2*math.Pi*float64(c[i]*x+d[i])))
+	}
+
+	this[3] = 1
+
+	return this
+}
+
+// returns base and its complementary color, hue is rotated in HSV
+func ComplementaryPalette[T Float](base *ColorT[T]) []ColorT[T] {
+	palette := []ColorT[T]{*base, *base}
+
+	palette[1].RotateHue(180)
+
+	return palette
+}
+
+// returns base and the two colors 120 degrees of hue away from it, hue is rotated in HSV
+func TriadicPalette[T Float](base *ColorT[T]) []ColorT[T] {
+	palette := []ColorT[T]{*base, *base, *base}
+
+	palette[1].RotateHue(120)
+	palette[2].RotateHue(240)
+
+	return palette
+}
+
+// returns n colors with hues spaced by degrees and centered on base, hue is rotated in HSV
+func AnalogousPalette[T Float](base *ColorT[T], n int, degrees T) []ColorT[T] {
+	palette := make([]ColorT[T], n)
+
+	for i := range palette {
+		palette[i] = *base
+		palette[i].RotateHue((T(i) - T(n-1)*0.5) * degrees)
+	}
+
+	return palette
+}
+
+// samples cosine palette a + b * cos(2 * Pi * (c * x + d)) evenly for x from 0 to 1 into dst and returns it
+func CosinePalette[T Float](dst []ColorT[T], a, b, c, d *Vec3T[T]) []ColorT[T] {
+	l := len(dst)
+
+	for i := range dst {
+		x := T(0)
+
+		if l > 1 {
+			x = T(i) / T(l-1)
+		}
+
+		dst[i].FromCosine(x, a, b, c, d)
+	}
+
+	return dst
+}
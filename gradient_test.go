@@ -0,0 +1,155 @@
+package mathf
+
+import (
+	"testing"
+)
+
+func TestGradientEvaluate(t *testing.T) {
+	g := NewGradient([]GradientStop{
+		{0, Color{0, 0, 0, 1}},
+		{0.5, Color{1, 0, 0, 1}},
+		{1, Color{1, 1, 1, 0.5}},
+	}, GRADIENT_LINEAR)
+
+	tests := []struct {
+		x    float32
+		want Color
+	}{
+		{-1, Color{0, 0, 0, 1}},
+		{0, Color{0, 0, 0, 1}},
+		{0.25, Color{0.5, 0, 0, 1}},
+		{0.5, Color{1, 0, 0, 1}},
+		{0.75, Color{1, 0.5, 0.5, 0.75}},
+		{1, Color{1, 1, 1, 0.5}},
+		{2, Color{1, 1, 1, 0.5}},
+	}
+
+	for _, test := range tests {
+		if got := g.Evaluate(test.x, new(Color)); !got.EqualsTol(&test.want, 1e-6) {
+			t.Errorf("Evaluate(%v) = %v, want %v", test.x, *got, test.want)
+		}
+	}
+
+	samples := g.Sample(make([]Color, 5))
+
+	for i, test := range tests[1:6] {
+		if !samples[i].EqualsTol(&test.want, 1e-6) {
+			t.Errorf("Sample()[%d] = %v, want %v", i, samples[i], test.want)
+		}
+	}
+	if got := g.Sample(make([]Color, 1)); !got[0].Equals(&tests[0].want) {
+		t.Errorf("Sample() of one = %v, want first stop %v", got[0], tests[0].want)
+	}
+	if got := new(Gradient).Evaluate(0.5, &Color{1, 1, 1, 1}); !got.Equals(&Color{0, 0, 0, 0}) {
+		t.Errorf("empty Evaluate() = %v, want transparent", *got)
+	}
+}
+
+func TestGradientUnsortedStops(t *testing.T) {
+	stops := []GradientStop{
+		{1, Color{0, 0, 1, 1}},
+		{0, Color{1, 0, 0, 1}},
+		{0.5, Color{0, 1, 0, 1}},
+	}
+	g := NewGradient(stops, GRADIENT_LINEAR)
+
+	if stops[0].Position != 1 {
+		t.Errorf("NewGradient() sorted the caller's slice")
+	}
+	for i, want := range []float32{0, 0.5, 1} {
+		if g.Stops[i].Position != want {
+			t.Errorf("Stops[%d].Position = %v, want %v", i, g.Stops[i].Position, want)
+		}
+	}
+
+	want := Color{0.5, 0.5, 0, 1}
+
+	if got := g.Evaluate(0.25, new(Color)); !got.EqualsTol(&want, 1e-6) {
+		t.Errorf("Evaluate(0.25) = %v, want %v", *got, want)
+	}
+
+	// a stop added at an existing position goes after it, making a hard edge
+	g.AddStop(0.5, &Color{1, 1, 1, 1})
+
+	if got := g.Evaluate(0.5, new(Color)); !got.Equals(&Color{1, 1, 1, 1}) {
+		t.Errorf("Evaluate(0.5) after AddStop = %v, want the added stop", *got)
+	}
+	if got := g.Evaluate(0.4999, new(Color)); !got.EqualsTol(&Color{0, 1, 0, 1}, 1e-3) {
+		t.Errorf("Evaluate(0.4999) after AddStop = %v, want the first stop at 0.5", *got)
+	}
+	if g.AddStop(-1, &Color{}); g.Stops[0].Position != -1 || len(g.Stops) != 5 {
+		t.Errorf("AddStop(-1) Stops = %v", g.Stops)
+	}
+}
+
+func TestGradientSpaces(t *testing.T) {
+	red, green := Color{1, 0, 0, 1}, Color{0, 1, 0, 0}
+	black, white := Color{0, 0, 0, 1}, Color{1, 1, 1, 1}
+
+	// hues 350 and 10 as linear colors, the shortest path passes through red not cyan
+	hue350 := *(&Color{1, 0, 1.0 / 6, 1}).ToLinear()
+	hue10 := *(&Color{1, 1.0 / 6, 0, 1}).ToLinear()
+
+	tests := []struct {
+		name  string
+		a, b  Color
+		x     float32
+		space int
+		want  Color
+	}{
+		{"linear", red, green, 0.5, GRADIENT_LINEAR, Color{0.5, 0.5, 0, 0.5}},
+		{"srgb", red, green, 0.5, GRADIENT_SRGB, Color{0.21404114, 0.21404114, 0, 0.5}},
+		{"srgb gray", black, white, 0.5, GRADIENT_SRGB, Color{0.21404114, 0.21404114, 0.21404114, 1}},
+		{"hsv", red, green, 0.5, GRADIENT_HSV, Color{1, 1, 0, 0.5}},
+		{"hsv wrap", hue350, hue10, 0.5, GRADIENT_HSV, Color{1, 0, 0, 1}},
+		{"hsv wrap reversed", hue10, hue350, 0.25, GRADIENT_HSV, *(&Color{1, 1.0 / 12, 0, 1}).ToLinear()},
+		{"hsv gray takes hue", white, red, 0.5, GRADIENT_HSV, Color{1, 0.21404114, 0.21404114, 1}},
+		{"oklab gray", black, white, 0.5, GRADIENT_OKLAB, Color{0.125, 0.125, 0.125, 1}},
+		{"oklab endpoint", red, green, 1, GRADIENT_OKLAB, green},
+	}
+
+	for _, test := range tests {
+		if got := new(Color).CInterpolate(&test.a, &test.b, test.x, test.space); !got.EqualsTol(&test.want, 1e-4) {
+			t.Errorf("%s CInterpolate(%v, %v, %v) = %v, want %v", test.name, test.a, test.b, test.x, *got, test.want)
+		}
+
+		g := NewGradient([]GradientStop{{0, test.a}, {1, test.b}}, test.space)
+
+		if got := g.Evaluate(test.x, new(Color)); !got.EqualsTol(&test.want, 1e-4) {
+			t.Errorf("%s Evaluate(%v) = %v, want %v", test.name, test.x, *got, test.want)
+		}
+	}
+}
+
+func TestPalettes(t *testing.T) {
+	red := Color{1, 0, 0, 1}
+
+	tests := []struct {
+		name    string
+		palette []Color
+		want    []Color
+	}{
+		{"complementary", ComplementaryPalette(&red), []Color{red, {0, 1, 1, 1}}},
+		{"triadic", TriadicPalette(&red), []Color{red, {0, 1, 0, 1}, {0, 0, 1, 1}}},
+		{"analogous", AnalogousPalette(&red, 3, 30), []Color{{1, 0, 0.5, 1}, red, {1, 0.5, 0, 1}}},
+		{"analogous even", AnalogousPalette(&red, 2, 60), []Color{{1, 0, 0.5, 1}, {1, 0.5, 0, 1}}},
+		{"cosine", CosinePalette(make([]Color, 3), &Vec3{0.5, 0.5, 0.5}, &Vec3{0.5, 0.5, 0.5}, &Vec3{1, 1, 1}, &Vec3{0, 0.25, 0.5}),
+			[]Color{{1, 0.5, 0, 1}, {0, 0.5, 1, 1}, {1, 0.5, 0, 1}}},
+	}
+
+	for _, test := range tests {
+		if len(test.palette) != len(test.want) {
+			t.Errorf("%s palette = %v, want %v", test.name, test.palette, test.want)
+			continue
+		}
+		for i := range test.want {
+			if !test.palette[i].EqualsTol(&test.want[i], 1e-5) {
+				t.Errorf("%s palette[%d] = %v, want %v", test.name, i, test.palette[i], test.want[i])
+			}
+		}
+	}
+
+	if got := (&Color{1, 0, 0, 0.5}).RotateHue(-120); !got.EqualsTol(&Color{0, 0, 1, 0.5}, 1e-5) {
+		t.Errorf("RotateHue(-120) = %v, want blue", *got)
+	}
+}
@@ -0,0 +1,360 @@
+package mathf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"unsafe"
+)
+
+// text layout of a type as printed by String, order maps printed position to element, nil keeps element order
+type textLayout struct {
+	name        string
+	open, close byte
+	order       []int
+	columns     int
+}
+
+var (
+	vec2Text  = &textLayout{"Vec2", '[', ']', nil, 2}
+	vec3Text  = &textLayout{"Vec3", '[', ']', nil, 3}
+	vec4Text  = &textLayout{"Vec4", '[', ']', nil, 4}
+	quatText  = &textLayout{"Quat", '[', ']', nil, 4}
+	colorText = &textLayout{"Color", '[', ']', nil, 4}
+	dualText  = &textLayout{"DualQuat", '[', ']', nil, 8}
+	eulerText = &textLayout{"Euler", '[', ']', nil, 4}
+	mat2Text  = &textLayout{"Mat2", '[', ']', []int{0, 2, 1, 3}, 2}
+	mat32Text = &textLayout{"Mat32", '[', ']', []int{0, 2, 4, 1, 3, 5}, 3}
+	mat3Text  = &textLayout{"Mat3", '{', '}', []int{0, 3, 6, 1, 4, 7, 2, 5, 8}, 3}
+	mat4Text  = &textLayout{"Mat4", '[', ']', []int{0, 4, 8, 12, 1, 5, 9, 13, 2, 6, 10, 14, 3, 7, 11, 15}, 4}
+)
+
+// text layout of a struct as printed by String with "Key: value" fields, nil field layouts are scalars
+type textStruct struct {
+	name   string
+	keys   []string
+	fields []*textLayout
+}
+
+var (
+	aabb2Text  = &textStruct{"AABB2", []string{"Min", "Max"}, []*textLayout{vec2Text, vec2Text}}
+	aabb3Text  = &textStruct{"AABB3", []string{"Min", "Max"}, []*textLayout{vec3Text, vec3Text}}
+	circleText = &textStruct{"Circle", []string{"Center", "Radius"}, []*textLayout{vec2Text, nil}}
+	sphereText = &textStruct{"Sphere", []string{"Center", "Radius"}, []*textLayout{vec3Text, nil}}
+	planeText  = &textStruct{"Plane", []string{"Normal", "Constant"}, []*textLayout{vec3Text, nil}}
+	ray2Text   = &textStruct{"Ray2", []string{"Origin", "Direction"}, []*textLayout{vec2Text, vec2Text}}
+	ray3Text   = &textStruct{"Ray3", []string{"Origin", "Direction"}, []*textLayout{vec3Text, vec3Text}}
+	obb2Text   = &textStruct{"OBB2", []string{"Center", "HalfSize", "Rotation"}, []*textLayout{vec2Text, vec2Text, mat2Text}}
+	obb3Text   = &textStruct{"OBB3", []string{"Center", "HalfSize", "Rotation"}, []*textLayout{vec3Text, vec3Text, mat3Text}}
+)
+
+// returns number of elements in layout
+func (this *textLayout) size() int {
+
+	if this.order != nil {
+		return len(this.order)
+	}
+
+	return this.columns
+}
+
+// returns value at p, or the zero value if p is nil
+func valueOrZero[V any](p *V) V {
+
+	if p == nil {
+		var zero V
+		return zero
+	}
+
+	return *p
+}
+
+// appends x with the fewest digits that parse back to x
+func appendFloat[T Float](b []byte, x T) []byte {
+
+	return strconv.AppendFloat(b, float64(x), 'g', -1, int(unsafe.Sizeof(x))*8)
+}
+
+// parses x from text
+func parseFloat[T Float](text []byte) (T, error) {
+	var x T
+
+	v, err := strconv.ParseFloat(string(bytes.TrimSpace(text)), int(unsafe.Sizeof(x))*8)
+
+	return T(v), err
+}
+
+// appends values in layout with round trip precision
+func appendText[T Float](b []byte, layout *textLayout, values []T) []byte {
+	rows := len(values) / layout.columns
+
+	b = append(b, layout.name...)
+	b = append(b, layout.open)
+
+	if rows > 1 {
+		b = append(b, '\n')
+	}
+
+	for i := range values {
+		j := i
+
+		if layout.order != nil {
+			j = layout.order[i]
+		}
+		if i > 0 {
+			b = append(b, ',')
+
+			if i%layout.columns == 0 {
+				b = append(b, '\n')
+			}
+		}
+
+		b = append(b, ' ')
+		b = appendFloat(b, values[j])
+	}
+
+	if rows > 1 {
+		b = append(b, '\n')
+	}
+
+	return append(b, ' ', layout.close)
+}
+
+// parses values in layout, any float format is accepted, values are unchanged on error
+func parseText[T Float](text []byte, layout *textLayout, values []T) error {
+	var parsed [16]T
+	inner, ok := textBody(text, layout.name, layout.open, layout.close)
+
+	if !ok {
+		return textError(layout.name, text)
+	}
+
+	fields := bytes.FieldsFunc(inner, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+
+	if len(fields) != len(values) {
+		return textError(layout.name, text)
+	}
+
+	for i, field := range fields {
+		j := i
+
+		if layout.order != nil {
+			j = layout.order[i]
+		}
+
+		x, err := parseFloat[T](field)
+
+		if err != nil {
+			return textError(layout.name, text)
+		}
+
+		parsed[j] = x
+	}
+
+	copy(values, parsed[:len(values)])
+
+	return nil
+}
+
+// appends the fields of layout with elements in field order
+func appendStructText[T Float](b []byte, layout *textStruct, elements []T) []byte {
+
+	b = append(b, layout.name...)
+	b = append(b, '[')
+
+	for i, key := range layout.keys {
+		field := layout.fields[i]
+
+		if i > 0 {
+			b = append(b, ',')
+		}
+
+		b = append(b, ' ')
+		b = append(b, key...)
+		b = append(b, ':', ' ')
+
+		if field == nil {
+			b = appendFloat(b, elements[0])
+			elements = elements[1:]
+		} else {
+			b = appendText(b, field, elements[:field.size()])
+			elements = elements[field.size():]
+		}
+	}
+
+	return append(b, ' ', ']')
+}
+
+// parses the fields of layout into elements in field order
+func parseStructText[T Float](text []byte, layout *textStruct, elements []T) error {
+	fields, err := textFields(text, layout.name, layout.keys...)
+
+	if err != nil {
+		return err
+	}
+
+	for i, field := range layout.fields {
+		if field == nil {
+			if elements[0], err = parseFloat[T](fields[i]); err != nil {
+				return textError(layout.name, text)
+			}
+			elements = elements[1:]
+		} else {
+			if err = parseText(fields[i], field, elements[:field.size()]); err != nil {
+				return err
+			}
+			elements = elements[field.size():]
+		}
+	}
+
+	return nil
+}
+
+// returns text between name and its brackets
+func textBody(text []byte, name string, open, close byte) ([]byte, bool) {
+	text = bytes.TrimSpace(text)
+
+	if !bytes.HasPrefix(text, []byte(name)) {
+		return nil, false
+	}
+
+	text = text[len(name):]
+
+	if len(text) < 2 || text[0] != open || text[len(text)-1] != close {
+		return nil, false
+	}
+
+	return text[1 : len(text)-1], true
+}
+
+// splits the "Key: value" fields of a struct printed by String, fields must be in order of keys
+func textFields(text []byte, name string, keys ...string) ([][]byte, error) {
+	inner, ok := textBody(text, name, '[', ']')
+
+	if !ok {
+		return nil, textError(name, text)
+	}
+
+	fields := make([][]byte, 0, len(keys))
+	depth, start := 0, 0
+
+	for i, c := range inner {
+		switch c {
+		case '[', '{':
+			depth++
+		case ']', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				fields = append(fields, inner[start:i])
+				start = i + 1
+			}
+		}
+	}
+	fields = append(fields, inner[start:])
+
+	if len(fields) != len(keys) {
+		return nil, textError(name, text)
+	}
+
+	for i, key := range keys {
+		field := bytes.TrimSpace(fields[i])
+
+		if !bytes.HasPrefix(field, []byte(key)) || len(field) <= len(key) || field[len(key)] != ':' {
+			return nil, textError(name, text)
+		}
+
+		fields[i] = field[len(key)+1:]
+	}
+
+	return fields, nil
+}
+
+// returns error for text that is not a name
+func textError(name string, text []byte) error {
+
+	return fmt.Errorf("mathf: invalid %s text %q", name, text)
+}
+
+// appends values as a JSON array
+func appendJSON[T Float](b []byte, values []T) ([]byte, error) {
+
+	b = append(b, '[')
+
+	for i, x := range values {
+		if math.IsNaN(float64(x)) || math.IsInf(float64(x), 0) {
+			return nil, fmt.Errorf("mathf: unsupported JSON value %v", x)
+		}
+		if i > 0 {
+			b = append(b, ',')
+		}
+
+		b = appendFloat(b, x)
+	}
+
+	return append(b, ']'), nil
+}
+
+// parses a JSON array of exactly len(values) numbers, null leaves values unchanged
+func parseJSON[T Float](data []byte, values []T) error {
+	var array []T
+
+	if isJSONNull(data) {
+		return nil
+	}
+	if err := json.Unmarshal(data, &array); err != nil {
+		return err
+	}
+	if len(array) != len(values) {
+		return fmt.Errorf("mathf: JSON array has %d elements, expected %d", len(array), len(values))
+	}
+
+	copy(values, array)
+
+	return nil
+}
+
+// checks if data is the JSON null literal
+func isJSONNull(data []byte) bool {
+
+	return string(bytes.TrimSpace(data)) == "null"
+}
+
+// appends values as little endian floats
+func appendBinary[T Float](b []byte, values []T) []byte {
+
+	for _, x := range values {
+		if unsafe.Sizeof(x) == 4 {
+			b = binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(x)))
+		} else {
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(float64(x)))
+		}
+	}
+
+	return b
+}
+
+// parses exactly len(values) little endian floats
+func parseBinary[T Float](data []byte, values []T) error {
+	var x T
+	size := int(unsafe.Sizeof(x))
+
+	if len(data) != len(values)*size {
+		return fmt.Errorf("mathf: binary data has %d bytes, expected %d", len(data), len(values)*size)
+	}
+
+	for i := range values {
+		if size == 4 {
+			values[i] = T(math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:])))
+		} else {
+			values[i] = T(math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:])))
+		}
+	}
+
+	return nil
+}
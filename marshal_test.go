@@ -0,0 +1,161 @@
+package mathf
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// a marshalable value and a constructor for an empty one of the same type
+var marshalTests = []struct {
+	name  string
+	value any
+	empty func() any
+}{
+	{"Vec2", &Vec2{0.1, -2.5}, func() any { return new(Vec2) }},
+	{"Vec3", &Vec3{0.1, -2.5, 1e-7}, func() any { return new(Vec3) }},
+	{"Vec3d", &Vec3d{0.1, -2.5, 1e300}, func() any { return new(Vec3d) }},
+	{"Vec4", &Vec4{0.1, -2.5, 1e-7, 3.4028235e38}, func() any { return new(Vec4) }},
+	{"Quat", &Quat{0.1, 0.2, 0.3, 0.9}, func() any { return new(Quat) }},
+	{"Color", &Color{1, 0.5, 0.25, 0.125}, func() any { return new(Color) }},
+	{"DualQuat", &DualQuat{0, 0, 0.6, 0.8, 0.1, 0.2, 0.3, 0}, func() any { return new(DualQuat) }},
+	{"Euler", &Euler{0.1, -0.2, 0.3, EULER_ZXY}, func() any { return new(Euler) }},
+	{"Mat2", &Mat2{1, 2, 3, 4}, func() any { return new(Mat2) }},
+	{"Mat32", &Mat32{1, 2, 3, 4, 5, 6}, func() any { return new(Mat32) }},
+	{"Mat3", &Mat3{1, 2, 3, 4, 5, 6, 7, 8, 9}, func() any { return new(Mat3) }},
+	{"Mat4", &Mat4{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16.5}, func() any { return new(Mat4) }},
+	{"Mat4d", &Mat4d{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 0.1}, func() any { return new(Mat4d) }},
+	{"AABB2", &AABB2{&Vec2{-1, -2}, &Vec2{3, 4.5}}, func() any { return new(AABB2) }},
+	{"AABB3", &AABB3{&Vec3{-1, -2, -3}, &Vec3{3, 4.5, 0.1}}, func() any { return new(AABB3) }},
+	{"Circle", &Circle{&Vec2{1, 2}, 0.5}, func() any { return new(Circle) }},
+	{"Sphere", &Sphere{&Vec3{1, 2, 3}, 0.5}, func() any { return new(Sphere) }},
+	{"Plane", &Plane{&Vec3{0, 1, 0}, -2.5}, func() any { return new(Plane) }},
+	{"Ray2", &Ray2{&Vec2{1, 2}, &Vec2{0, 1}}, func() any { return new(Ray2) }},
+	{"Ray3", &Ray3{&Vec3{1, 2, 3}, &Vec3{0, 0, -1}}, func() any { return new(Ray3) }},
+	{"OBB2", &OBB2{&Vec2{1, 2}, &Vec2{3, 4}, &Mat2{0, 1, -1, 0}}, func() any { return new(OBB2) }},
+	{"OBB3", &OBB3{&Vec3{1, 2, 3}, &Vec3{4, 5, 6}, &Mat3{0, 1, 0, -1, 0, 0, 0, 0, 1}}, func() any { return new(OBB3) }},
+}
+
+func TestMarshalTextRoundTrip(t *testing.T) {
+
+	for _, test := range marshalTests {
+		text, err := test.value.(encoding.TextMarshaler).MarshalText()
+
+		if err != nil {
+			t.Errorf("%s MarshalText: %v", test.name, err)
+			continue
+		}
+
+		got := test.empty()
+
+		if err := got.(encoding.TextUnmarshaler).UnmarshalText(text); err != nil {
+			t.Errorf("%s UnmarshalText(%q): %v", test.name, text, err)
+		} else if !reflect.DeepEqual(got, test.value) {
+			t.Errorf("%s text round trip = %v, want %v", test.name, got, test.value)
+		}
+	}
+}
+
+func TestMarshalJSONRoundTrip(t *testing.T) {
+
+	for _, test := range marshalTests {
+		data, err := json.Marshal(test.value)
+
+		if err != nil {
+			t.Errorf("%s json.Marshal: %v", test.name, err)
+			continue
+		}
+
+		got := test.empty()
+
+		if err := json.Unmarshal(data, got); err != nil {
+			t.Errorf("%s json.Unmarshal(%s): %v", test.name, data, err)
+		} else if !reflect.DeepEqual(got, test.value) {
+			t.Errorf("%s JSON round trip = %v, want %v", test.name, got, test.value)
+		}
+	}
+}
+
+func TestMarshalBinaryRoundTrip(t *testing.T) {
+
+	for _, test := range marshalTests {
+		data, err := test.value.(encoding.BinaryMarshaler).MarshalBinary()
+
+		if err != nil {
+			t.Errorf("%s MarshalBinary: %v", test.name, err)
+			continue
+		}
+
+		got := test.empty()
+
+		if err := got.(encoding.BinaryUnmarshaler).UnmarshalBinary(data); err != nil {
+			t.Errorf("%s UnmarshalBinary: %v", test.name, err)
+		} else if !reflect.DeepEqual(got, test.value) {
+			t.Errorf("%s binary round trip = %v, want %v", test.name, got, test.value)
+		}
+	}
+}
+
+func TestMarshalNilFields(t *testing.T) {
+	type level struct {
+		Box    AABB3
+		Floor  Plane
+		Bounds Sphere
+		Pick   Ray3
+		Prop   OBB3
+	}
+
+	// the marshalers have pointer receivers, so the fields are only reached through an addressable value
+	data, err := json.Marshal(&level{})
+
+	if err != nil {
+		t.Fatalf("json.Marshal of nil fields: %v", err)
+	}
+
+	var got level
+
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal(%s): %v", data, err)
+	}
+	if *got.Box.Min != (Vec3{}) || *got.Floor.Normal != (Vec3{}) || *got.Prop.Rotation != (Mat3{}) {
+		t.Errorf("nil fields decoded as %s, want zeros", data)
+	}
+
+	for _, test := range marshalTests {
+		empty := test.empty()
+
+		if _, err := empty.(encoding.TextMarshaler).MarshalText(); err != nil {
+			t.Errorf("%s MarshalText of zero value: %v", test.name, err)
+		}
+		if _, err := empty.(encoding.BinaryMarshaler).MarshalBinary(); err != nil {
+			t.Errorf("%s MarshalBinary of zero value: %v", test.name, err)
+		}
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		into encoding.TextUnmarshaler
+	}{
+		{"wrong name", "Vec2[ 1, 2 ]", new(Vec3)},
+		{"too few", "Vec3[ 1, 2 ]", new(Vec3)},
+		{"not a number", "Vec3[ 1, x, 3 ]", new(Vec3)},
+		{"missing field", "AABB3[ Min: Vec3[ 0, 0, 0 ] ]", new(AABB3)},
+	}
+
+	for _, test := range tests {
+		if err := test.into.UnmarshalText([]byte(test.text)); err == nil {
+			t.Errorf("%s: UnmarshalText(%q) succeeded, want error", test.name, test.text)
+		}
+	}
+
+	if err := new(Vec3).UnmarshalBinary([]byte{1, 2, 3}); err == nil {
+		t.Error("UnmarshalBinary of short data succeeded, want error")
+	}
+	if err := json.Unmarshal([]byte("[1, 2]"), new(Vec3)); err == nil {
+		t.Error("json.Unmarshal of short array succeeded, want error")
+	}
+}
@@ -5,8 +5,14 @@ import (
 	"math"
 )
 
-// float32 Array representing a 2x2 Matrix
-type Mat2 [4]float32
+// Array representing a 2x2 Matrix of float32 or float64
+type Mat2T[T Float] [4]T
+
+// float32 Mat2
+type Mat2 = Mat2T[float32]
+
+// float64 Mat2
+type Mat2d = Mat2T[float64]
 
 // returns new Mat2
 func NewMat2() *Mat2 {
@@ -18,14 +24,24 @@ func NewMat2() *Mat2 {
 	return this
 }
 
+// returns new Mat2d
+func NewMat2d() *Mat2d {
+	this := new(Mat2d)
+
+	this[0], this[2] = 1, 0
+	this[1], this[3] = 0, 1
+
+	return this
+}
+
 // returns a copy of this
-func (this *Mat2) Clone() *Mat2 {
+func (this *Mat2T[T]) Clone() *Mat2T[T] {
 
-	return new(Mat2).Copy(this)
+	return new(Mat2T[T]).Copy(this)
 }
 
 // copies other
-func (this *Mat2) Copy(other *Mat2) *Mat2 {
+func (this *Mat2T[T]) Copy(other *Mat2T[T]) *Mat2T[T] {
 
 	this[0], this[2] = other[0], other[2]
 	this[1], this[3] = other[1], other[3]
@@ -34,7 +50,7 @@ func (this *Mat2) Copy(other *Mat2) *Mat2 {
 }
 
 // sets this from values
-func (this *Mat2) Set(m11, m12, m21, m22 float32) *Mat2 {
+func (this *Mat2T[T]) Set(m11, m12, m21, m22 T) *Mat2T[T] {
 
 	this[0], this[2] = m11, m12
 	this[1], this[3] = m21, m22
@@ -43,41 +59,41 @@ func (this *Mat2) Set(m11, m12, m21, m22 float32) *Mat2 {
 }
 
 // mutiples this by other
-func (this *Mat2) Mul(other *Mat2) *Mat2 {
+func (this *Mat2T[T]) Mul(other *Mat2T[T]) *Mat2T[T] {
 	a11, a12 := this[0], this[2]
 	a21, a22 := this[1], this[3]
 
 	b11, b12 := other[0], other[2]
 	b21, b22 := other[1], other[3]
 
-	this[0] = a11*b11 + a21*b12
-	this[1] = a12*b11 + a22*b12
+	this[0] = a11*b11 + a12*b21
+	this[2] = a11*b12 + a12*b22
 
-	this[2] = a11*b21 + a21*b22
-	this[3] = a12*b21 + a22*b22
+	this[1] = a21*b11 + a22*b21
+	this[3] = a21*b12 + a22*b22
 
 	return this
 }
 
 // mutiples a and b saves in this
-func (this *Mat2) MMul(a, b *Mat2) *Mat2 {
+func (this *Mat2T[T]) MMul(a, b *Mat2T[T]) *Mat2T[T] {
 	a11, a12 := a[0], a[2]
 	a21, a22 := a[1], a[3]
 
 	b11, b12 := b[0], b[2]
 	b21, b22 := b[1], b[3]
 
-	this[0] = a11*b11 + a21*b12
-	this[1] = a12*b11 + a22*b12
+	this[0] = a11*b11 + a12*b21
+	this[2] = a11*b12 + a12*b22
 
-	this[2] = a11*b21 + a21*b22
-	this[3] = a12*b21 + a22*b22
+	this[1] = a21*b11 + a22*b21
+	this[3] = a21*b12 + a22*b22
 
 	return this
 }
 
 // mutiples this by scalar
-func (this *Mat2) SMul(s float32) *Mat2 {
+func (this *Mat2T[T]) SMul(s T) *Mat2T[T] {
 
 	this[0] *= s
 	this[1] *= s
@@ -88,7 +104,7 @@ func (this *Mat2) SMul(s float32) *Mat2 {
 }
 
 // divides this by scalar
-func (this *Mat2) SDiv(s float32) *Mat2 {
+func (this *Mat2T[T]) SDiv(s T) *Mat2T[T] {
 	if s != 0 {
 		s = 1 / s
 	}
@@ -102,45 +118,49 @@ func (this *Mat2) SDiv(s float32) *Mat2 {
 }
 
 // returns inverse of this
-func (this *Mat2) Inverse() *Mat2 {
+func (this *Mat2T[T]) Inverse() *Mat2T[T] {
 
 	m11, m12 := this[0], this[2]
 	m21, m22 := this[1], this[3]
 
-	det := m11*m22 - m12*m22
+	det := m11*m22 - m12*m21
 	if det == 0 {
 		return this.Identity()
 	}
 
+	det = 1 / det
+
 	this[0] = m22 * det
-	this[1] = -m12 * det
-	this[2] = -m21 * det
+	this[1] = -m21 * det
+	this[2] = -m12 * det
 	this[3] = m11 * det
 
 	return this
 }
 
 // saves inverse of other in this
-func (this *Mat2) MInverse(other *Mat2) *Mat2 {
+func (this *Mat2T[T]) MInverse(other *Mat2T[T]) *Mat2T[T] {
 
 	m11, m12 := other[0], other[2]
 	m21, m22 := other[1], other[3]
 
-	det := m11*m22 - m12*m22
+	det := m11*m22 - m12*m21
 	if det == 0 {
 		return this.Identity()
 	}
 
+	det = 1 / det
+
 	this[0] = m22 * det
-	this[1] = -m12 * det
-	this[2] = -m21 * det
+	this[1] = -m21 * det
+	this[2] = -m12 * det
 	this[3] = m11 * det
 
 	return this
 }
 
 // returns this as identity
-func (this *Mat2) Identity() *Mat2 {
+func (this *Mat2T[T]) Identity() *Mat2T[T] {
 
 	this[0] = 1
 	this[1] = 0
@@ -151,7 +171,7 @@ func (this *Mat2) Identity() *Mat2 {
 }
 
 // transposes this across diagonal
-func (this *Mat2) Transpose() *Mat2 {
+func (this *Mat2T[T]) Transpose() *Mat2T[T] {
 
 	this[1], this[2] = this[2], this[1]
 
@@ -159,13 +179,13 @@ func (this *Mat2) Transpose() *Mat2 {
 }
 
 // returns the determinant
-func (this *Mat2) Determinant() float32 {
+func (this *Mat2T[T]) Determinant() T {
 
 	return this[0]*this[3] - this[2]*this[1]
 }
 
 // returns scale matrix
-func (this *Mat2) MakeScale(x, y float32) *Mat2 {
+func (this *Mat2T[T]) MakeScale(x, y T) *Mat2T[T] {
 
 	this[0], this[2] = x, 0
 	this[1], this[3] = 0, y
@@ -174,8 +194,8 @@ func (this *Mat2) MakeScale(x, y float32) *Mat2 {
 }
 
 // returns rotation matrix
-func (this *Mat2) MakeRotation(angle float32) *Mat2 {
-	s, c := float32(math.Sin(float64(angle))), float32(math.Cos(float64(angle)))
+func (this *Mat2T[T]) MakeRotation(angle T) *Mat2T[T] {
+	s, c := T(math.Sin(float64(angle))), T(math.Cos(float64(angle)))
 
 	this[0], this[2] = c, -s
 	this[1], this[3] = s, c
@@ -184,18 +204,18 @@ func (this *Mat2) MakeRotation(angle float32) *Mat2 {
 }
 
 // returns rotation of this matrix
-func (this *Mat2) GetRotation() float32 {
+func (this *Mat2T[T]) GetRotation() T {
 
-	return float32(math.Atan2(float64(this[1]), float64(this[0])))
+	return T(math.Atan2(float64(this[1]), float64(this[0])))
 }
 
 // rotates matrix by angle
-func (this *Mat2) Rotate(angle float32) *Mat2 {
+func (this *Mat2T[T]) Rotate(angle T) *Mat2T[T] {
 
 	m11, m12 := this[0], this[2]
 	m21, m22 := this[1], this[3]
 
-	s, c := float32(math.Sin(float64(angle))), float32(math.Cos(float64(angle)))
+	s, c := T(math.Sin(float64(angle))), T(math.Cos(float64(angle)))
 
 	this[0] = m11*c + m12*s
 	this[1] = m11*-s + m12*c
@@ -206,7 +226,7 @@ func (this *Mat2) Rotate(angle float32) *Mat2 {
 }
 
 // sets values from Mat32
-func (this *Mat2) FromMat32(m *Mat32) *Mat2 {
+func (this *Mat2T[T]) FromMat32(m *Mat32T[T]) *Mat2T[T] {
 
 	this[0] = m[0]
 	this[1] = m[1]
@@ -216,8 +236,52 @@ func (this *Mat2) FromMat32(m *Mat32) *Mat2 {
 	return this
 }
 
-// checks if this equals other
-func (this *Mat2) Equals(other *Mat2) bool {
+// returns product of this and other by value
+func (this Mat2T[T]) Times(other Mat2T[T]) Mat2T[T] {
+
+	return *this.Mul(&other)
+}
+
+// returns this scaled by s by value
+func (this Mat2T[T]) Scaled(s T) Mat2T[T] {
+
+	return *this.SMul(s)
+}
+
+// returns inverse of this by value
+func (this Mat2T[T]) Inverted() Mat2T[T] {
+
+	return *this.Inverse()
+}
+
+// returns transpose of this by value
+func (this Mat2T[T]) Transposed() Mat2T[T] {
+
+	return *this.Transpose()
+}
+
+// converts this to float32, saves in target
+func (this *Mat2T[T]) Float32(target *Mat2) *Mat2 {
+
+	for i, v := range this {
+		target[i] = float32(v)
+	}
+
+	return target
+}
+
+// converts this to float64, saves in target
+func (this *Mat2T[T]) Float64(target *Mat2d) *Mat2d {
+
+	for i, v := range this {
+		target[i] = float64(v)
+	}
+
+	return target
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *Mat2T[T]) Equals(other *Mat2T[T]) bool {
 
 	if this[0] != other[0] {
 		return false
@@ -235,8 +299,62 @@ func (this *Mat2) Equals(other *Mat2) bool {
 	return true
 }
 
+// checks if this equals other within absolute tolerance tol
+func (this *Mat2T[T]) EqualsTol(other *Mat2T[T], tol T) bool {
+
+	return equalsTolSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other within tol relative to its largest element magnitude
+func (this *Mat2T[T]) EqualsRel(other *Mat2T[T], tol T) bool {
+
+	return equalsRelSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *Mat2T[T]) EqualsULP(other *Mat2T[T], ulps int) bool {
+
+	return equalsULPSlice(this[:], other[:], ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *Mat2T[T]) MarshalText() ([]byte, error) {
+
+	return appendText(nil, mat2Text, this[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *Mat2T[T]) UnmarshalText(text []byte) error {
+
+	return parseText(text, mat2Text, this[:])
+}
+
+// returns this as a JSON array, implements json.Marshaler
+func (this *Mat2T[T]) MarshalJSON() ([]byte, error) {
+
+	return appendJSON(nil, this[:])
+}
+
+// parses a JSON array into this, implements json.Unmarshaler
+func (this *Mat2T[T]) UnmarshalJSON(data []byte) error {
+
+	return parseJSON(data, this[:])
+}
+
+// returns this as little endian floats, implements encoding.BinaryMarshaler
+func (this *Mat2T[T]) MarshalBinary() ([]byte, error) {
+
+	return appendBinary(nil, this[:]), nil
+}
+
+// parses little endian floats into this, implements encoding.BinaryUnmarshaler
+func (this *Mat2T[T]) UnmarshalBinary(data []byte) error {
+
+	return parseBinary(data, this[:])
+}
+
 // returns this as string
-func (this *Mat2) String() string {
+func (this *Mat2T[T]) String() string {
 
 	return fmt.Sprintf("Mat2[\n %f, %f,\n %f, %f\n ]", this[0], this[2], this[1], this[3])
 }
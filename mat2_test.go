@@ -0,0 +1,58 @@
+package mathf
+
+import (
+	"testing"
+)
+
+func TestMat2Mul(t *testing.T) {
+	tests := []struct {
+		a, b, want Mat2
+	}{
+		{Mat2{1, 3, 2, 4}, Mat2{5, 7, 6, 8}, Mat2{19, 43, 22, 50}},
+		{Mat2{5, 7, 6, 8}, Mat2{1, 3, 2, 4}, Mat2{23, 31, 34, 46}},
+		{Mat2{1, 0, 0, 1}, Mat2{5, 7, 6, 8}, Mat2{5, 7, 6, 8}},
+	}
+
+	for _, test := range tests {
+		a := test.a
+
+		if got := a.Mul(&test.b); !got.Equals(&test.want) {
+			t.Errorf("%v.Mul(%v) = %v, want %v", test.a, test.b, *got, test.want)
+		}
+		if got := new(Mat2).MMul(&test.a, &test.b); !got.Equals(&test.want) {
+			t.Errorf("MMul(%v, %v) = %v, want %v", test.a, test.b, *got, test.want)
+		}
+		if got := test.a.Times(test.b); !got.Equals(&test.want) {
+			t.Errorf("%v.Times(%v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestMat2Inverse(t *testing.T) {
+	tests := []struct {
+		m, want Mat2
+	}{
+		{Mat2{1, 3, 2, 4}, Mat2{-2, 1.5, 1, -0.5}},
+		{Mat2{2, 0, 0, 4}, Mat2{0.5, 0, 0, 0.25}},
+		{Mat2{0, 1, -1, 0}, Mat2{0, -1, 1, 0}},
+		{Mat2{1, 2, 2, 4}, Mat2{1, 0, 0, 1}},
+	}
+	identity := Mat2{1, 0, 0, 1}
+
+	for _, test := range tests {
+		m := test.m
+
+		if got := m.Inverse(); !got.EqualsTol(&test.want, 1e-6) {
+			t.Errorf("%v.Inverse() = %v, want %v", test.m, *got, test.want)
+		}
+		if got := new(Mat2).MInverse(&test.m); !got.EqualsTol(&test.want, 1e-6) {
+			t.Errorf("MInverse(%v) = %v, want %v", test.m, *got, test.want)
+		}
+		if test.m.Determinant() == 0 {
+			continue
+		}
+		if got := test.m.Times(test.m.Inverted()); !got.EqualsTol(&identity, 1e-6) {
+			t.Errorf("%v.Times(Inverted()) = %v, want identity", test.m, got)
+		}
+	}
+}
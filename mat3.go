@@ -5,8 +5,14 @@ import (
 	"math"
 )
 
-// float32 Array representing a 3x3 Matrix
-type Mat3 [9]float32
+// Array representing a 3x3 Matrix of float32 or float64
+type Mat3T[T Float] [9]T
+
+// float32 Mat3
+type Mat3 = Mat3T[float32]
+
+// float64 Mat3
+type Mat3d = Mat3T[float64]
 
 // returns new Mat3
 func NewMat3() *Mat3 {
@@ -19,14 +25,25 @@ func NewMat3() *Mat3 {
 	return this
 }
 
+// returns new Mat3d
+func NewMat3d() *Mat3d {
+	this := new(Mat3d)
+
+	this[0], this[3], this[6] = 1, 0, 0
+	this[1], this[4], this[7] = 0, 1, 0
+	this[2], this[5], this[8] = 0, 0, 1
+
+	return this
+}
+
 // returns a copy of this
-func (this *Mat3) Clone() *Mat3 {
+func (this *Mat3T[T]) Clone() *Mat3T[T] {
 
-	return new(Mat3).Copy(this)
+	return new(Mat3T[T]).Copy(this)
 }
 
 // copies other
-func (this *Mat3) Copy(other *Mat3) *Mat3 {
+func (this *Mat3T[T]) Copy(other *Mat3T[T]) *Mat3T[T] {
 
 	this[0], this[3], this[6] = other[0], other[3], other[6]
 	this[1], this[4], this[7] = other[1], other[4], other[7]
@@ -36,7 +53,7 @@ func (this *Mat3) Copy(other *Mat3) *Mat3 {
 }
 
 // sets this from values
-func (this *Mat3) Set(m11, m12, m13, m21, m22, m23, m31, m32, m33 float32) *Mat3 {
+func (this *Mat3T[T]) Set(m11, m12, m13, m21, m22, m23, m31, m32, m33 T) *Mat3T[T] {
 
 	this[0], this[3], this[6] = m11, m12, m13
 	this[1], this[4], this[7] = m21, m22, m23
@@ -46,7 +63,7 @@ func (this *Mat3) Set(m11, m12, m13, m21, m22, m23, m31, m32, m33 float32) *Mat3
 }
 
 // mutiples this by other
-func (this *Mat3) Mul(other *Mat3) *Mat3 {
+func (this *Mat3T[T]) Mul(other *Mat3T[T]) *Mat3T[T] {
 	a11, a12, a13 := this[0], this[3], this[6]
 	a21, a22, a23 := this[1], this[4], this[7]
 	a31, a32, a33 := this[2], this[5], this[8]
@@ -55,23 +72,23 @@ func (this *Mat3) Mul(other *Mat3) *Mat3 {
 	b21, b22, b23 := other[1], other[4], other[7]
 	b31, b32, b33 := other[2], other[5], other[8]
 
-	this[0] = a11*b11 + a21*b12 + a31*b13
-	this[3] = a12*b11 + a22*b12 + a32*b13
-	this[6] = a13*b11 + a23*b12 + a33*b13
+	this[0] = a11*b11 + a12*b21 + a13*b31
+	this[3] = a11*b12 + a12*b22 + a13*b32
+	this[6] = a11*b13 + a12*b23 + a13*b33
 
-	this[1] = a11*b21 + a21*b22 + a31*b23
-	this[4] = a12*b21 + a22*b22 + a32*b23
-	this[7] = a13*b21 + a23*b22 + a33*b23
+	this[1] = a21*b11 + a22*b21 + a23*b31
+	this[4] = a21*b12 + a22*b22 + a23*b32
+	this[7] = a21*b13 + a22*b23 + a23*b33
 
-	this[2] = a11*b31 + a21*b32 + a31*b33
-	this[5] = a12*b31 + a22*b32 + a32*b33
-	this[8] = a13*b31 + a23*b32 + a33*b33
+	this[2] = a31*b11 + a32*b21 + a33*b31
+	this[5] = a31*b12 + a32*b22 + a33*b32
+	this[8] = a31*b13 + a32*b23 + a33*b33
 
 	return this
 }
 
 // mutiples a and b saves in this
-func (this *Mat3) MMul(a, b *Mat3) *Mat3 {
+func (this *Mat3T[T]) MMul(a, b *Mat3T[T]) *Mat3T[T] {
 	a11, a12, a13 := a[0], a[3], a[6]
 	a21, a22, a23 := a[1], a[4], a[7]
 	a31, a32, a33 := a[2], a[5], a[8]
@@ -80,23 +97,23 @@ func (this *Mat3) MMul(a, b *Mat3) *Mat3 {
 	b21, b22, b23 := b[1], b[4], b[7]
 	b31, b32, b33 := b[2], b[5], b[8]
 
-	this[0] = a11*b11 + a21*b12 + a31*b13
-	this[3] = a12*b11 + a22*b12 + a32*b13
-	this[6] = a13*b11 + a23*b12 + a33*b13
+	this[0] = a11*b11 + a12*b21 + a13*b31
+	this[3] = a11*b12 + a12*b22 + a13*b32
+	this[6] = a11*b13 + a12*b23 + a13*b33
 
-	this[1] = a11*b21 + a21*b22 + a31*b23
-	this[4] = a12*b21 + a22*b22 + a32*b23
-	this[7] = a13*b21 + a23*b22 + a33*b23
+	this[1] = a21*b11 + a22*b21 + a23*b31
+	this[4] = a21*b12 + a22*b22 + a23*b32
+	this[7] = a21*b13 + a22*b23 + a23*b33
 
-	this[2] = a11*b31 + a21*b32 + a31*b33
-	this[5] = a12*b31 + a22*b32 + a32*b33
-	this[8] = a13*b31 + a23*b32 + a33*b33
+	this[2] = a31*b11 + a32*b21 + a33*b31
+	this[5] = a31*b12 + a32*b22 + a33*b32
+	this[8] = a31*b13 + a32*b23 + a33*b33
 
 	return this
 }
 
 // mutiples this by scalar
-func (this *Mat3) SMul(s float32) *Mat3 {
+func (this *Mat3T[T]) SMul(s T) *Mat3T[T] {
 
 	this[0] *= s
 	this[1] *= s
@@ -112,7 +129,7 @@ func (this *Mat3) SMul(s float32) *Mat3 {
 }
 
 // divides this by scalar
-func (this *Mat3) SDiv(s float32) *Mat3 {
+func (this *Mat3T[T]) SDiv(s T) *Mat3T[T] {
 	if s != 0 {
 		s = 1 / s
 	}
@@ -131,7 +148,7 @@ func (this *Mat3) SDiv(s float32) *Mat3 {
 }
 
 // returns inverse of this
-func (this *Mat3) Inverse() *Mat3 {
+func (this *Mat3T[T]) Inverse() *Mat3T[T] {
 
 	m11, m12, m13 := this[0], this[3], this[6]
 	m21, m22, m23 := this[1], this[4], this[7]
@@ -164,7 +181,7 @@ func (this *Mat3) Inverse() *Mat3 {
 }
 
 // saves inverse of other in this
-func (this *Mat3) MInverse(other *Mat3) *Mat3 {
+func (this *Mat3T[T]) MInverse(other *Mat3T[T]) *Mat3T[T] {
 
 	m11, m12, m13 := other[0], other[3], other[6]
 	m21, m22, m23 := other[1], other[4], other[7]
@@ -197,7 +214,7 @@ func (this *Mat3) MInverse(other *Mat3) *Mat3 {
 }
 
 // saves inverse of mat4 in this
-func (this *Mat3) Mat4Inverse(other *Mat4) *Mat3 {
+func (this *Mat3T[T]) Mat4Inverse(other *Mat4T[T]) *Mat3T[T] {
 
 	m11, m12, m13 := other[0], other[4], other[8]
 	m21, m22, m23 := other[1], other[5], other[9]
@@ -230,7 +247,7 @@ func (this *Mat3) Mat4Inverse(other *Mat4) *Mat3 {
 }
 
 // returns this as identity
-func (this *Mat3) Identity() *Mat3 {
+func (this *Mat3T[T]) Identity() *Mat3T[T] {
 
 	this[0] = 1
 	this[1] = 0
@@ -246,7 +263,7 @@ func (this *Mat3) Identity() *Mat3 {
 }
 
 // transposes this across diagonal
-func (this *Mat3) Transpose() *Mat3 {
+func (this *Mat3T[T]) Transpose() *Mat3T[T] {
 
 	this[1], this[3] = this[3], this[1]
 	this[2], this[6] = this[6], this[2]
@@ -256,7 +273,7 @@ func (this *Mat3) Transpose() *Mat3 {
 }
 
 // returns the determinant
-func (this *Mat3) Determinant() float32 {
+func (this *Mat3T[T]) Determinant() T {
 
 	a, b, c := this[0], this[1], this[2]
 	d, e, f := this[3], this[4], this[5]
@@ -266,7 +283,7 @@ func (this *Mat3) Determinant() float32 {
 }
 
 // returns scale matrix
-func (this *Mat3) MakeScale(x, y, z float32) *Mat3 {
+func (this *Mat3T[T]) MakeScale(x, y, z T) *Mat3T[T] {
 
 	this[0], this[3], this[6] = x, 0, 0
 	this[1], this[4], this[7] = 0, y, 0
@@ -276,8 +293,8 @@ func (this *Mat3) MakeScale(x, y, z float32) *Mat3 {
 }
 
 // returns rotation matrix around the x axis
-func (this *Mat3) MakeRotationX(angle float32) *Mat3 {
-	c, s := float32(math.Cos(float64(angle))), float32(math.Sin(float64(angle)))
+func (this *Mat3T[T]) MakeRotationX(angle T) *Mat3T[T] {
+	c, s := T(math.Cos(float64(angle))), T(math.Sin(float64(angle)))
 
 	this[0], this[3], this[6] = 0, 0, 0
 	this[1], this[4], this[7] = 0, c, -s
@@ -287,8 +304,8 @@ func (this *Mat3) MakeRotationX(angle float32) *Mat3 {
 }
 
 // returns rotation matrix around the y axis
-func (this *Mat3) MakeRotationY(angle float32) *Mat3 {
-	c, s := float32(math.Cos(float64(angle))), float32(math.Sin(float64(angle)))
+func (this *Mat3T[T]) MakeRotationY(angle T) *Mat3T[T] {
+	c, s := T(math.Cos(float64(angle))), T(math.Sin(float64(angle)))
 
 	this[0], this[3], this[6] = c, 0, s
 	this[1], this[4], this[7] = 0, 1, 0
@@ -298,8 +315,8 @@ func (this *Mat3) MakeRotationY(angle float32) *Mat3 {
 }
 
 // returns rotation matrix around the z axis
-func (this *Mat3) MakeRotationZ(angle float32) *Mat3 {
-	c, s := float32(math.Cos(float64(angle))), float32(math.Sin(float64(angle)))
+func (this *Mat3T[T]) MakeRotationZ(angle T) *Mat3T[T] {
+	c, s := T(math.Cos(float64(angle))), T(math.Sin(float64(angle)))
 
 	this[0], this[3], this[6] = c, -s, 0
 	this[1], this[4], this[7] = s, c, 0
@@ -309,7 +326,7 @@ func (this *Mat3) MakeRotationZ(angle float32) *Mat3 {
 }
 
 // sets values from Quat
-func (this *Mat3) FromQuat(q *Quat) *Mat3 {
+func (this *Mat3T[T]) FromQuat(q *QuatT[T]) *Mat3T[T] {
 	x, y, z, w := q[0], q[1], q[2], q[3]
 	x2, y2, z2 := x+x, y+y, z+z
 	xx, xy, xz := x*x2, x*y2, x*z2
@@ -331,8 +348,19 @@ func (this *Mat3) FromQuat(q *Quat) *Mat3 {
 	return this
 }
 
+// sets values from Euler angles using its rotation order
+func (this *Mat3T[T]) FromEuler(e *Euler) *Mat3T[T] {
+	m := e.rotation()
+
+	this[0], this[3], this[6] = T(m[0]), T(m[3]), T(m[6])
+	this[1], this[4], this[7] = T(m[1]), T(m[4]), T(m[7])
+	this[2], this[5], this[8] = T(m[2]), T(m[5]), T(m[8])
+
+	return this
+}
+
 // sets values from Mat2
-func (this *Mat3) FromMat2(m *Mat2) *Mat3 {
+func (this *Mat3T[T]) FromMat2(m *Mat2T[T]) *Mat3T[T] {
 
 	this[0] = m[0]
 	this[1] = m[1]
@@ -348,7 +376,7 @@ func (this *Mat3) FromMat2(m *Mat2) *Mat3 {
 }
 
 // sets values from Mat4
-func (this *Mat3) FromMat4(m *Mat4) *Mat3 {
+func (this *Mat3T[T]) FromMat4(m *Mat4T[T]) *Mat3T[T] {
 
 	this[0] = m[0]
 	this[1] = m[1]
@@ -363,8 +391,52 @@ func (this *Mat3) FromMat4(m *Mat4) *Mat3 {
 	return this
 }
 
-// checks if this equals other
-func (this *Mat3) Equals(other *Mat3) bool {
+// returns product of this and other by value
+func (this Mat3T[T]) Times(other Mat3T[T]) Mat3T[T] {
+
+	return *this.Mul(&other)
+}
+
+// returns this scaled by s by value
+func (this Mat3T[T]) Scaled(s T) Mat3T[T] {
+
+	return *this.SMul(s)
+}
+
+// returns inverse of this by value
+func (this Mat3T[T]) Inverted() Mat3T[T] {
+
+	return *this.Inverse()
+}
+
+// returns transpose of this by value
+func (this Mat3T[T]) Transposed() Mat3T[T] {
+
+	return *this.Transpose()
+}
+
+// converts this to float32, saves in target
+func (this *Mat3T[T]) Float32(target *Mat3) *Mat3 {
+
+	for i, v := range this {
+		target[i] = float32(v)
+	}
+
+	return target
+}
+
+// converts this to float64, saves in target
+func (this *Mat3T[T]) Float64(target *Mat3d) *Mat3d {
+
+	for i, v := range this {
+		target[i] = float64(v)
+	}
+
+	return target
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *Mat3T[T]) Equals(other *Mat3T[T]) bool {
 
 	if this[0] != other[0] {
 		return false
@@ -397,8 +469,62 @@ func (this *Mat3) Equals(other *Mat3) bool {
 	return true
 }
 
+// checks if this equals other within absolute tolerance tol
+func (this *Mat3T[T]) EqualsTol(other *Mat3T[T], tol T) bool {
+
+	return equalsTolSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other within tol relative to its largest element magnitude
+func (this *Mat3T[T]) EqualsRel(other *Mat3T[T], tol T) bool {
+
+	return equalsRelSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *Mat3T[T]) EqualsULP(other *Mat3T[T], ulps int) bool {
+
+	return equalsULPSlice(this[:], other[:], ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *Mat3T[T]) MarshalText() ([]byte, error) {
+
+	return appendText(nil, mat3Text, this[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *Mat3T[T]) UnmarshalText(text []byte) error {
+
+	return parseText(text, mat3Text, this[:])
+}
+
+// returns this as a JSON array, implements json.Marshaler
+func (this *Mat3T[T]) MarshalJSON() ([]byte, error) {
+
+	return appendJSON(nil, this[:])
+}
+
+// parses a JSON array into this, implements json.Unmarshaler
+func (this *Mat3T[T]) UnmarshalJSON(data []byte) error {
+
+	return parseJSON(data, this[:])
+}
+
+// returns this as little endian floats, implements encoding.BinaryMarshaler
+func (this *Mat3T[T]) MarshalBinary() ([]byte, error) {
+
+	return appendBinary(nil, this[:]), nil
+}
+
+// parses little endian floats into this, implements encoding.BinaryUnmarshaler
+func (this *Mat3T[T]) UnmarshalBinary(data []byte) error {
+
+	return parseBinary(data, this[:])
+}
+
 // returns this as string
-func (this *Mat3) String() string {
+func (this *Mat3T[T]) String() string {
 
 	return fmt.Sprintf("Mat3{\n %f, %f, %f,\n %f, %f, %f,\n %f, %f, %f\n }", this[0], this[3], this[6], this[1], this[4], this[7], this[2], this[5], this[8])
 }
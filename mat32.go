@@ -5,8 +5,14 @@ import (
 	"math"
 )
 
-// float32 Array representing a 3x3 Matrix
-type Mat32 [6]float32
+// Array representing a 3x2 Matrix of float32 or float64
+type Mat32T[T Float] [6]T
+
+// float32 Mat32
+type Mat32 = Mat32T[float32]
+
+// float64 Mat32
+type Mat32d = Mat32T[float64]
 
 // returns new Mat32
 func NewMat32() *Mat32 {
@@ -18,14 +24,24 @@ func NewMat32() *Mat32 {
 	return this
 }
 
+// returns new Mat32d
+func NewMat32d() *Mat32d {
+	this := new(Mat32d)
+
+	this[0], this[2], this[4] = 1, 0, 0
+	this[1], this[3], this[5] = 0, 1, 0
+
+	return this
+}
+
 // returns a copy of this
-func (this *Mat32) Clone() *Mat32 {
+func (this *Mat32T[T]) Clone() *Mat32T[T] {
 
-	return new(Mat32).Copy(this)
+	return new(Mat32T[T]).Copy(this)
 }
 
 // copies other
-func (this *Mat32) Copy(other *Mat32) *Mat32 {
+func (this *Mat32T[T]) Copy(other *Mat32T[T]) *Mat32T[T] {
 
 	this[0], this[2], this[4] = other[0], other[2], other[4]
 	this[1], this[3], this[5] = other[1], other[3], other[5]
@@ -34,7 +50,7 @@ func (this *Mat32) Copy(other *Mat32) *Mat32 {
 }
 
 // sets this from values
-func (this *Mat32) Set(m11, m12, m13, m21, m22, m23 float32) *Mat32 {
+func (this *Mat32T[T]) Set(m11, m12, m13, m21, m22, m23 T) *Mat32T[T] {
 
 	this[0], this[2], this[4] = m11, m12, m13
 	this[1], this[3], this[5] = m21, m22, m23
@@ -43,18 +59,18 @@ func (this *Mat32) Set(m11, m12, m13, m21, m22, m23 float32) *Mat32 {
 }
 
 // mutiples this by other
-func (this *Mat32) Mul(other *Mat32) *Mat32 {
+func (this *Mat32T[T]) Mul(other *Mat32T[T]) *Mat32T[T] {
 	a11, a12, a13 := this[0], this[2], this[4]
 	a21, a22, a23 := this[1], this[3], this[5]
 
 	b11, b12, b13 := other[0], other[2], other[4]
 	b21, b22, b23 := other[1], other[3], other[5]
 
-	this[0] = a11*b11 + a21*b12
-	this[1] = a12*b11 + a22*b12
+	this[0] = a11*b11 + a12*b21
+	this[1] = a21*b11 + a22*b21
 
-	this[2] = a11*b21 + a21*b22
-	this[3] = a12*b21 + a22*b22
+	this[2] = a11*b12 + a12*b22
+	this[3] = a21*b12 + a22*b22
 
 	this[4] = a11*b13 + a12*b23 + a13
 	this[5] = a21*b13 + a22*b23 + a23
@@ -63,18 +79,18 @@ func (this *Mat32) Mul(other *Mat32) *Mat32 {
 }
 
 // mutiples a and b saves in this
-func (this *Mat32) MMul(a, b *Mat32) *Mat32 {
+func (this *Mat32T[T]) MMul(a, b *Mat32T[T]) *Mat32T[T] {
 	a11, a12, a13 := a[0], a[2], a[4]
 	a21, a22, a23 := a[1], a[3], a[5]
 
 	b11, b12, b13 := b[0], b[2], b[4]
 	b21, b22, b23 := b[1], b[3], b[5]
 
-	this[0] = a11*b11 + a21*b12
-	this[1] = a12*b11 + a22*b12
+	this[0] = a11*b11 + a12*b21
+	this[1] = a21*b11 + a22*b21
 
-	this[2] = a11*b21 + a21*b22
-	this[3] = a12*b21 + a22*b22
+	this[2] = a11*b12 + a12*b22
+	this[3] = a21*b12 + a22*b22
 
 	this[4] = a11*b13 + a12*b23 + a13
 	this[5] = a21*b13 + a22*b23 + a23
@@ -83,7 +99,7 @@ func (this *Mat32) MMul(a, b *Mat32) *Mat32 {
 }
 
 // mutiples this by scalar
-func (this *Mat32) SMul(s float32) *Mat32 {
+func (this *Mat32T[T]) SMul(s T) *Mat32T[T] {
 
 	this[0] *= s
 	this[1] *= s
@@ -96,7 +112,7 @@ func (this *Mat32) SMul(s float32) *Mat32 {
 }
 
 // divides this by scalar
-func (this *Mat32) SDiv(s float32) *Mat32 {
+func (this *Mat32T[T]) SDiv(s T) *Mat32T[T] {
 	if s != 0 {
 		s = 1 / s
 	}
@@ -112,7 +128,7 @@ func (this *Mat32) SDiv(s float32) *Mat32 {
 }
 
 // returns inverse of this
-func (this *Mat32) Inverse() *Mat32 {
+func (this *Mat32T[T]) Inverse() *Mat32T[T] {
 
 	m11, m12, m13 := this[0], this[2], this[4]
 	m21, m22, m23 := this[1], this[3], this[5]
@@ -125,8 +141,8 @@ func (this *Mat32) Inverse() *Mat32 {
 	det = 1 / det
 
 	this[0] = m22 * det
-	this[1] = -m12 * det
-	this[2] = -m21 * det
+	this[1] = -m21 * det
+	this[2] = -m12 * det
 	this[3] = m11 * det
 
 	this[4] = (m12*m23 - m22*m13) * det
@@ -136,7 +152,7 @@ func (this *Mat32) Inverse() *Mat32 {
 }
 
 // saves inverse of other in this
-func (this *Mat32) MInverse(other *Mat32) *Mat32 {
+func (this *Mat32T[T]) MInverse(other *Mat32T[T]) *Mat32T[T] {
 
 	m11, m12, m13 := other[0], other[2], other[4]
 	m21, m22, m23 := other[1], other[3], other[5]
@@ -149,8 +165,8 @@ func (this *Mat32) MInverse(other *Mat32) *Mat32 {
 	det = 1 / det
 
 	this[0] = m22 * det
-	this[1] = -m12 * det
-	this[2] = -m21 * det
+	this[1] = -m21 * det
+	this[2] = -m12 * det
 	this[3] = m11 * det
 
 	this[4] = (m12*m23 - m22*m13) * det
@@ -160,7 +176,7 @@ func (this *Mat32) MInverse(other *Mat32) *Mat32 {
 }
 
 // returns this as identity
-func (this *Mat32) Identity() *Mat32 {
+func (this *Mat32T[T]) Identity() *Mat32T[T] {
 
 	this[0] = 1
 	this[1] = 0
@@ -173,7 +189,7 @@ func (this *Mat32) Identity() *Mat32 {
 }
 
 // transposes this across diagonal
-func (this *Mat32) Transpose() *Mat32 {
+func (this *Mat32T[T]) Transpose() *Mat32T[T] {
 
 	this[1], this[2] = this[2], this[1]
 
@@ -181,15 +197,15 @@ func (this *Mat32) Transpose() *Mat32 {
 }
 
 // returns the determinant
-func (this *Mat32) Determinant() float32 {
+func (this *Mat32T[T]) Determinant() T {
 
 	return this[0]*this[3] - this[2]*this[1]
 }
 
 // composes matrix from position, scale and rotation
-func (this *Mat32) Compose(position, scale *Vec2, rotation float32) *Mat32 {
+func (this *Mat32T[T]) Compose(position, scale *Vec2T[T], rotation T) *Mat32T[T] {
 	sx, sy := scale[0], scale[1]
-	c, s := float32(math.Cos(float64(rotation))), float32(math.Sin(float64(rotation)))
+	c, s := T(math.Cos(float64(rotation))), T(math.Sin(float64(rotation)))
 
 	this[0] = c * sx
 	this[1] = s * sx
@@ -203,7 +219,7 @@ func (this *Mat32) Compose(position, scale *Vec2, rotation float32) *Mat32 {
 }
 
 // decomposes matrix into a position and scale Vec2 and returns rotation angle in radians
-func (this *Mat32) Decompose(position, scale *Vec2) float32 {
+func (this *Mat32T[T]) Decompose(position, scale *Vec2T[T]) T {
 	m11, m12 := this[0], this[1]
 
 	sx := scale.Set(m11, m12).Length()
@@ -215,11 +231,11 @@ func (this *Mat32) Decompose(position, scale *Vec2) float32 {
 	scale[0] = sx
 	scale[1] = sy
 
-	return float32(math.Atan2(float64(m12), float64(m11)))
+	return T(math.Atan2(float64(m12), float64(m11)))
 }
 
 // extracts others position into this
-func (this *Mat32) ExtractPosition(other *Mat32) *Mat32 {
+func (this *Mat32T[T]) ExtractPosition(other *Mat32T[T]) *Mat32T[T] {
 
 	this[4] = other[4]
 	this[5] = other[5]
@@ -228,7 +244,7 @@ func (this *Mat32) ExtractPosition(other *Mat32) *Mat32 {
 }
 
 // extracts others rotation into this
-func (this *Mat32) ExtractRotation(other *Mat32) *Mat32 {
+func (this *Mat32T[T]) ExtractRotation(other *Mat32T[T]) *Mat32T[T] {
 
 	m11, m12 := other[0], other[2]
 	m21, m22 := other[1], other[3]
@@ -236,13 +252,13 @@ func (this *Mat32) ExtractRotation(other *Mat32) *Mat32 {
 	x := m11*m11 + m21*m21
 	y := m12*m12 + m22*m22
 
-	var sx, sy float32 = 0, 0
+	var sx, sy T = 0, 0
 
 	if x != 0 {
-		sx = 1 / float32(math.Sqrt(float64(x)))
+		sx = 1 / T(math.Sqrt(float64(x)))
 	}
 	if y != 0 {
-		sy = 1 / float32(math.Sqrt(float64(y)))
+		sy = 1 / T(math.Sqrt(float64(y)))
 	}
 
 	this[0] = m11 * sx
@@ -255,10 +271,10 @@ func (this *Mat32) ExtractRotation(other *Mat32) *Mat32 {
 }
 
 // makes this look from eye to target
-func (this *Mat32) LookAt(eye, target *Vec2) *Mat32 {
+func (this *Mat32T[T]) LookAt(eye, target *Vec2T[T]) *Mat32T[T] {
 	x, y := target[0]-eye[0], target[1]-eye[1]
-	a := float32(math.Atan2(float64(y), float64(x))) - HALF_PI
-	c, s := float32(math.Cos(float64(a))), float32(math.Sin(float64(a)))
+	a := T(math.Atan2(float64(y), float64(x))) - T(math.Pi*0.5)
+	c, s := T(math.Cos(float64(a))), T(math.Sin(float64(a)))
 
 	this[0] = c
 	this[1] = s
@@ -269,7 +285,7 @@ func (this *Mat32) LookAt(eye, target *Vec2) *Mat32 {
 }
 
 // sets position from Vec2
-func (this *Mat32) SetPosition(position *Vec2) *Mat32 {
+func (this *Mat32T[T]) SetPosition(position *Vec2T[T]) *Mat32T[T] {
 
 	this[4], this[5] = position[0], position[1]
 
@@ -277,8 +293,8 @@ func (this *Mat32) SetPosition(position *Vec2) *Mat32 {
 }
 
 // sets rotation from angle
-func (this *Mat32) SetRotation(angle float32) *Mat32 {
-	c, s := float32(math.Cos(float64(angle))), float32(math.Sin(float64(angle)))
+func (this *Mat32T[T]) SetRotation(angle T) *Mat32T[T] {
+	c, s := T(math.Cos(float64(angle))), T(math.Sin(float64(angle)))
 
 	this[0], this[2] = c, -s
 	this[1], this[3] = s, c
@@ -287,13 +303,13 @@ func (this *Mat32) SetRotation(angle float32) *Mat32 {
 }
 
 // returns rotation in radians
-func (this *Mat32) GetRotation() float32 {
+func (this *Mat32T[T]) GetRotation() T {
 
-	return float32(math.Atan2(float64(this[1]), float64(this[0])))
+	return T(math.Atan2(float64(this[1]), float64(this[0])))
 }
 
 // translates this by Vec2
-func (this *Mat32) Translate(v *Vec2) *Mat32 {
+func (this *Mat32T[T]) Translate(v *Vec2T[T]) *Mat32T[T] {
 	x, y := v[0], v[1]
 
 	this[4] = this[0]*x + this[2]*y + this[4]
@@ -303,12 +319,12 @@ func (this *Mat32) Translate(v *Vec2) *Mat32 {
 }
 
 // rotates this by angle
-func (this *Mat32) Rotate(angle float32) *Mat32 {
+func (this *Mat32T[T]) Rotate(angle T) *Mat32T[T] {
 
 	m11, m12 := this[0], this[2]
 	m21, m22 := this[1], this[3]
 
-	c, s := float32(math.Cos(float64(angle))), float32(math.Sin(float64(angle)))
+	c, s := T(math.Cos(float64(angle))), T(math.Sin(float64(angle)))
 
 	this[0] = m11*c + m12*s
 	this[1] = m11*-s + m12*c
@@ -319,7 +335,7 @@ func (this *Mat32) Rotate(angle float32) *Mat32 {
 }
 
 // scales this by Vec2
-func (this *Mat32) Scale(v *Vec2) *Mat32 {
+func (this *Mat32T[T]) Scale(v *Vec2T[T]) *Mat32T[T] {
 	x, y := v[0], v[1]
 
 	this[0] *= x
@@ -334,7 +350,7 @@ func (this *Mat32) Scale(v *Vec2) *Mat32 {
 }
 
 // returns translation matrix
-func (this *Mat32) MakeTranslation(x, y float32) *Mat32 {
+func (this *Mat32T[T]) MakeTranslation(x, y T) *Mat32T[T] {
 
 	this[0], this[2], this[4] = 1, 0, x
 	this[1], this[3], this[5] = 0, 1, y
@@ -343,7 +359,7 @@ func (this *Mat32) MakeTranslation(x, y float32) *Mat32 {
 }
 
 // returns scale matrix
-func (this *Mat32) MakeScale(x, y float32) *Mat32 {
+func (this *Mat32T[T]) MakeScale(x, y T) *Mat32T[T] {
 
 	this[0], this[2], this[4] = x, 0, 0
 	this[1], this[3], this[5] = 0, y, 0
@@ -352,8 +368,8 @@ func (this *Mat32) MakeScale(x, y float32) *Mat32 {
 }
 
 // returns rotation matrix
-func (this *Mat32) MakeRotation(angle float32) *Mat32 {
-	c, s := float32(math.Cos(float64(angle))), float32(math.Sin(float64(angle)))
+func (this *Mat32T[T]) MakeRotation(angle T) *Mat32T[T] {
+	c, s := T(math.Cos(float64(angle))), T(math.Sin(float64(angle)))
 
 	this[0], this[2], this[4] = c, -s, 0
 	this[1], this[3], this[5] = s, c, 0
@@ -362,7 +378,7 @@ func (this *Mat32) MakeRotation(angle float32) *Mat32 {
 }
 
 // returns orthographic matrix
-func (this *Mat32) MakeOrthographic(left, right, bottom, top float32) *Mat32 {
+func (this *Mat32T[T]) MakeOrthographic(left, right, bottom, top T) *Mat32T[T] {
 	w := 1 / (right - left)
 	h := 1 / (top - bottom)
 	x := (right + left) * w
@@ -380,7 +396,7 @@ func (this *Mat32) MakeOrthographic(left, right, bottom, top float32) *Mat32 {
 }
 
 // sets values from Mat2
-func (this *Mat32) FromMat2(m *Mat2) *Mat32 {
+func (this *Mat32T[T]) FromMat2(m *Mat2T[T]) *Mat32T[T] {
 
 	this[0] = m[0]
 	this[1] = m[1]
@@ -393,7 +409,7 @@ func (this *Mat32) FromMat2(m *Mat2) *Mat32 {
 }
 
 // sets values from Mat3
-func (this *Mat32) FromMat3(m *Mat3) *Mat32 {
+func (this *Mat32T[T]) FromMat3(m *Mat3T[T]) *Mat32T[T] {
 
 	this[0] = m[0]
 	this[1] = m[1]
@@ -406,7 +422,7 @@ func (this *Mat32) FromMat3(m *Mat3) *Mat32 {
 }
 
 // sets values from Mat4
-func (this *Mat32) FromMat4(m *Mat4) *Mat32 {
+func (this *Mat32T[T]) FromMat4(m *Mat4T[T]) *Mat32T[T] {
 
 	this[0] = m[0]
 	this[1] = m[1]
@@ -418,8 +434,46 @@ func (this *Mat32) FromMat4(m *Mat4) *Mat32 {
 	return this
 }
 
-// checks if this equals other
-func (this *Mat32) Equals(other *Mat32) bool {
+// returns product of this and other by value
+func (this Mat32T[T]) Times(other Mat32T[T]) Mat32T[T] {
+
+	return *this.Mul(&other)
+}
+
+// returns this scaled by s by value
+func (this Mat32T[T]) Scaled(s T) Mat32T[T] {
+
+	return *this.SMul(s)
+}
+
+// returns inverse of this by value
+func (this Mat32T[T]) Inverted() Mat32T[T] {
+
+	return *this.Inverse()
+}
+
+// converts this to float32, saves in target
+func (this *Mat32T[T]) Float32(target *Mat32) *Mat32 {
+
+	for i, v := range this {
+		target[i] = float32(v)
+	}
+
+	return target
+}
+
+// converts this to float64, saves in target
+func (this *Mat32T[T]) Float64(target *Mat32d) *Mat32d {
+
+	for i, v := range this {
+		target[i] = float64(v)
+	}
+
+	return target
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *Mat32T[T]) Equals(other *Mat32T[T]) bool {
 
 	if this[0] != other[0] {
 		return false
@@ -433,12 +487,72 @@ func (this *Mat32) Equals(other *Mat32) bool {
 	if this[3] != other[3] {
 		return false
 	}
+	if this[4] != other[4] {
+		return false
+	}
+	if this[5] != other[5] {
+		return false
+	}
 
 	return true
 }
 
+// checks if this equals other within absolute tolerance tol
+func (this *Mat32T[T]) EqualsTol(other *Mat32T[T], tol T) bool {
+
+	return equalsTolSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other within tol relative to its largest element magnitude
+func (this *Mat32T[T]) EqualsRel(other *Mat32T[T], tol T) bool {
+
+	return equalsRelSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *Mat32T[T]) EqualsULP(other *Mat32T[T], ulps int) bool {
+
+	return equalsULPSlice(this[:], other[:], ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *Mat32T[T]) MarshalText() ([]byte, error) {
+
+	return appendText(nil, mat32Text, this[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *Mat32T[T]) UnmarshalText(text []byte) error {
+
+	return parseText(text, mat32Text, this[:])
+}
+
+// returns this as a JSON array, implements json.Marshaler
+func (this *Mat32T[T]) MarshalJSON() ([]byte, error) {
+
+	return appendJSON(nil, this[:])
+}
+
+// parses a JSON array into this, implements json.Unmarshaler
+func (this *Mat32T[T]) UnmarshalJSON(data []byte) error {
+
+	return parseJSON(data, this[:])
+}
+
+// returns this as little endian floats, implements encoding.BinaryMarshaler
+func (this *Mat32T[T]) MarshalBinary() ([]byte, error) {
+
+	return appendBinary(nil, this[:]), nil
+}
+
+// parses little endian floats into this, implements encoding.BinaryUnmarshaler
+func (this *Mat32T[T]) UnmarshalBinary(data []byte) error {
+
+	return parseBinary(data, this[:])
+}
+
 // returns this as string type
-func (this *Mat32) String() string {
+func (this *Mat32T[T]) String() string {
 
 	return fmt.Sprintf("Mat32[\n %f, %f, %f,\n %f, %f, %f\n ]", this[0], this[2], this[4], this[1], this[3], this[5])
 }
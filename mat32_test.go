@@ -0,0 +1,104 @@
+package mathf
+
+import (
+	"testing"
+)
+
+func TestMat32Mul(t *testing.T) {
+	tests := []struct {
+		a, b, want Mat32
+	}{
+		{Mat32{1, 3, 2, 4, 5, 6}, Mat32{0, 1, -1, 0, 1, 2}, Mat32{2, 4, -1, -3, 10, 17}},
+		{Mat32{0, 1, -1, 0, 1, 2}, Mat32{1, 3, 2, 4, 5, 6}, Mat32{-3, 1, -4, 2, -5, 7}},
+		{Mat32{1, 0, 0, 1, 0, 0}, Mat32{1, 3, 2, 4, 5, 6}, Mat32{1, 3, 2, 4, 5, 6}},
+	}
+
+	for _, test := range tests {
+		a := test.a
+
+		if got := a.Mul(&test.b); !got.Equals(&test.want) {
+			t.Errorf("%v.Mul(%v) = %v, want %v", test.a, test.b, *got, test.want)
+		}
+		if got := new(Mat32).MMul(&test.a, &test.b); !got.Equals(&test.want) {
+			t.Errorf("MMul(%v, %v) = %v, want %v", test.a, test.b, *got, test.want)
+		}
+		if got := test.a.Times(test.b); !got.Equals(&test.want) {
+			t.Errorf("%v.Times(%v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+
+		v := Vec2{0.5, -3}
+		want := *v.Clone().ApplyMat32(&test.b).ApplyMat32(&test.a)
+
+		if got := v.ApplyMat32(&test.want); !got.EqualsTol(&want, 1e-5) {
+			t.Errorf("point by product = %v, want %v", *got, want)
+		}
+	}
+}
+
+func TestMat32Inverse(t *testing.T) {
+	tests := []struct {
+		m, want Mat32
+	}{
+		{Mat32{1, 3, 2, 4, 5, 6}, Mat32{-2, 1.5, 1, -0.5, 4, -4.5}},
+		{Mat32{2, 0, 0, 4, 1, 1}, Mat32{0.5, 0, 0, 0.25, -0.5, -0.25}},
+		{Mat32{0, 1, -1, 0, 1, 2}, Mat32{0, -1, 1, 0, -2, 1}},
+	}
+	identity := Mat32{1, 0, 0, 1, 0, 0}
+
+	for _, test := range tests {
+		m := test.m
+
+		if got := m.Inverse(); !got.EqualsTol(&test.want, 1e-6) {
+			t.Errorf("%v.Inverse() = %v, want %v", test.m, *got, test.want)
+		}
+		if got := new(Mat32).MInverse(&test.m); !got.EqualsTol(&test.want, 1e-6) {
+			t.Errorf("MInverse(%v) = %v, want %v", test.m, *got, test.want)
+		}
+		if got := test.m.Times(test.m.Inverted()); !got.EqualsTol(&identity, 1e-6) {
+			t.Errorf("%v.Times(Inverted()) = %v, want identity", test.m, got)
+		}
+	}
+}
+
+// returns m as the equivalent Mat4 affine transform in the xy plane
+func mat32ToMat4(m *Mat32) *Mat4 {
+
+	return &Mat4{m[0], m[1], 0, 0, m[2], m[3], 0, 0, 0, 0, 1, 0, m[4], m[5], 0, 1}
+}
+
+func TestMat32MatchesMat4(t *testing.T) {
+	// the baseline Mul gave {-3, -4, 1, 2, 10, 17} and Inverse {-2, 1, 1.5, -0.5, 4, -4.5} for these
+	a := Mat32{1, 3, 2, 4, 5, 6}
+	b := Mat32{0, 1, -1, 0, 1, 2}
+
+	want := mat32ToMat4(&a).Mul(mat32ToMat4(&b))
+
+	if got := a.Clone().Mul(&b); !mat32ToMat4(got).EqualsTol(want, 1e-6) {
+		t.Errorf("%v.Mul(%v) = %v, want %v", a, b, *got, *want)
+	}
+
+	want = new(Mat4).MInverse(mat32ToMat4(&a))
+
+	if got := a.Clone().Inverse(); !mat32ToMat4(got).EqualsTol(want, 1e-6) {
+		t.Errorf("%v.Inverse() = %v, want %v", a, *got, *want)
+	}
+}
+
+func TestMat32Equals(t *testing.T) {
+	m := Mat32{1, 2, 3, 4, 5, 6}
+
+	if !m.Equals(m.Clone()) {
+		t.Errorf("%v.Equals(itself) = false", m)
+	}
+
+	// a change in any element, including the translation, must be seen
+	for i := range m {
+		other := m
+
+		other[i] += 0.5
+
+		if m.Equals(&other) || other.Equals(&m) {
+			t.Errorf("Equals with element %d changed = true, want false", i)
+		}
+	}
+}
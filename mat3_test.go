@@ -0,0 +1,70 @@
+package mathf
+
+import (
+	"testing"
+)
+
+func TestMat3Mul(t *testing.T) {
+	tests := []struct {
+		a, b, want Mat3
+	}{
+		{Mat3{1, 0, 5, 2, 1, 6, 3, 4, 0}, Mat3{1, 0, 0, 0, 0, 1, 0, 1, 0}, Mat3{1, 0, 5, 3, 4, 0, 2, 1, 6}},
+		{Mat3{1, 0, 0, 0, 0, 1, 0, 1, 0}, Mat3{1, 0, 5, 2, 1, 6, 3, 4, 0}, Mat3{1, 5, 0, 2, 6, 1, 3, 0, 4}},
+		{Mat3{1, 0, 0, 0, 1, 0, 0, 0, 1}, Mat3{1, 0, 5, 2, 1, 6, 3, 4, 0}, Mat3{1, 0, 5, 2, 1, 6, 3, 4, 0}},
+	}
+
+	for _, test := range tests {
+		a := test.a
+
+		if got := a.Mul(&test.b); !got.Equals(&test.want) {
+			t.Errorf("%v.Mul(%v) = %v, want %v", test.a, test.b, *got, test.want)
+		}
+		if got := new(Mat3).MMul(&test.a, &test.b); !got.Equals(&test.want) {
+			t.Errorf("MMul(%v, %v) = %v, want %v", test.a, test.b, *got, test.want)
+		}
+		if got := test.a.Times(test.b); !got.Equals(&test.want) {
+			t.Errorf("%v.Times(%v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestMat3MulMatchesMat4(t *testing.T) {
+	var a, b Mat4
+	var a3, b3, want Mat3
+
+	a.Compose(&Vec3{1, 2, 3}, &Vec3{1, 2, 3}, NewQuat().FromAxisAngle(&Vec3{0, 0, 1}, 0.5))
+	b.Compose(&Vec3{-3, 0, 1}, &Vec3{2, 1, 1}, NewQuat().FromAxisAngle(&Vec3{1, 0, 0}, 1.2))
+
+	a3.FromMat4(&a)
+	b3.FromMat4(&b)
+	want.FromMat4(a.Mul(&b))
+
+	if got := a3.Times(b3); !got.EqualsTol(&want, 1e-5) {
+		t.Errorf("Mat3 Times = %v, Mat4 Times = %v", got, want)
+	}
+}
+
+func TestMat3Inverse(t *testing.T) {
+	tests := []struct {
+		m, want Mat3
+	}{
+		{Mat3{1, 0, 5, 2, 1, 6, 3, 4, 0}, Mat3{-24, 20, -5, 18, -15, 4, 5, -4, 1}},
+		{Mat3{2, 0, 0, 0, 4, 0, 0, 0, 8}, Mat3{0.5, 0, 0, 0, 0.25, 0, 0, 0, 0.125}},
+		{Mat3{1, 2, 3, 2, 4, 6, 0, 0, 1}, Mat3{1, 0, 0, 0, 1, 0, 0, 0, 1}},
+	}
+	identity := Mat3{1, 0, 0, 0, 1, 0, 0, 0, 1}
+
+	for _, test := range tests {
+		m := test.m
+
+		if got := m.Inverse(); !got.EqualsTol(&test.want, 1e-4) {
+			t.Errorf("%v.Inverse() = %v, want %v", test.m, *got, test.want)
+		}
+		if test.m.Determinant() == 0 {
+			continue
+		}
+		if got := test.m.Times(test.m.Inverted()); !got.EqualsTol(&identity, 1e-4) {
+			t.Errorf("%v.Times(Inverted()) = %v, want identity", test.m, got)
+		}
+	}
+}
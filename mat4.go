@@ -5,8 +5,14 @@ import (
 	"math"
 )
 
-// float32 Array representing a 3x3 Matrix
-type Mat4 [16]float32
+// Array representing a 4x4 Matrix of float32 or float64
+type Mat4T[T Float] [16]T
+
+// float32 Mat4
+type Mat4 = Mat4T[float32]
+
+// float64 Mat4
+type Mat4d = Mat4T[float64]
 
 // returns new Mat4
 func NewMat4() *Mat4 {
@@ -20,14 +26,26 @@ func NewMat4() *Mat4 {
 	return this
 }
 
+// returns new Mat4d
+func NewMat4d() *Mat4d {
+	this := new(Mat4d)
+
+	this[0], this[4], this[8], this[12] = 1, 0, 0, 0
+	this[1], this[5], this[9], this[13] = 0, 1, 0, 0
+	this[2], this[6], this[10], this[14] = 0, 0, 1, 0
+	this[3], this[7], this[11], this[15] = 0, 0, 0, 1
+
+	return this
+}
+
 // returns a copy of this
-func (this *Mat4) Clone() *Mat4 {
+func (this *Mat4T[T]) Clone() *Mat4T[T] {
 
-	return new(Mat4).Copy(this)
+	return new(Mat4T[T]).Copy(this)
 }
 
 // copies other
-func (this *Mat4) Copy(other *Mat4) *Mat4 {
+func (this *Mat4T[T]) Copy(other *Mat4T[T]) *Mat4T[T] {
 
 	this[0], this[4], this[8], this[12] = other[0], other[4], other[8], other[12]
 	this[1], this[5], this[9], this[13] = other[1], other[5], other[9], other[13]
@@ -38,7 +56,7 @@ func (this *Mat4) Copy(other *Mat4) *Mat4 {
 }
 
 // sets this from values
-func (this *Mat4) Set(m11, m12, m13, m14, m21, m22, m23, m24, m31, m32, m33, m34, m41, m42, m43, m44 float32) *Mat4 {
+func (this *Mat4T[T]) Set(m11, m12, m13, m14, m21, m22, m23, m24, m31, m32, m33, m34, m41, m42, m43, m44 T) *Mat4T[T] {
 
 	this[0], this[4], this[8], this[12] = m11, m12, m13, m14
 	this[1], this[5], this[9], this[13] = m21, m22, m23, m24
@@ -49,7 +67,14 @@ func (this *Mat4) Set(m11, m12, m13, m14, m21, m22, m23, m24, m31, m32, m33, m34
 }
 
 // mutiples this by other
-func (this *Mat4) Mul(other *Mat4) *Mat4 {
+func (this *Mat4T[T]) Mul(other *Mat4T[T]) *Mat4T[T] {
+	if useSIMD {
+		if m, ok := any(this).(*Mat4); ok {
+			mat4MulSIMD(m, m, any(other).(*Mat4))
+			return this
+		}
+	}
+
 	a11, a12, a13, a14 := this[0], this[4], this[8], this[12]
 	a21, a22, a23, a24 := this[1], this[5], this[9], this[13]
 	a31, a32, a33, a34 := this[2], this[6], this[10], this[14]
@@ -84,7 +109,14 @@ func (this *Mat4) Mul(other *Mat4) *Mat4 {
 }
 
 // mutiples a and b saves in this
-func (this *Mat4) MMul(a, b *Mat4) *Mat4 {
+func (this *Mat4T[T]) MMul(a, b *Mat4T[T]) *Mat4T[T] {
+	if useSIMD {
+		if m, ok := any(this).(*Mat4); ok {
+			mat4MulSIMD(m, any(a).(*Mat4), any(b).(*Mat4))
+			return this
+		}
+	}
+
 	a11, a12, a13, a14 := a[0], a[4], a[8], a[12]
 	a21, a22, a23, a24 := a[1], a[5], a[9], a[13]
 	a31, a32, a33, a34 := a[2], a[6], a[10], a[14]
@@ -119,7 +151,7 @@ func (this *Mat4) MMul(a, b *Mat4) *Mat4 {
 }
 
 // mutiples this by scalar
-func (this *Mat4) SMul(s float32) *Mat4 {
+func (this *Mat4T[T]) SMul(s T) *Mat4T[T] {
 
 	this[0] *= s
 	this[1] *= s
@@ -142,7 +174,7 @@ func (this *Mat4) SMul(s float32) *Mat4 {
 }
 
 // divides this by scalar
-func (this *Mat4) SDiv(s float32) *Mat4 {
+func (this *Mat4T[T]) SDiv(s T) *Mat4T[T] {
 	if s != 0 {
 		s = 1 / s
 	}
@@ -168,7 +200,16 @@ func (this *Mat4) SDiv(s float32) *Mat4 {
 }
 
 // returns inverse of this
-func (this *Mat4) Inverse() *Mat4 {
+func (this *Mat4T[T]) Inverse() *Mat4T[T] {
+
+	if useSIMD {
+		if m, ok := any(this).(*Mat4); ok {
+			if mat4InverseSIMD(m, m) == 0 {
+				return this.Identity()
+			}
+			return this
+		}
+	}
 
 	m11, m12, m13, m14 := this[0], this[4], this[8], this[12]
 	m21, m22, m23, m24 := this[1], this[5], this[9], this[13]
@@ -208,7 +249,16 @@ func (this *Mat4) Inverse() *Mat4 {
 }
 
 // saves inverse of other in this
-func (this *Mat4) MInverse(other *Mat4) *Mat4 {
+func (this *Mat4T[T]) MInverse(other *Mat4T[T]) *Mat4T[T] {
+
+	if useSIMD {
+		if m, ok := any(this).(*Mat4); ok {
+			if mat4InverseSIMD(m, any(other).(*Mat4)) == 0 {
+				return this.Identity()
+			}
+			return this
+		}
+	}
 
 	m11, m12, m13, m14 := other[0], other[4], other[8], other[12]
 	m21, m22, m23, m24 := other[1], other[5], other[9], other[13]
@@ -248,7 +298,7 @@ func (this *Mat4) MInverse(other *Mat4) *Mat4 {
 }
 
 // returns this as identity
-func (this *Mat4) Identity() *Mat4 {
+func (this *Mat4T[T]) Identity() *Mat4T[T] {
 
 	this[0] = 1
 	this[1] = 0
@@ -271,20 +321,27 @@ func (this *Mat4) Identity() *Mat4 {
 }
 
 // transposes this across diagonal
-func (this *Mat4) Transpose() *Mat4 {
+func (this *Mat4T[T]) Transpose() *Mat4T[T] {
+
+	if useSIMD {
+		if m, ok := any(this).(*Mat4); ok {
+			mat4TransposeSIMD(m, m)
+			return this
+		}
+	}
 
 	this[1], this[4] = this[4], this[1]
 	this[2], this[8] = this[8], this[2]
 	this[6], this[9] = this[9], this[6]
 	this[3], this[12] = this[12], this[3]
-	this[7], this[13] = this[14], this[7]
+	this[7], this[13] = this[13], this[7]
 	this[11], this[14] = this[14], this[11]
 
 	return this
 }
 
 // returns the determinant
-func (this *Mat4) Determinant() float32 {
+func (this *Mat4T[T]) Determinant() T {
 
 	m11, m12, m13, m14 := this[0], this[4], this[8], this[12]
 	m21, m22, m23, m24 := this[1], this[5], this[9], this[13]
@@ -295,7 +352,7 @@ func (this *Mat4) Determinant() float32 {
 }
 
 // composes matrix from position, scale and rotation
-func (this *Mat4) Compose(position, scale *Vec3, rotation *Quat) *Mat4 {
+func (this *Mat4T[T]) Compose(position, scale *Vec3T[T], rotation *QuatT[T]) *Mat4T[T] {
 	x, y, z, w := rotation[0], rotation[1], rotation[2], rotation[3]
 	x2, y2, z2 := x+x, y+y, z+z
 	xx, xy, xz := x*x2, x*y2, x*z2
@@ -328,14 +385,29 @@ func (this *Mat4) Compose(position, scale *Vec3, rotation *Quat) *Mat4 {
 	return this
 }
 
-// decomposes matrix into a position and scale Vec2 and a rotation angle in radians
-func (this *Mat4) Decompose(position, scale *Vec3, rotation *Quat) *Mat4 {
+// sets this to the rigid transform of DualQuat, dq should be normalized
+func (this *Mat4T[T]) FromDualQuat(dq *DualQuat) *Mat4T[T] {
+	var t Vec3
+	var q Quat
+
+	dq.GetTranslation(&t)
+	dq.Real(&q)
+
+	position := Vec3T[T]{T(t[0]), T(t[1]), T(t[2])}
+	scale := Vec3T[T]{1, 1, 1}
+	rotation := QuatT[T]{T(q[0]), T(q[1]), T(q[2]), T(q[3])}
+
+	return this.Compose(&position, &scale, &rotation)
+}
+
+// decomposes matrix into a position and scale Vec3 and a rotation Quat
+func (this *Mat4T[T]) Decompose(position, scale *Vec3T[T], rotation *QuatT[T]) *Mat4T[T] {
 
 	m11, m12, m13 := this[0], this[4], this[8]
 	m21, m22, m23 := this[1], this[5], this[9]
 	m31, m32, m33 := this[2], this[6], this[10]
 
-	var trace, x, y, z, w, s float32
+	var trace, x, y, z, w, s T
 
 	sx := scale.Set(m11, m21, m31).Length()
 	sy := scale.Set(m12, m22, m32).Length()
@@ -366,7 +438,7 @@ func (this *Mat4) Decompose(position, scale *Vec3, rotation *Quat) *Mat4 {
 	trace = m11 + m22 + m33
 
 	if trace > 0 {
-		s = 0.5 / float32(math.Sqrt(float64(trace+1)))
+		s = 0.5 / T(math.Sqrt(float64(trace+1)))
 
 		w = 0.25 / s
 		x = (m32 - m23) * s
@@ -374,7 +446,7 @@ func (this *Mat4) Decompose(position, scale *Vec3, rotation *Quat) *Mat4 {
 		z = (m21 - m12) * s
 
 	} else if m11 > m22 && m11 > m33 {
-		s = 2 * float32(math.Sqrt(float64(1+m11-m22-m33)))
+		s = 2 * T(math.Sqrt(float64(1+m11-m22-m33)))
 
 		w = (m32 - m23) / s
 		x = 0.25 * s
@@ -382,7 +454,7 @@ func (this *Mat4) Decompose(position, scale *Vec3, rotation *Quat) *Mat4 {
 		z = (m13 + m31) / s
 
 	} else if m22 > m33 {
-		s = 2 * float32(math.Sqrt(float64(1+m22-m11-m33)))
+		s = 2 * T(math.Sqrt(float64(1+m22-m11-m33)))
 
 		w = (m13 - m31) / s
 		x = (m12 + m21) / s
@@ -390,7 +462,7 @@ func (this *Mat4) Decompose(position, scale *Vec3, rotation *Quat) *Mat4 {
 		z = (m23 + m32) / s
 
 	} else {
-		s = 2 * float32(math.Sqrt(float64(1+m33-m11-m22)))
+		s = 2 * T(math.Sqrt(float64(1+m33-m11-m22)))
 
 		w = (m21 - m12) / s
 		x = (m13 + m31) / s
@@ -400,14 +472,14 @@ func (this *Mat4) Decompose(position, scale *Vec3, rotation *Quat) *Mat4 {
 
 	rotation[0] = x
 	rotation[1] = y
-	rotation[2] = w
-	rotation[3] = z
+	rotation[2] = z
+	rotation[3] = w
 
 	return this
 }
 
 // extracts others position and saves it in this
-func (this *Mat4) ExtractPosition(other *Mat4) *Mat4 {
+func (this *Mat4T[T]) ExtractPosition(other *Mat4T[T]) *Mat4T[T] {
 
 	this[12] = other[12]
 	this[13] = other[13]
@@ -417,7 +489,7 @@ func (this *Mat4) ExtractPosition(other *Mat4) *Mat4 {
 }
 
 // extracts others rotation and saves it in this
-func (this *Mat4) ExtractRotation(other *Mat4) *Mat4 {
+func (this *Mat4T[T]) ExtractRotation(other *Mat4T[T]) *Mat4T[T] {
 	m11, m12, m13 := this[0], this[4], this[8]
 	m21, m22, m23 := this[1], this[5], this[9]
 	m31, m32, m33 := this[2], this[6], this[10]
@@ -426,16 +498,16 @@ func (this *Mat4) ExtractRotation(other *Mat4) *Mat4 {
 	y := m12*m12 + m22*m22 + m32*m32
 	z := m13*m13 + m23*m23 + m33*m33
 
-	var sx, sy, sz float32 = 0, 0, 0
+	var sx, sy, sz T = 0, 0, 0
 
 	if x != 0 {
-		sx = 1 / float32(math.Sqrt(float64(x)))
+		sx = 1 / T(math.Sqrt(float64(x)))
 	}
 	if y != 0 {
-		sy = 1 / float32(math.Sqrt(float64(y)))
+		sy = 1 / T(math.Sqrt(float64(y)))
 	}
 	if z != 0 {
-		sz = 1 / float32(math.Sqrt(float64(z)))
+		sz = 1 / T(math.Sqrt(float64(z)))
 	}
 
 	this[0] = m11 * sx
@@ -454,11 +526,11 @@ func (this *Mat4) ExtractRotation(other *Mat4) *Mat4 {
 }
 
 // makes this look from eye to target
-func (this *Mat4) LookAt(eye, target, up *Vec3) *Mat4 {
+func (this *Mat4T[T]) LookAt(eye, target, up *Vec3T[T]) *Mat4T[T] {
 	zx, zy, zz := target[0]-eye[0], target[1]-eye[1], target[2]-eye[2]
 	l := zx*zx + zy*zy + zz*zz
 	if l != 0 {
-		l = 1 / float32(math.Sqrt(float64(l)))
+		l = 1 / T(math.Sqrt(float64(l)))
 	}
 
 	zx *= l
@@ -471,7 +543,7 @@ func (this *Mat4) LookAt(eye, target, up *Vec3) *Mat4 {
 
 	l = xx*xx + xy*xy + xz*xz
 	if l != 0 {
-		l = 1 / float32(math.Sqrt(float64(l)))
+		l = 1 / T(math.Sqrt(float64(l)))
 	}
 
 	xx *= l
@@ -496,7 +568,7 @@ func (this *Mat4) LookAt(eye, target, up *Vec3) *Mat4 {
 }
 
 // sets position of this
-func (this *Mat4) SetPosition(position *Vec3) *Mat4 {
+func (this *Mat4T[T]) SetPosition(position *Vec3T[T]) *Mat4T[T] {
 
 	this[12], this[13], this[14] = position[0], position[1], position[2]
 
@@ -504,7 +576,7 @@ func (this *Mat4) SetPosition(position *Vec3) *Mat4 {
 }
 
 // translates this by Vec3
-func (this *Mat4) Translate(v *Vec3) *Mat4 {
+func (this *Mat4T[T]) Translate(v *Vec3T[T]) *Mat4T[T] {
 	x, y, z := v[0], v[1], v[2]
 
 	this[12] = this[0]*x + this[4]*y + this[8]*z + this[12]
@@ -516,8 +588,8 @@ func (this *Mat4) Translate(v *Vec3) *Mat4 {
 }
 
 // rotates this by angle along the x axis
-func (this *Mat4) RotateX(angle float32) *Mat4 {
-	c, s := float32(math.Cos(float64(angle))), float32(math.Sin(float64(angle)))
+func (this *Mat4T[T]) RotateX(angle T) *Mat4T[T] {
+	c, s := T(math.Cos(float64(angle))), T(math.Sin(float64(angle)))
 	m12 := this[4]
 	m22 := this[5]
 	m32 := this[6]
@@ -541,8 +613,8 @@ func (this *Mat4) RotateX(angle float32) *Mat4 {
 }
 
 // rotates this by angle along the y axis
-func (this *Mat4) RotateY(angle float32) *Mat4 {
-	c, s := float32(math.Cos(float64(angle))), float32(math.Sin(float64(angle)))
+func (this *Mat4T[T]) RotateY(angle T) *Mat4T[T] {
+	c, s := T(math.Cos(float64(angle))), T(math.Sin(float64(angle)))
 	m11 := this[0]
 	m21 := this[1]
 	m31 := this[2]
@@ -566,8 +638,8 @@ func (this *Mat4) RotateY(angle float32) *Mat4 {
 }
 
 // rotates this by angle along the z axis
-func (this *Mat4) RotateZ(angle float32) *Mat4 {
-	c, s := float32(math.Cos(float64(angle))), float32(math.Sin(float64(angle)))
+func (this *Mat4T[T]) RotateZ(angle T) *Mat4T[T] {
+	c, s := T(math.Cos(float64(angle))), T(math.Sin(float64(angle)))
 	m11 := this[0]
 	m21 := this[1]
 	m31 := this[2]
@@ -591,7 +663,7 @@ func (this *Mat4) RotateZ(angle float32) *Mat4 {
 }
 
 // scales this by Vec3
-func (this *Mat4) Scale(s *Vec3) *Mat4 {
+func (this *Mat4T[T]) Scale(s *Vec3T[T]) *Mat4T[T] {
 	x, y, z := s[0], s[1], s[2]
 
 	this[0] *= x
@@ -614,7 +686,7 @@ func (this *Mat4) Scale(s *Vec3) *Mat4 {
 }
 
 // returns scale matrix
-func (this *Mat4) MakeScale(x, y, z float32) *Mat4 {
+func (this *Mat4T[T]) MakeScale(x, y, z T) *Mat4T[T] {
 
 	this[0], this[3], this[6] = x, 0, 0
 	this[1], this[4], this[7] = 0, y, 0
@@ -624,8 +696,8 @@ func (this *Mat4) MakeScale(x, y, z float32) *Mat4 {
 }
 
 // returns rotation matrix around the x axis
-func (this *Mat4) MakeRotationX(angle float32) *Mat4 {
-	c, s := float32(math.Cos(float64(angle))), float32(math.Sin(float64(angle)))
+func (this *Mat4T[T]) MakeRotationX(angle T) *Mat4T[T] {
+	c, s := T(math.Cos(float64(angle))), T(math.Sin(float64(angle)))
 
 	this[0], this[3], this[6] = 0, 0, 0
 	this[1], this[4], this[7] = 0, c, -s
@@ -635,8 +707,8 @@ func (this *Mat4) MakeRotationX(angle float32) *Mat4 {
 }
 
 // returns rotation matrix around the y axis
-func (this *Mat4) MakeRotationY(angle float32) *Mat4 {
-	c, s := float32(math.Cos(float64(angle))), float32(math.Sin(float64(angle)))
+func (this *Mat4T[T]) MakeRotationY(angle T) *Mat4T[T] {
+	c, s := T(math.Cos(float64(angle))), T(math.Sin(float64(angle)))
 
 	this[0], this[3], this[6] = c, 0, s
 	this[1], this[4], this[7] = 0, 1, 0
@@ -646,8 +718,8 @@ func (this *Mat4) MakeRotationY(angle float32) *Mat4 {
 }
 
 // returns rotation matrix around the z axis
-func (this *Mat4) MakeRotationZ(angle float32) *Mat4 {
-	c, s := float32(math.Cos(float64(angle))), float32(math.Sin(float64(angle)))
+func (this *Mat4T[T]) MakeRotationZ(angle T) *Mat4T[T] {
+	c, s := T(math.Cos(float64(angle))), T(math.Sin(float64(angle)))
 
 	this[0], this[3], this[6] = c, -s, 0
 	this[1], this[4], this[7] = s, c, 0
@@ -656,11 +728,47 @@ func (this *Mat4) MakeRotationZ(angle float32) *Mat4 {
 	return this
 }
 
+// clip space options for projection matrices, combine with |
+const (
+	CLIP_ZERO_TO_ONE  = 1 << iota // depth from 0 to 1 like Vulkan, D3D and Metal instead of -1 to 1
+	CLIP_REVERSE_Z                // near maps to the far depth and far to the near depth
+	CLIP_INFINITE_FAR             // far plane at infinity, far is ignored, perspective only
+	CLIP_FLIP_Y                   // y points down in clip space like Vulkan
+)
+
+// returns the clip space depth of the near and far planes for clip options
+func clipDepthRange[T Float](flags int) (T, T) {
+	var lo T = -1
+
+	if flags&CLIP_ZERO_TO_ONE != 0 {
+		lo = 0
+	}
+	if flags&CLIP_REVERSE_Z != 0 {
+		return 1, lo
+	}
+
+	return lo, 1
+}
+
 // returns frustum matrix
-func (this *Mat4) MakeFrustum(left, right, bottom, top, near, far float32) *Mat4 {
+func (this *Mat4T[T]) MakeFrustum(left, right, bottom, top, near, far T) *Mat4T[T] {
+
+	return this.MakeFrustumClip(left, right, bottom, top, near, far, 0)
+}
+
+// returns frustum matrix using clip space options
+func (this *Mat4T[T]) MakeFrustumClip(left, right, bottom, top, near, far T, flags int) *Mat4T[T] {
 	rl := 1 / (right - left)
 	tb := 1 / (top - bottom)
-	nf := 1 / (near - far)
+	dn, df := clipDepthRange[T](flags)
+	var a, b T
+
+	if flags&CLIP_INFINITE_FAR != 0 {
+		a = -df
+	} else {
+		a = (far*df - near*dn) / (near - far)
+	}
+	b = near * (dn + a)
 
 	this[0] = (near * 2) * rl
 	this[1] = 0
@@ -672,46 +780,46 @@ func (this *Mat4) MakeFrustum(left, right, bottom, top, near, far float32) *Mat4
 	this[7] = 0
 	this[8] = (right + left) * rl
 	this[9] = (top + bottom) * tb
-	this[10] = (far + near) * nf
+	this[10] = a
 	this[11] = -1
 	this[12] = 0
 	this[13] = 0
-	this[14] = (far * near * 2) * nf
+	this[14] = b
 	this[15] = 0
 
+	if flags&CLIP_FLIP_Y != 0 {
+		this[5], this[9] = -this[5], -this[9]
+	}
+
 	return this
 }
 
-// returns perspective matrix
-func (this *Mat4) MakePerspective(fov, aspect, near, far float32) *Mat4 {
-	f := 1 / float32(math.Atan(float64(fov*0.5)))
-	nf := 1 / (near - far)
+// returns perspective matrix, fov is the vertical field of view in radians
+func (this *Mat4T[T]) MakePerspective(fov, aspect, near, far T) *Mat4T[T] {
 
-	this[0] = f / aspect
-	this[1] = 0
-	this[2] = 0
-	this[3] = 0
-	this[4] = 0
-	this[5] = f
-	this[6] = 0
-	this[7] = 0
-	this[8] = 0
-	this[9] = 0
-	this[10] = (far + near) * nf
-	this[11] = -1
-	this[12] = 0
-	this[13] = 0
-	this[14] = (2 * far * near) * nf
-	this[15] = 0
+	return this.MakePerspectiveClip(fov, aspect, near, far, 0)
+}
 
-	return this
+// returns perspective matrix using clip space options, fov is the vertical field of view in radians
+func (this *Mat4T[T]) MakePerspectiveClip(fov, aspect, near, far T, flags int) *Mat4T[T] {
+	top := near * T(math.Tan(float64(fov*0.5)))
+	right := top * aspect
+
+	return this.MakeFrustumClip(-right, right, -top, top, near, far, flags)
 }
 
 // returns orthographic matrix
-func (this *Mat4) MakeOrthographic(left, right, bottom, top, near, far float32) *Mat4 {
+func (this *Mat4T[T]) MakeOrthographic(left, right, bottom, top, near, far T) *Mat4T[T] {
+
+	return this.MakeOrthographicClip(left, right, bottom, top, near, far, 0)
+}
+
+// returns orthographic matrix using clip space options, CLIP_INFINITE_FAR is ignored
+func (this *Mat4T[T]) MakeOrthographicClip(left, right, bottom, top, near, far T, flags int) *Mat4T[T] {
 	lr := 1 / (left - right)
 	bt := 1 / (bottom - top)
-	nf := 1 / (near - far)
+	dn, df := clipDepthRange[T](flags)
+	c := (dn - df) / (far - near)
 
 	this[0] = -2 * lr
 	this[1] = 0
@@ -723,18 +831,72 @@ func (this *Mat4) MakeOrthographic(left, right, bottom, top, near, far float32)
 	this[7] = 0
 	this[8] = 0
 	this[9] = 0
-	this[10] = 2 * nf
+	this[10] = c
 	this[11] = 0
 	this[12] = (left + right) * lr
 	this[13] = (top + bottom) * bt
-	this[14] = (far + near) * nf
+	this[14] = dn + c*near
 	this[15] = 1
 
+	if flags&CLIP_FLIP_Y != 0 {
+		this[5], this[13] = -this[5], -this[13]
+	}
+
 	return this
 }
 
+// checks if this is a perspective projection matrix
+func (this *Mat4T[T]) IsPerspective() bool {
+
+	return this[15] == 0
+}
+
+// returns near plane distance of projection matrix built with clip space options
+func (this *Mat4T[T]) GetNear(flags int) T {
+	dn, _ := clipDepthRange[T](flags)
+
+	if this.IsPerspective() {
+		return this[14] / (dn + this[10])
+	}
+
+	return (this[14] - dn) / this[10]
+}
+
+// returns far plane distance of projection matrix built with clip space options, Inf if far is at infinity
+func (this *Mat4T[T]) GetFar(flags int) T {
+	_, df := clipDepthRange[T](flags)
+
+	if this.IsPerspective() {
+		d := df + this[10]
+
+		if abs(d) < T(Epsilon) {
+			return Inf
+		}
+
+		return this[14] / d
+	}
+
+	return (this[14] - df) / this[10]
+}
+
+// returns vertical field of view in radians of perspective matrix, 0 for orthographic
+func (this *Mat4T[T]) GetFov() T {
+
+	if !this.IsPerspective() {
+		return 0
+	}
+
+	return 2 * T(math.Atan(float64(1/abs(this[5]))))
+}
+
+// returns aspect ratio of width over height of projection matrix
+func (this *Mat4T[T]) GetAspect() T {
+
+	return abs(this[5]) / this[0]
+}
+
 // sets values from Quat
-func (this *Mat4) FromQuat(q *Quat) *Mat4 {
+func (this *Mat4T[T]) FromQuat(q *QuatT[T]) *Mat4T[T] {
 	x, y, z, w := q[0], q[1], q[2], q[3]
 	x2, y2, z2 := x+x, y+y, z+z
 	xx, xy, xz := x*x2, x*y2, x*z2
@@ -756,8 +918,20 @@ func (this *Mat4) FromQuat(q *Quat) *Mat4 {
 	return this
 }
 
+// sets values from Euler angles using its rotation order, clears position
+func (this *Mat4T[T]) FromEuler(e *Euler) *Mat4T[T] {
+	m := e.rotation()
+
+	this[0], this[4], this[8], this[12] = T(m[0]), T(m[3]), T(m[6]), 0
+	this[1], this[5], this[9], this[13] = T(m[1]), T(m[4]), T(m[7]), 0
+	this[2], this[6], this[10], this[14] = T(m[2]), T(m[5]), T(m[8]), 0
+	this[3], this[7], this[11], this[15] = 0, 0, 0, 1
+
+	return this
+}
+
 // sets values from Mat2
-func (this *Mat4) FromMat2(m *Mat2) *Mat4 {
+func (this *Mat4T[T]) FromMat2(m *Mat2T[T]) *Mat4T[T] {
 
 	this[0] = m[0]
 	this[1] = m[1]
@@ -773,7 +947,7 @@ func (this *Mat4) FromMat2(m *Mat2) *Mat4 {
 }
 
 // sets values from Mat4
-func (this *Mat4) FromMat4(m *Mat4) *Mat4 {
+func (this *Mat4T[T]) FromMat4(m *Mat4T[T]) *Mat4T[T] {
 
 	this[0] = m[0]
 	this[1] = m[1]
@@ -788,8 +962,52 @@ func (this *Mat4) FromMat4(m *Mat4) *Mat4 {
 	return this
 }
 
-// checks if this equals other
-func (this *Mat4) Equals(other *Mat4) bool {
+// returns product of this and other by value
+func (this Mat4T[T]) Times(other Mat4T[T]) Mat4T[T] {
+
+	return *this.Mul(&other)
+}
+
+// returns this scaled by s by value
+func (this Mat4T[T]) Scaled(s T) Mat4T[T] {
+
+	return *this.SMul(s)
+}
+
+// returns inverse of this by value
+func (this Mat4T[T]) Inverted() Mat4T[T] {
+
+	return *this.Inverse()
+}
+
+// returns transpose of this by value
+func (this Mat4T[T]) Transposed() Mat4T[T] {
+
+	return *this.Transpose()
+}
+
+// converts this to float32, saves in target
+func (this *Mat4T[T]) Float32(target *Mat4) *Mat4 {
+
+	for i, v := range this {
+		target[i] = float32(v)
+	}
+
+	return target
+}
+
+// converts this to float64, saves in target
+func (this *Mat4T[T]) Float64(target *Mat4d) *Mat4d {
+
+	for i, v := range this {
+		target[i] = float64(v)
+	}
+
+	return target
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *Mat4T[T]) Equals(other *Mat4T[T]) bool {
 
 	if this[0] != other[0] {
 		return false
@@ -818,12 +1036,87 @@ func (this *Mat4) Equals(other *Mat4) bool {
 	if this[8] != other[8] {
 		return false
 	}
+	if this[9] != other[9] {
+		return false
+	}
+	if this[10] != other[10] {
+		return false
+	}
+	if this[11] != other[11] {
+		return false
+	}
+	if this[12] != other[12] {
+		return false
+	}
+	if this[13] != other[13] {
+		return false
+	}
+	if this[14] != other[14] {
+		return false
+	}
+	if this[15] != other[15] {
+		return false
+	}
 
 	return true
 }
 
+// checks if this equals other within absolute tolerance tol
+func (this *Mat4T[T]) EqualsTol(other *Mat4T[T], tol T) bool {
+
+	return equalsTolSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other within tol relative to its largest element magnitude
+func (this *Mat4T[T]) EqualsRel(other *Mat4T[T], tol T) bool {
+
+	return equalsRelSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *Mat4T[T]) EqualsULP(other *Mat4T[T], ulps int) bool {
+
+	return equalsULPSlice(this[:], other[:], ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *Mat4T[T]) MarshalText() ([]byte, error) {
+
+	return appendText(nil, mat4Text, this[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *Mat4T[T]) UnmarshalText(text []byte) error {
+
+	return parseText(text, mat4Text, this[:])
+}
+
+// returns this as a JSON array, implements json.Marshaler
+func (this *Mat4T[T]) MarshalJSON() ([]byte, error) {
+
+	return appendJSON(nil, this[:])
+}
+
+// parses a JSON array into this, implements json.Unmarshaler
+func (this *Mat4T[T]) UnmarshalJSON(data []byte) error {
+
+	return parseJSON(data, this[:])
+}
+
+// returns this as little endian floats, implements encoding.BinaryMarshaler
+func (this *Mat4T[T]) MarshalBinary() ([]byte, error) {
+
+	return appendBinary(nil, this[:]), nil
+}
+
+// parses little endian floats into this, implements encoding.BinaryUnmarshaler
+func (this *Mat4T[T]) UnmarshalBinary(data []byte) error {
+
+	return parseBinary(data, this[:])
+}
+
 // returns this as string
-func (this *Mat4) String() string {
+func (this *Mat4T[T]) String() string {
 
 	return fmt.Sprintf("Mat4[\n %f, %f, %f, %f,\n %f, %f, %f, %f,\n %f, %f, %f, %f,\n %f, %f, %f, %f\n ]", this[0], this[4], this[8], this[12], this[1], this[5], this[9], this[13], this[2], this[6], this[10], this[14], this[3], this[7], this[11], this[15])
 }
@@ -0,0 +1,172 @@
+package mathf
+
+import (
+	"testing"
+)
+
+func TestMat4Transpose(t *testing.T) {
+	tests := []struct {
+		m, want Mat4
+	}{
+		{
+			Mat4{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+			Mat4{0, 4, 8, 12, 1, 5, 9, 13, 2, 6, 10, 14, 3, 7, 11, 15},
+		},
+		{
+			Mat4{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, 5, 6, 7, 1},
+			Mat4{1, 0, 0, 5, 0, 1, 0, 6, 0, 0, 1, 7, 0, 0, 0, 1},
+		},
+	}
+
+	for _, test := range tests {
+		m := test.m
+
+		if got := m.Transpose(); *got != test.want {
+			t.Errorf("%v.Transpose() = %v, want %v", test.m, *got, test.want)
+		}
+		if got := test.m.Transposed(); got != test.want {
+			t.Errorf("%v.Transposed() = %v, want %v", test.m, got, test.want)
+		}
+
+		md, wantd := test.m.Float64(new(Mat4d)), test.want.Float64(new(Mat4d))
+
+		if got := md.Transpose(); *got != *wantd {
+			t.Errorf("%v.Transpose() = %v, want %v", test.m, *got, *wantd)
+		}
+	}
+}
+
+// keeps benchmarked results alive
+var mat4Sink Mat4
+
+func TestMat4ValueAllocs(t *testing.T) {
+	a := Mat4{2, 0, 0, 0, 0, 3, 0, 0, 0, 0, 4, 0, 1, 2, 3, 1}
+	b := Mat4{0, 1, 0, 0, -1, 0, 0, 0, 0, 0, 1, 0, 0, 0, 5, 1}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		mat4Sink = a.Times(b).Inverted().Transposed().Scaled(2)
+	})
+
+	if allocs != 0 {
+		t.Errorf("value API allocates %v times per run, want 0", allocs)
+	}
+}
+
+func BenchmarkMat4Pointer(b *testing.B) {
+	m, other := NewMat4(), NewMat4().Compose(&Vec3{1, 2, 3}, &Vec3{1, 1, 1}, NewQuat().FromAxisAngle(&Vec3{0, 1, 0}, 0.5))
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		mat4Sink = *m.Clone().Mul(other).Inverse()
+	}
+}
+
+func BenchmarkMat4Value(b *testing.B) {
+	m, other := *NewMat4(), *NewMat4().Compose(&Vec3{1, 2, 3}, &Vec3{1, 1, 1}, NewQuat().FromAxisAngle(&Vec3{0, 1, 0}, 0.5))
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		mat4Sink = m.Times(other).Inverted()
+	}
+}
+
+func TestMat4MakePerspective(t *testing.T) {
+	tests := []struct {
+		fov, aspect, near, far float32
+		want                   Mat4
+	}{
+		{Pi * 0.5, 1, 1, 100, Mat4{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, -101.0 / 99, -1, 0, 0, -200.0 / 99, 0}},
+		{Pi / 3, 2, 0.5, 10, Mat4{0.8660254, 0, 0, 0, 0, 1.7320508, 0, 0, 0, 0, -10.5 / 9.5, -1, 0, 0, -10 / 9.5, 0}},
+	}
+
+	for _, test := range tests {
+		var m Mat4
+
+		if got := m.MakePerspective(test.fov, test.aspect, test.near, test.far); !got.EqualsTol(&test.want, 1e-6) {
+			t.Errorf("MakePerspective(%v, %v, %v, %v) = %v, want %v", test.fov, test.aspect, test.near, test.far, *got, test.want)
+		}
+		if got := m.GetFov(); !EqualsTol(got, test.fov, 1e-6) {
+			t.Errorf("GetFov() = %v, want %v", got, test.fov)
+		}
+		if got := m.GetAspect(); !EqualsTol(got, test.aspect, 1e-6) {
+			t.Errorf("GetAspect() = %v, want %v", got, test.aspect)
+		}
+	}
+}
+
+func TestMat4DecomposeCompose(t *testing.T) {
+	tests := []struct {
+		position, scale Vec3
+		axis            Vec3
+		angle           float32
+	}{
+		{Vec3{0, 0, 0}, Vec3{1, 1, 1}, Vec3{0, 1, 0}, 0},
+		{Vec3{1, 2, 3}, Vec3{1, 1, 1}, Vec3{0, 0, 1}, Pi * 0.5},
+		{Vec3{-4, 0.5, 10}, Vec3{2, 3, 4}, Vec3{1, 0, 0}, 1.2},
+		{Vec3{7, -8, 9}, Vec3{0.5, 1, 2}, *NewVec3(1, 2, 3).Normalize(), 2.5},
+		{Vec3{0, 0, -1}, Vec3{1, 1, 1}, *NewVec3(-1, 1, 0).Normalize(), Pi * 0.9},
+	}
+
+	for _, test := range tests {
+		var m Mat4
+		var position, scale Vec3
+		var rotation Quat
+		want := *NewQuat().FromAxisAngle(&test.axis, test.angle)
+
+		m.Compose(&test.position, &test.scale, &want)
+		m.Decompose(&position, &scale, &rotation)
+
+		if !position.EqualsTol(&test.position, 1e-5) {
+			t.Errorf("Decompose position = %v, want %v", position, test.position)
+		}
+		if !scale.EqualsTol(&test.scale, 1e-5) {
+			t.Errorf("Decompose scale = %v, want %v", scale, test.scale)
+		}
+		if !rotation.EqualsRotation(&want, 1e-5) {
+			t.Errorf("Decompose rotation = %v, want %v", rotation, want)
+		}
+
+		var again Mat4
+
+		if again.Compose(&position, &scale, &rotation); !again.EqualsTol(&m, 1e-5) {
+			t.Errorf("Compose(Decompose(m)) = %v, want %v", again, m)
+		}
+	}
+}
+
+func TestMat4DecomposeIdentity(t *testing.T) {
+	var position, scale Vec3
+	var rotation Quat
+
+	// the baseline wrote w into z, so the identity decomposed to {0, 0, 1, 0}
+	NewMat4().Decompose(&position, &scale, &rotation)
+
+	if want := (Quat{0, 0, 0, 1}); !rotation.Equals(&want) {
+		t.Errorf("identity Decompose rotation = %v, want %v", rotation, want)
+	}
+}
+
+func TestMat4Equals(t *testing.T) {
+	var m Mat4
+
+	for i := range m {
+		m[i] = float32(i + 1)
+	}
+
+	if !m.Equals(m.Clone()) {
+		t.Errorf("%v.Equals(itself) = false", m)
+	}
+
+	// a change in any element must be seen
+	for i := range m {
+		other := m
+
+		other[i] += 0.5
+
+		if m.Equals(&other) || other.Equals(&m) {
+			t.Errorf("Equals with element %d changed = true, want false", i)
+		}
+	}
+}
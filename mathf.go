@@ -3,6 +3,7 @@ package mathf
 import (
 	"math"
 	"math/rand"
+	"unsafe"
 )
 
 const (
@@ -34,8 +35,19 @@ const (
 	DOWN_RIGHT = "down_right"
 )
 
+// floating point types of the generic vector, matrix and quaternion types
+type Float interface {
+	~float32 | ~float64
+}
+
 // returns absolute value of x
 func Abs(x float32) float32 {
+
+	return abs(x)
+}
+
+// returns absolute value of x
+func abs[T Float](x T) T {
 	if x < 0 {
 		return -x
 	}
@@ -43,12 +55,126 @@ func Abs(x float32) float32 {
 	return x
 }
 
-// checks if a and b are equal
+// checks if a and b are equal within Epsilon, unlike the exact Equals methods of the math types
 func Equals(a, b float32) bool {
 
 	return Abs(a-b) < Epsilon
 }
 
+// checks if a and b are within absolute tolerance tol
+func EqualsTol(a, b, tol float32) bool {
+
+	return equalsTol(a, b, tol)
+}
+
+// checks if a and b are within absolute tolerance tol
+func equalsTol[T Float](a, b, tol T) bool {
+
+	return a == b || abs(a-b) <= tol
+}
+
+// checks if a and b are within tol relative to the larger magnitude of a and b
+func EqualsRel(a, b, tol float32) bool {
+
+	return equalsRel(a, b, tol)
+}
+
+// checks if a and b are within tol relative to the larger magnitude of a and b
+func equalsRel[T Float](a, b, tol T) bool {
+	m := abs(a)
+
+	if x := abs(b); x > m {
+		m = x
+	}
+
+	return a == b || abs(a-b) <= tol*m
+}
+
+// checks if a and b are at most ulps representable floats apart, NaN never equals
+func EqualsULP(a, b float32, ulps int) bool {
+
+	return equalsULP(a, b, ulps)
+}
+
+// checks if a and b are at most ulps representable floats apart, NaN never equals
+func equalsULP[T Float](a, b T, ulps int) bool {
+
+	if a == b {
+		return true
+	}
+	if a != a || b != b || ulps < 0 {
+		return false
+	}
+
+	ia, ib := orderedBits(a), orderedBits(b)
+
+	if ia < ib {
+		ia, ib = ib, ia
+	}
+
+	return uint64(ia)-uint64(ib) <= uint64(ulps)
+}
+
+// returns bits of x as an integer ordered like the floats, 0 and -0 are the same
+func orderedBits[T Float](x T) int64 {
+	var bits, sign uint64
+
+	if unsafe.Sizeof(x) == 4 {
+		bits, sign = uint64(math.Float32bits(float32(x))), 1<<31
+	} else {
+		bits, sign = math.Float64bits(float64(x)), 1<<63
+	}
+
+	if bits&sign != 0 {
+		return -int64(bits &^ sign)
+	}
+
+	return int64(bits)
+}
+
+// checks if a and b are element wise within absolute tolerance tol, b must be as long as a
+func equalsTolSlice[T Float](a, b []T, tol T) bool {
+	b = b[:len(a)]
+
+	for i, v := range a {
+		if !equalsTol(v, b[i], tol) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checks if a and b are element wise within tol relative to their largest element magnitude, b must be as long as a
+func equalsRelSlice[T Float](a, b []T, tol T) bool {
+	var m T
+	b = b[:len(a)]
+
+	for i, v := range a {
+		if x := abs(v); x > m {
+			m = x
+		}
+		if x := abs(b[i]); x > m {
+			m = x
+		}
+	}
+
+	return equalsTolSlice(a, b, tol*m)
+}
+
+// checks if a and b are element wise at most ulps representable floats apart, b must be as long as a
+func equalsULPSlice[T Float](a, b []T, ulps int) bool {
+	b = b[:len(a)]
+
+	for i, v := range a {
+		if !equalsULP(v, b[i], ulps) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // returns x as a standard radian 0 <= x < PI
 func StandardRadian(x float32) float32 {
 
@@ -70,9 +196,28 @@ func Sign(x float32) float32 {
 	return 1
 }
 
+// returns the largest finite value of T, Inf for float32
+func inf[T Float]() T {
+	var x T
+
+	if unsafe.Sizeof(x) == 4 {
+		return Inf
+	}
+
+	m := math.MaxFloat64
+
+	return T(m)
+}
+
 // clamp x between min and max
 func Clamp(x, min, max float32) float32 {
 
+	return clamp(x, min, max)
+}
+
+// clamp x between min and max
+func clamp[T Float](x, min, max T) T {
+
 	if x < min {
 		return min
 	}
@@ -86,6 +231,12 @@ func Clamp(x, min, max float32) float32 {
 // clamp x between 0 and 1
 func Clamp01(x float32) float32 {
 
+	return clamp01(x)
+}
+
+// clamp x between 0 and 1
+func clamp01[T Float](x T) T {
+
 	if x < 0 {
 		return 0
 	}
@@ -111,6 +262,12 @@ func Truncate(x, n float32) float32 {
 // lerps between a and b by x
 func Lerp(x, a, b float32) float32 {
 
+	return lerp(x, a, b)
+}
+
+// lerps between a and b by x
+func lerp[T Float](x, a, b T) T {
+
 	return a + (b-a)*x
 }
 
@@ -0,0 +1,125 @@
+package mathf
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEqualsApprox(t *testing.T) {
+	one := math.Nextafter32(1, 2)
+	denormal := math.Float32frombits(1)
+
+	tests := []struct {
+		a, b         float32
+		tol          float32
+		ulps         int
+		tolEq, relEq bool
+		ulpEq        bool
+	}{
+		{1, 1, 0, 0, true, true, true},
+		{0, float32(math.Copysign(0, -1)), 0, 0, true, true, true},
+		{1, one, 0, 1, false, false, true},
+		{1, math.Nextafter32(one, 2), 0, 1, false, false, false},
+		{-denormal, denormal, 0, 2, false, false, true},
+		{-denormal, denormal, 0, 1, false, false, false},
+		{1, 1.001, 0.01, 0, true, true, false},
+		{1000, 1000.5, 0.001, 0, false, true, false},
+		{-1e-30, 1e-30, 1e-29, 4, true, false, false},
+		{float32(math.NaN()), float32(math.NaN()), 1, math.MaxInt32, false, false, false},
+		{1, 2, 0.5, -1, false, true, false},
+	}
+
+	for _, test := range tests {
+		if got := EqualsTol(test.a, test.b, test.tol); got != test.tolEq {
+			t.Errorf("EqualsTol(%v, %v, %v) = %v, want %v", test.a, test.b, test.tol, got, test.tolEq)
+		}
+		if got := EqualsRel(test.a, test.b, test.tol); got != test.relEq {
+			t.Errorf("EqualsRel(%v, %v, %v) = %v, want %v", test.a, test.b, test.tol, got, test.relEq)
+		}
+		if got := EqualsULP(test.a, test.b, test.ulps); got != test.ulpEq {
+			t.Errorf("EqualsULP(%v, %v, %v) = %v, want %v", test.a, test.b, test.ulps, got, test.ulpEq)
+		}
+		if got := EqualsULP(test.b, test.a, test.ulps); got != test.ulpEq {
+			t.Errorf("EqualsULP(%v, %v, %v) = %v, want %v", test.b, test.a, test.ulps, got, test.ulpEq)
+		}
+	}
+
+	if !equalsULP(1, math.Nextafter(1, 2), 1) || equalsULP(1, math.Nextafter(1, 2), 0) {
+		t.Error("equalsULP[float64] does not count float64 steps")
+	}
+}
+
+func TestEqualsExact(t *testing.T) {
+	d := float32(1e-6)
+
+	// each type's Equals is exact, so a change of d must be seen while EqualsTol ignores it
+	tests := []struct {
+		name  string
+		equal func(d float32) bool
+		tol   func(d float32) bool
+	}{
+		{"Vec2", func(d float32) bool { return (&Vec2{1, 2}).Equals(&Vec2{1, 2 + d}) }, func(d float32) bool { return (&Vec2{1, 2}).EqualsTol(&Vec2{1, 2 + d}, 1e-5) }},
+		{"Vec3", func(d float32) bool { return (&Vec3{1, 2, 3}).Equals(&Vec3{1, 2, 3 + d}) }, func(d float32) bool { return (&Vec3{1, 2, 3}).EqualsTol(&Vec3{1, 2, 3 + d}, 1e-5) }},
+		{"Vec4", func(d float32) bool { return (&Vec4{1, 2, 3, 4}).Equals(&Vec4{1, 2, 3, 4 + d}) }, func(d float32) bool { return (&Vec4{1, 2, 3, 4}).EqualsTol(&Vec4{1, 2, 3, 4 + d}, 1e-5) }},
+		{"Quat", func(d float32) bool { return (&Quat{0, 0, 0, 1}).Equals(&Quat{d, 0, 0, 1}) }, func(d float32) bool { return (&Quat{0, 0, 0, 1}).EqualsTol(&Quat{d, 0, 0, 1}, 1e-5) }},
+		{"Color", func(d float32) bool { return (&Color{1, 0.5, 0, 1}).Equals(&Color{1, 0.5 + d, 0, 1}) }, func(d float32) bool { return (&Color{1, 0.5, 0, 1}).EqualsTol(&Color{1, 0.5 + d, 0, 1}, 1e-5) }},
+		{"Mat2", func(d float32) bool { return (&Mat2{1, 0, 0, 1}).Equals(&Mat2{1, 0, d, 1}) }, func(d float32) bool { return (&Mat2{1, 0, 0, 1}).EqualsTol(&Mat2{1, 0, d, 1}, 1e-5) }},
+		{"Mat3", func(d float32) bool { return (&Mat3{8: 1}).Equals(&Mat3{8: 1 + d}) }, func(d float32) bool { return (&Mat3{8: 1}).EqualsTol(&Mat3{8: 1 + d}, 1e-5) }},
+		{"Mat4", func(d float32) bool { return (&Mat4{15: 1}).Equals(&Mat4{15: 1 + d}) }, func(d float32) bool { return (&Mat4{15: 1}).EqualsTol(&Mat4{15: 1 + d}, 1e-5) }},
+		{"Mat32 x", func(d float32) bool { return (&Mat32{1, 0, 0, 1, 5, 6}).Equals(&Mat32{1, 0, 0, 1, 5 + d, 6}) }, func(d float32) bool { return (&Mat32{1, 0, 0, 1, 5, 6}).EqualsTol(&Mat32{1, 0, 0, 1, 5 + d, 6}, 1e-5) }},
+		{"Mat32 y", func(d float32) bool { return (&Mat32{1, 0, 0, 1, 5, 6}).Equals(&Mat32{1, 0, 0, 1, 5, 6 + d}) }, func(d float32) bool { return (&Mat32{1, 0, 0, 1, 5, 6}).EqualsTol(&Mat32{1, 0, 0, 1, 5, 6 + d}, 1e-5) }},
+		{"DualQuat", func(d float32) bool { return (&DualQuat{3: 1}).Equals(&DualQuat{3: 1, 4: d}) }, func(d float32) bool { return (&DualQuat{3: 1}).EqualsTol(&DualQuat{3: 1, 4: d}, 1e-5) }},
+		{"Euler", func(d float32) bool {
+			return (&Euler{0.1, 0.2, 0.3, EULER_XYZ}).Equals(&Euler{0.1, 0.2, 0.3 + d, EULER_XYZ})
+		}, func(d float32) bool {
+			return (&Euler{0.1, 0.2, 0.3, EULER_XYZ}).EqualsTol(&Euler{0.1, 0.2, 0.3 + d, EULER_XYZ}, 1e-5)
+		}},
+		{"AABB2", func(d float32) bool {
+			return (&AABB2{&Vec2{0, 0}, &Vec2{1, 1}}).Equals(&AABB2{&Vec2{0, 0}, &Vec2{1, 1 + d}})
+		}, func(d float32) bool {
+			return (&AABB2{&Vec2{0, 0}, &Vec2{1, 1}}).EqualsTol(&AABB2{&Vec2{0, 0}, &Vec2{1, 1 + d}}, 1e-5)
+		}},
+		{"AABB3", func(d float32) bool {
+			return (&AABB3{&Vec3{0, 0, 0}, &Vec3{1, 1, 1}}).Equals(&AABB3{&Vec3{d, 0, 0}, &Vec3{1, 1, 1}})
+		}, func(d float32) bool {
+			return (&AABB3{&Vec3{0, 0, 0}, &Vec3{1, 1, 1}}).EqualsTol(&AABB3{&Vec3{d, 0, 0}, &Vec3{1, 1, 1}}, 1e-5)
+		}},
+		{"Circle", func(d float32) bool { return (&Circle{&Vec2{1, 2}, 3}).Equals(&Circle{&Vec2{1, 2}, 3 + d}) }, func(d float32) bool { return (&Circle{&Vec2{1, 2}, 3}).EqualsTol(&Circle{&Vec2{1, 2}, 3 + d}, 1e-5) }},
+		{"Sphere", func(d float32) bool { return (&Sphere{&Vec3{1, 2, 3}, 4}).Equals(&Sphere{&Vec3{1, 2, 3 + d}, 4}) }, func(d float32) bool {
+			return (&Sphere{&Vec3{1, 2, 3}, 4}).EqualsTol(&Sphere{&Vec3{1, 2, 3 + d}, 4}, 1e-5)
+		}},
+		{"Plane", func(d float32) bool { return (&Plane{&Vec3{0, 1, 0}, 2}).Equals(&Plane{&Vec3{0, 1, 0}, 2 + d}) }, func(d float32) bool {
+			return (&Plane{&Vec3{0, 1, 0}, 2}).EqualsTol(&Plane{&Vec3{0, 1, 0}, 2 + d}, 1e-5)
+		}},
+	}
+
+	for _, test := range tests {
+		if !test.equal(0) {
+			t.Errorf("%s.Equals of equal values = false, want true", test.name)
+		}
+		if test.equal(d) {
+			t.Errorf("%s.Equals of values %v apart = true, want false", test.name, d)
+		}
+		if !test.tol(d) {
+			t.Errorf("%s.EqualsTol of values %v apart = false, want true", test.name, d)
+		}
+		if test.tol(1e-3) {
+			t.Errorf("%s.EqualsTol of values 1e-3 apart = true, want false", test.name)
+		}
+	}
+}
+
+func TestQuatEqualsRotation(t *testing.T) {
+	q := *NewQuat().FromAxisAngle(&Vec3{0, 1, 0}, 0.5)
+	negated := Quat{-q[0], -q[1], -q[2], -q[3]}
+
+	if q.Equals(&negated) || q.EqualsTol(&negated, 1e-5) {
+		t.Errorf("%v.Equals(%v) = true, want false", q, negated)
+	}
+	if !q.EqualsRotation(&negated, 1e-5) {
+		t.Errorf("%v.EqualsRotation(%v) = false, want true", q, negated)
+	}
+	if other := *NewQuat().FromAxisAngle(&Vec3{0, 1, 0}, 0.6); q.EqualsRotation(&other, 1e-5) {
+		t.Errorf("%v.EqualsRotation(%v) = true, want false", q, other)
+	}
+}
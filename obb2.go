@@ -0,0 +1,411 @@
+package mathf
+
+import (
+	"fmt"
+	"math"
+)
+
+// 2D oriented bounding box represented by a center, half extents along each axis and a rotation whose columns are the axes
+type OBB2 struct {
+	Center, HalfSize *Vec2
+	Rotation         *Mat2
+}
+
+// returns new OBB2
+func NewOBB2(center, halfSize *Vec2, rotation *Mat2) *OBB2 {
+	this := new(OBB2)
+
+	this.Center = new(Vec2).Copy(center)
+	this.HalfSize = new(Vec2).Copy(halfSize)
+	this.Rotation = new(Mat2).Copy(rotation)
+
+	return this
+}
+
+// returns a copy of this
+func (this *OBB2) Clone() *OBB2 {
+
+	return NewOBB2(this.Center, this.HalfSize, this.Rotation)
+}
+
+// copies other
+func (this *OBB2) Copy(other *OBB2) *OBB2 {
+
+	this.Center.Copy(other.Center)
+	this.HalfSize.Copy(other.HalfSize)
+	this.Rotation.Copy(other.Rotation)
+
+	return this
+}
+
+// sets this from values
+func (this *OBB2) Set(center, halfSize *Vec2, rotation *Mat2) *OBB2 {
+
+	this.Center.Copy(center)
+	this.HalfSize.Copy(halfSize)
+	this.Rotation.Copy(rotation)
+
+	return this
+}
+
+// sets rotation from angle in radians
+func (this *OBB2) SetRotation(angle float32) *OBB2 {
+
+	this.Rotation.MakeRotation(angle)
+
+	return this
+}
+
+// returns axis i of this, saves in target
+func (this *OBB2) Axis(i int, target *Vec2) *Vec2 {
+	m := this.Rotation
+
+	return target.Set(m[i*2], m[i*2+1])
+}
+
+// sets this from box transformed by Mat32, m should not contain shear
+func (this *OBB2) FromAABB2(box *AABB2, m *Mat32) *OBB2 {
+
+	box.Center(this.Center).ApplyMat32(m)
+	box.Size(this.HalfSize).SMul(0.5)
+	this.Rotation.FromMat32(m)
+
+	return this.normalizeAxes()
+}
+
+// transforms this by Mat32, m should not contain shear
+func (this *OBB2) ApplyMat32(m *Mat32) *OBB2 {
+	var axis Vec2
+	r := this.Rotation
+
+	this.Center.ApplyMat32(m)
+
+	for i := 0; i < 2; i++ {
+		this.Axis(i, &axis).ApplyMat32Direction(m)
+		r[i*2], r[i*2+1] = axis[0], axis[1]
+	}
+
+	return this.normalizeAxes()
+}
+
+// moves the scale of each rotation axis into half size
+func (this *OBB2) normalizeAxes() *OBB2 {
+	m := this.Rotation
+
+	for i := 0; i < 2; i++ {
+		x, y := m[i*2], m[i*2+1]
+		l := float32(math.Sqrt(float64(x*x + y*y)))
+
+		if l == 0 {
+			continue
+		}
+
+		this.HalfSize[i] *= l
+		l = 1 / l
+		m[i*2], m[i*2+1] = x*l, y*l
+	}
+
+	return this
+}
+
+// sets this from array of points, axes are the principal components of the points
+func (this *OBB2) FromPoints(array []*Vec2) *OBB2 {
+	l := len(array)
+
+	if l == 0 {
+		this.Center.Set(0, 0)
+		this.HalfSize.Set(0, 0)
+		this.Rotation.Identity()
+
+		return this
+	}
+
+	var mx, my, cxx, cxy, cyy float64
+
+	for _, v := range array {
+		mx += float64(v[0])
+		my += float64(v[1])
+	}
+	mx /= float64(l)
+	my /= float64(l)
+
+	for _, v := range array {
+		dx, dy := float64(v[0])-mx, float64(v[1])-my
+
+		cxx += dx * dx
+		cxy += dx * dy
+		cyy += dy * dy
+	}
+
+	this.Rotation.MakeRotation(float32(0.5 * math.Atan2(2*cxy, cxx-cyy)))
+	m := this.Rotation
+
+	min := [2]float32{Inf, Inf}
+	max := [2]float32{-Inf, -Inf}
+
+	for _, v := range array {
+		for i := 0; i < 2; i++ {
+			d := v[0]*m[i*2] + v[1]*m[i*2+1]
+
+			if d < min[i] {
+				min[i] = d
+			}
+			if d > max[i] {
+				max[i] = d
+			}
+		}
+	}
+
+	this.Center.Set(0, 0)
+
+	for i := 0; i < 2; i++ {
+		c := (min[i] + max[i]) * 0.5
+
+		this.HalfSize[i] = (max[i] - min[i]) * 0.5
+		this.Center[0] += m[i*2] * c
+		this.Center[1] += m[i*2+1] * c
+	}
+
+	return this
+}
+
+// returns point in the local space of this, saves in target
+func (this *OBB2) toLocal(v, target *Vec2) *Vec2 {
+	m := this.Rotation
+	x, y := v[0]-this.Center[0], v[1]-this.Center[1]
+
+	return target.Set(x*m[0]+y*m[1], x*m[2]+y*m[3])
+}
+
+// checks if OBB contains point
+func (this *OBB2) Contains(v *Vec2) bool {
+	var p Vec2
+
+	this.toLocal(v, &p)
+
+	return Abs(p[0]) <= this.HalfSize[0] && Abs(p[1]) <= this.HalfSize[1]
+}
+
+// returns closest point in this to point, saves in target
+func (this *OBB2) ClosestPoint(v, target *Vec2) *Vec2 {
+	var p Vec2
+	m, h := this.Rotation, this.HalfSize
+
+	this.toLocal(v, &p)
+
+	x := Clamp(p[0], -h[0], h[0])
+	y := Clamp(p[1], -h[1], h[1])
+
+	return target.Set(this.Center[0]+m[0]*x+m[2]*y, this.Center[1]+m[1]*x+m[3]*y)
+}
+
+// returns distance from this to point, zero if point is inside
+func (this *OBB2) DistanceToPoint(v *Vec2) float32 {
+	var p Vec2
+
+	return this.ClosestPoint(v, &p).DistanceTo(v)
+}
+
+// returns the projected radius of this onto axis n
+func (this *OBB2) projectedRadius(n *Vec2) float32 {
+	m, h := this.Rotation, this.HalfSize
+
+	return h[0]*Abs(m[0]*n[0]+m[1]*n[1]) + h[1]*Abs(m[2]*n[0]+m[3]*n[1])
+}
+
+// checks if this and other intersect using the separating axis test
+func (this *OBB2) Intersects(other *OBB2) bool {
+	var axis Vec2
+	var t Vec2
+
+	t.VSub(other.Center, this.Center)
+
+	for i := 0; i < 2; i++ {
+		if Abs(t.Dot(this.Axis(i, &axis))) > this.projectedRadius(&axis)+other.projectedRadius(&axis) {
+			return false
+		}
+		if Abs(t.Dot(other.Axis(i, &axis))) > this.projectedRadius(&axis)+other.projectedRadius(&axis) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checks if this and box intersect
+func (this *OBB2) IntersectsAABB2(box *AABB2) bool {
+	var other OBB2
+	var center, halfSize Vec2
+	var rotation Mat2
+
+	other.Center = box.Center(&center)
+	other.HalfSize = box.Size(&halfSize).SMul(0.5)
+	other.Rotation = rotation.Identity()
+
+	return this.Intersects(&other)
+}
+
+// checks if this and circle intersect
+func (this *OBB2) IntersectsCircle(circle *Circle) bool {
+	var p Vec2
+
+	return this.ClosestPoint(circle.Center, &p).DistanceToSq(circle.Center) <= circle.Radius*circle.Radius
+}
+
+// checks if this and triangle a, b, c intersect using the separating axis test
+func (this *OBB2) IntersectsTriangle(a, b, c *Vec2) bool {
+	var axis Vec2
+	points := [3]*Vec2{a, b, c}
+
+	separated := func(n *Vec2) bool {
+		o := this.Center.Dot(n)
+		r := this.projectedRadius(n)
+		min, max := float32(Inf), float32(-Inf)
+
+		for _, p := range points {
+			d := p.Dot(n) - o
+
+			if d < min {
+				min = d
+			}
+			if d > max {
+				max = d
+			}
+		}
+
+		return min > r || max < -r
+	}
+
+	for i := 0; i < 2; i++ {
+		if separated(this.Axis(i, &axis)) {
+			return false
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		p, q := points[i], points[(i+1)%3]
+
+		if separated(axis.Set(p[1]-q[1], q[0]-p[0])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *OBB2) Equals(other *OBB2) bool {
+
+	return this.Center.Equals(other.Center) && this.HalfSize.Equals(other.HalfSize) && this.Rotation.Equals(other.Rotation)
+}
+
+// checks if this equals other within absolute tolerance tol
+func (this *OBB2) EqualsTol(other *OBB2, tol float32) bool {
+
+	return this.Center.EqualsTol(other.Center, tol) && this.HalfSize.EqualsTol(other.HalfSize, tol) && this.Rotation.EqualsTol(other.Rotation, tol)
+}
+
+// checks if this equals other within tol relative to the magnitude of its elements
+func (this *OBB2) EqualsRel(other *OBB2, tol float32) bool {
+
+	return this.Center.EqualsRel(other.Center, tol) && this.HalfSize.EqualsRel(other.HalfSize, tol) && this.Rotation.EqualsRel(other.Rotation, tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *OBB2) EqualsULP(other *OBB2, ulps int) bool {
+
+	return this.Center.EqualsULP(other.Center, ulps) && this.HalfSize.EqualsULP(other.HalfSize, ulps) && this.Rotation.EqualsULP(other.Rotation, ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *OBB2) MarshalText() ([]byte, error) {
+	e := this.elements()
+
+	return appendStructText(nil, obb2Text, e[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *OBB2) UnmarshalText(text []byte) error {
+	var e [8]float32
+
+	if err := parseStructText(text, obb2Text, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns this as a JSON array of Center, HalfSize and Rotation, implements json.Marshaler
+func (this *OBB2) MarshalJSON() ([]byte, error) {
+	e := this.elements()
+
+	return appendJSON(nil, e[:])
+}
+
+// parses a JSON array of Center, HalfSize and Rotation into this, implements json.Unmarshaler
+func (this *OBB2) UnmarshalJSON(data []byte) error {
+	var e [8]float32
+
+	if isJSONNull(data) {
+		return nil
+	}
+	if err := parseJSON(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Center, HalfSize and Rotation of this as little endian floats, implements encoding.BinaryMarshaler
+func (this *OBB2) MarshalBinary() ([]byte, error) {
+	e := this.elements()
+
+	return appendBinary(nil, e[:]), nil
+}
+
+// parses little endian floats of Center, HalfSize and Rotation into this, implements encoding.BinaryUnmarshaler
+func (this *OBB2) UnmarshalBinary(data []byte) error {
+	var e [8]float32
+
+	if err := parseBinary(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Center, HalfSize and Rotation of this as marshaling elements, nil fields are zero
+func (this *OBB2) elements() [8]float32 {
+	center, halfSize, rotation := valueOrZero(this.Center), valueOrZero(this.HalfSize), valueOrZero(this.Rotation)
+
+	return [8]float32{center[0], center[1], halfSize[0], halfSize[1], rotation[0], rotation[1], rotation[2], rotation[3]}
+}
+
+// sets Center, HalfSize and Rotation of this from marshaling elements, nil fields are allocated
+func (this *OBB2) setElements(e *[8]float32) {
+	if this.Center == nil {
+		this.Center = new(Vec2)
+	}
+	if this.HalfSize == nil {
+		this.HalfSize = new(Vec2)
+	}
+	if this.Rotation == nil {
+		this.Rotation = new(Mat2)
+	}
+
+	copy(this.Center[:], e[0:2])
+	copy(this.HalfSize[:], e[2:4])
+	copy(this.Rotation[:], e[4:8])
+}
+
+// returns this as string type
+func (this *OBB2) String() string {
+
+	return fmt.Sprintf("OBB2[ Center: %s, HalfSize: %s, Rotation: %s ]", this.Center, this.HalfSize, this.Rotation)
+}
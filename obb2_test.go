@@ -0,0 +1,176 @@
+package mathf
+
+import (
+	"math"
+	"testing"
+)
+
+// returns OBB2 with center and half size rotated by angle
+func newRotatedOBB2(center, halfSize *Vec2, angle float32) *OBB2 {
+
+	return NewOBB2(center, halfSize, NewMat2()).SetRotation(angle)
+}
+
+func TestOBB2SetRotation(t *testing.T) {
+	box := newRotatedOBB2(&Vec2{}, &Vec2{1, 1}, 0.8)
+	s, c := float32(math.Sin(0.8)), float32(math.Cos(0.8))
+
+	for i, want := range []Vec2{{c, s}, {-s, c}} {
+		var axis Vec2
+
+		if box.Axis(i, &axis); !axis.EqualsTol(&want, 1e-6) {
+			t.Errorf("Axis(%d) = %v, want %v", i, axis, want)
+		}
+	}
+}
+
+func TestOBB2Intersects(t *testing.T) {
+	unit := Vec2{1, 1}
+	identity := NewMat2()
+	rotated := newRotatedOBB2(&Vec2{}, &unit, 0.6)
+	axis := rotated.Axis(0, new(Vec2))
+
+	// b is turned 45 degrees and placed along the diagonal, only its own axes separate it from the corner of a
+	diagonal := func(d float32) *OBB2 {
+		return newRotatedOBB2(&Vec2{d / math.Sqrt2, d / math.Sqrt2}, &unit, Pi*0.25)
+	}
+
+	tests := []struct {
+		name string
+		a, b *OBB2
+		want bool
+	}{
+		{"same", NewOBB2(&Vec2{}, &unit, identity), NewOBB2(&Vec2{}, &unit, identity), true},
+		{"overlapping", NewOBB2(&Vec2{}, &unit, identity), NewOBB2(&Vec2{1.5, 0.5}, &unit, identity), true},
+		{"touching", NewOBB2(&Vec2{}, &unit, identity), NewOBB2(&Vec2{2, 0}, &unit, identity), true},
+		{"separated x", NewOBB2(&Vec2{}, &unit, identity), NewOBB2(&Vec2{2.01, 0}, &unit, identity), false},
+		{"separated y", NewOBB2(&Vec2{}, &unit, identity), NewOBB2(&Vec2{0, -2.5}, &Vec2{1, 0.4}, identity), false},
+		{"contained", NewOBB2(&Vec2{}, &Vec2{5, 5}, identity), rotated, true},
+		{"rotated corner in", NewOBB2(&Vec2{}, &unit, identity), newRotatedOBB2(&Vec2{1 + math.Sqrt2 - 0.05, 0}, &unit, Pi*0.25), true},
+		{"rotated corner out", NewOBB2(&Vec2{}, &unit, identity), newRotatedOBB2(&Vec2{1 + math.Sqrt2 + 0.05, 0}, &unit, Pi*0.25), false},
+		{"diagonal overlapping", NewOBB2(&Vec2{}, &unit, identity), diagonal(1 + math.Sqrt2 - 0.05), true},
+		{"diagonal separated", NewOBB2(&Vec2{}, &unit, identity), diagonal(1 + math.Sqrt2 + 0.05), false},
+		{"parallel edges overlapping", rotated, NewOBB2(axis.Clone().SMul(1.999), &unit, rotated.Rotation), true},
+		{"parallel edges separated", rotated, NewOBB2(axis.Clone().SMul(2.01), &unit, rotated.Rotation), false},
+	}
+
+	for _, test := range tests {
+		if got := test.a.Intersects(test.b); got != test.want {
+			t.Errorf("%s: %v.Intersects(%v) = %v, want %v", test.name, test.a, test.b, got, test.want)
+		}
+		if got := test.b.Intersects(test.a); got != test.want {
+			t.Errorf("%s: %v.Intersects(%v) = %v, want %v", test.name, test.b, test.a, got, test.want)
+		}
+	}
+}
+
+func TestOBB2IntersectsTriangle(t *testing.T) {
+	box := NewOBB2(&Vec2{}, &Vec2{1, 1}, NewMat2())
+	rotated := newRotatedOBB2(&Vec2{1, 2}, &Vec2{2, 0.5}, 1)
+
+	tests := []struct {
+		name    string
+		box     *OBB2
+		a, b, c Vec2
+		want    bool
+	}{
+		{"inside", box, Vec2{-0.5, 0}, Vec2{0.5, 0}, Vec2{0, 0.5}, true},
+		{"box inside triangle", box, Vec2{-10, -10}, Vec2{10, -10}, Vec2{0, 10}, true},
+		{"face axis", box, Vec2{2, 0}, Vec2{3, 0}, Vec2{2, 1}, false},
+		// only the normal of the edge from (2+d, 0) to (0, 2+d) separates
+		{"edge normal separated", box, Vec2{2.05, 0}, Vec2{0, 2.05}, Vec2{3, 3}, false},
+		{"edge normal overlapping", box, Vec2{1.95, 0}, Vec2{0, 1.95}, Vec2{3, 3}, true},
+		{"degenerate segment through", box, Vec2{-3, 0.5}, Vec2{-3, 0.5}, Vec2{3, 0.5}, true},
+		{"degenerate segment outside", box, Vec2{-3, 1.5}, Vec2{-3, 1.5}, Vec2{3, 1.5}, false},
+		{"rotated center", rotated, Vec2{1, 2}, Vec2{1.1, 2}, Vec2{1, 2.1}, true},
+		{"rotated far", rotated, Vec2{10, 2}, Vec2{11, 2}, Vec2{10, 3}, false},
+	}
+
+	for _, test := range tests {
+		if got := test.box.IntersectsTriangle(&test.a, &test.b, &test.c); got != test.want {
+			t.Errorf("%s: IntersectsTriangle(%v, %v, %v) = %v, want %v", test.name, test.a, test.b, test.c, got, test.want)
+		}
+	}
+}
+
+func TestOBB2FromPoints(t *testing.T) {
+	center, halfSize := Vec2{2, -1}, Vec2{3, 1}
+	rotation := NewMat2().MakeRotation(0.5)
+	var points []*Vec2
+
+	// the corners of a known rectangle have its axes as principal components
+	for _, p := range [][2]float32{{-1, -1}, {1, -1}, {1, 1}, {-1, 1}} {
+		v := Vec2{p[0] * halfSize[0], p[1] * halfSize[1]}
+
+		points = append(points, v.ApplyMat2(rotation).Add(&center))
+	}
+
+	box := NewOBB2(&Vec2{}, &Vec2{}, NewMat2()).FromPoints(points)
+	want := NewOBB2(&center, &halfSize, rotation)
+
+	if !box.Center.EqualsTol(&center, 1e-5) {
+		t.Errorf("FromPoints center = %v, want %v", box.Center, center)
+	}
+
+	// axes come back in any order and sign, so match each to the known axis along it
+	for i := 0; i < 2; i++ {
+		var axis, other Vec2
+
+		box.Axis(i, &axis)
+		found := false
+
+		for j := 0; j < 2; j++ {
+			if Abs(axis.Dot(want.Axis(j, &other))) > 1-1e-5 {
+				found = true
+
+				if !EqualsTol(box.HalfSize[i], halfSize[j], 1e-5) {
+					t.Errorf("FromPoints half size along axis %d = %v, want %v", j, box.HalfSize[i], halfSize[j])
+				}
+			}
+		}
+
+		if !found {
+			t.Errorf("FromPoints axis %v is not a principal axis", axis)
+		}
+	}
+
+	for _, p := range points {
+		if !box.Contains(p) && box.DistanceToPoint(p) > 1e-5 {
+			t.Errorf("FromPoints box does not contain %v", p)
+		}
+	}
+
+	if box.FromPoints(nil); !box.HalfSize.Equals(&Vec2{}) || !box.Rotation.Equals(NewMat2()) {
+		t.Errorf("FromPoints(nil) = %v, want empty identity box", box)
+	}
+}
+
+func TestOBB2ContainsClosestPoint(t *testing.T) {
+	box := newRotatedOBB2(&Vec2{1, 2}, &Vec2{2, 1}, HALF_PI)
+
+	// after 90 degrees the box spans 1 in x and 2 in y around its center
+	tests := []struct {
+		p, closest Vec2
+		inside     bool
+	}{
+		{Vec2{1, 2}, Vec2{1, 2}, true},
+		{Vec2{1.9, 3.9}, Vec2{1.9, 3.9}, true},
+		{Vec2{1, 5}, Vec2{1, 4}, false},
+		{Vec2{3, 2}, Vec2{2, 2}, false},
+		{Vec2{5, -7}, Vec2{2, 0}, false},
+	}
+
+	for _, test := range tests {
+		var got Vec2
+
+		if inside := box.Contains(&test.p); inside != test.inside {
+			t.Errorf("Contains(%v) = %v, want %v", test.p, inside, test.inside)
+		}
+		if box.ClosestPoint(&test.p, &got); !got.EqualsTol(&test.closest, 1e-5) {
+			t.Errorf("ClosestPoint(%v) = %v, want %v", test.p, got, test.closest)
+		}
+		if d, want := box.DistanceToPoint(&test.p), test.p.DistanceTo(&test.closest); !EqualsTol(d, want, 1e-5) {
+			t.Errorf("DistanceToPoint(%v) = %v, want %v", test.p, d, want)
+		}
+	}
+}
@@ -0,0 +1,532 @@
+package mathf
+
+import (
+	"fmt"
+	"math"
+)
+
+// 3D oriented bounding box represented by a center, half extents along each axis and a rotation whose columns are the axes
+type OBB3 struct {
+	Center, HalfSize *Vec3
+	Rotation         *Mat3
+}
+
+// returns new OBB3
+func NewOBB3(center, halfSize *Vec3, rotation *Mat3) *OBB3 {
+	this := new(OBB3)
+
+	this.Center = new(Vec3).Copy(center)
+	this.HalfSize = new(Vec3).Copy(halfSize)
+	this.Rotation = new(Mat3).Copy(rotation)
+
+	return this
+}
+
+// returns a copy of this
+func (this *OBB3) Clone() *OBB3 {
+
+	return NewOBB3(this.Center, this.HalfSize, this.Rotation)
+}
+
+// copies other
+func (this *OBB3) Copy(other *OBB3) *OBB3 {
+
+	this.Center.Copy(other.Center)
+	this.HalfSize.Copy(other.HalfSize)
+	this.Rotation.Copy(other.Rotation)
+
+	return this
+}
+
+// sets this from values
+func (this *OBB3) Set(center, halfSize *Vec3, rotation *Mat3) *OBB3 {
+
+	this.Center.Copy(center)
+	this.HalfSize.Copy(halfSize)
+	this.Rotation.Copy(rotation)
+
+	return this
+}
+
+// sets rotation from Quat
+func (this *OBB3) SetRotation(q *Quat) *OBB3 {
+
+	this.Rotation.FromQuat(q)
+
+	return this
+}
+
+// returns axis i of this, saves in target
+func (this *OBB3) Axis(i int, target *Vec3) *Vec3 {
+	m := this.Rotation
+
+	return target.Set(m[i*3], m[i*3+1], m[i*3+2])
+}
+
+// sets this from box transformed by Mat4, m should not contain shear
+func (this *OBB3) FromAABB3(box *AABB3, m *Mat4) *OBB3 {
+
+	box.Center(this.Center).ApplyMat4(m)
+	box.Size(this.HalfSize).SMul(0.5)
+	this.Rotation.FromMat4(m)
+
+	return this.normalizeAxes()
+}
+
+// transforms this by Mat4, m should not contain shear
+func (this *OBB3) ApplyMat4(m *Mat4) *OBB3 {
+	var axis Vec3
+	r := this.Rotation
+
+	this.Center.ApplyMat4(m)
+
+	for i := 0; i < 3; i++ {
+		this.Axis(i, &axis).ApplyMat4Direction(m)
+		r[i*3], r[i*3+1], r[i*3+2] = axis[0], axis[1], axis[2]
+	}
+
+	return this.normalizeAxes()
+}
+
+// moves the scale of each rotation axis into half size
+func (this *OBB3) normalizeAxes() *OBB3 {
+	m := this.Rotation
+
+	for i := 0; i < 3; i++ {
+		x, y, z := m[i*3], m[i*3+1], m[i*3+2]
+		l := float32(math.Sqrt(float64(x*x + y*y + z*z)))
+
+		if l == 0 {
+			continue
+		}
+
+		this.HalfSize[i] *= l
+		l = 1 / l
+		m[i*3], m[i*3+1], m[i*3+2] = x*l, y*l, z*l
+	}
+
+	return this
+}
+
+// sets this from array of points, axes are the principal components of the points
+func (this *OBB3) FromPoints(array []*Vec3) *OBB3 {
+	l := len(array)
+
+	if l == 0 {
+		this.Center.Set(0, 0, 0)
+		this.HalfSize.Set(0, 0, 0)
+		this.Rotation.Identity()
+
+		return this
+	}
+
+	var mean [3]float64
+	var cov [3][3]float64
+
+	for _, v := range array {
+		mean[0] += float64(v[0])
+		mean[1] += float64(v[1])
+		mean[2] += float64(v[2])
+	}
+	for i := range mean {
+		mean[i] /= float64(l)
+	}
+
+	for _, v := range array {
+		d := [3]float64{float64(v[0]) - mean[0], float64(v[1]) - mean[1], float64(v[2]) - mean[2]}
+
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				cov[i][j] += d[i] * d[j]
+			}
+		}
+	}
+
+	axes := jacobiEigenvectors3(cov)
+	m := this.Rotation
+
+	for i := 0; i < 3; i++ {
+		m[i*3], m[i*3+1], m[i*3+2] = float32(axes[0][i]), float32(axes[1][i]), float32(axes[2][i])
+	}
+
+	m[6] = m[1]*m[5] - m[2]*m[4]
+	m[7] = m[2]*m[3] - m[0]*m[5]
+	m[8] = m[0]*m[4] - m[1]*m[3]
+
+	min := [3]float32{Inf, Inf, Inf}
+	max := [3]float32{-Inf, -Inf, -Inf}
+
+	for _, v := range array {
+		for i := 0; i < 3; i++ {
+			d := v[0]*m[i*3] + v[1]*m[i*3+1] + v[2]*m[i*3+2]
+
+			if d < min[i] {
+				min[i] = d
+			}
+			if d > max[i] {
+				max[i] = d
+			}
+		}
+	}
+
+	this.Center.Set(0, 0, 0)
+
+	for i := 0; i < 3; i++ {
+		c := (min[i] + max[i]) * 0.5
+
+		this.HalfSize[i] = (max[i] - min[i]) * 0.5
+		this.Center[0] += m[i*3] * c
+		this.Center[1] += m[i*3+1] * c
+		this.Center[2] += m[i*3+2] * c
+	}
+
+	return this
+}
+
+// returns the eigenvectors of symmetric matrix a as columns using cyclic Jacobi rotations
+func jacobiEigenvectors3(a [3][3]float64) [3][3]float64 {
+	v := [3][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+
+	for sweep := 0; sweep < 50; sweep++ {
+		off := a[0][1]*a[0][1] + a[0][2]*a[0][2] + a[1][2]*a[1][2]
+		if off < 1e-20 {
+			break
+		}
+
+		for p := 0; p < 2; p++ {
+			for q := p + 1; q < 3; q++ {
+				if a[p][q] == 0 {
+					continue
+				}
+
+				theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+
+				for k := 0; k < 3; k++ {
+					akp, akq := a[k][p], a[k][q]
+					a[k][p] = c*akp - s*akq
+					a[k][q] = s*akp + c*akq
+				}
+				for k := 0; k < 3; k++ {
+					apk, aqk := a[p][k], a[q][k]
+					a[p][k] = c*apk - s*aqk
+					a[q][k] = s*apk + c*aqk
+				}
+				for k := 0; k < 3; k++ {
+					vkp, vkq := v[k][p], v[k][q]
+					v[k][p] = c*vkp - s*vkq
+					v[k][q] = s*vkp + c*vkq
+				}
+			}
+		}
+	}
+
+	return v
+}
+
+// returns point in the local space of this, saves in target
+func (this *OBB3) toLocal(v, target *Vec3) *Vec3 {
+	m := this.Rotation
+	x, y, z := v[0]-this.Center[0], v[1]-this.Center[1], v[2]-this.Center[2]
+
+	return target.Set(
+		x*m[0]+y*m[1]+z*m[2],
+		x*m[3]+y*m[4]+z*m[5],
+		x*m[6]+y*m[7]+z*m[8],
+	)
+}
+
+// checks if OBB contains point
+func (this *OBB3) Contains(v *Vec3) bool {
+	var p Vec3
+
+	this.toLocal(v, &p)
+
+	return Abs(p[0]) <= this.HalfSize[0] && Abs(p[1]) <= this.HalfSize[1] && Abs(p[2]) <= this.HalfSize[2]
+}
+
+// returns closest point in this to point, saves in target
+func (this *OBB3) ClosestPoint(v, target *Vec3) *Vec3 {
+	var p Vec3
+	m, h := this.Rotation, this.HalfSize
+
+	this.toLocal(v, &p)
+
+	x := Clamp(p[0], -h[0], h[0])
+	y := Clamp(p[1], -h[1], h[1])
+	z := Clamp(p[2], -h[2], h[2])
+
+	return target.Set(
+		this.Center[0]+m[0]*x+m[3]*y+m[6]*z,
+		this.Center[1]+m[1]*x+m[4]*y+m[7]*z,
+		this.Center[2]+m[2]*x+m[5]*y+m[8]*z,
+	)
+}
+
+// returns distance from this to point, zero if point is inside
+func (this *OBB3) DistanceToPoint(v *Vec3) float32 {
+	var p Vec3
+
+	return this.ClosestPoint(v, &p).DistanceTo(v)
+}
+
+// checks if this and other intersect using the separating axis test
+func (this *OBB3) Intersects(other *OBB3) bool {
+	var r, absR [3][3]float32
+	var t [3]float32
+	a, b := this.Rotation, other.Rotation
+	ea, eb := this.HalfSize, other.HalfSize
+
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i][j] = a[i*3]*b[j*3] + a[i*3+1]*b[j*3+1] + a[i*3+2]*b[j*3+2]
+			absR[i][j] = Abs(r[i][j]) + Epsilon
+		}
+	}
+
+	tx := other.Center[0] - this.Center[0]
+	ty := other.Center[1] - this.Center[1]
+	tz := other.Center[2] - this.Center[2]
+
+	for i := 0; i < 3; i++ {
+		t[i] = tx*a[i*3] + ty*a[i*3+1] + tz*a[i*3+2]
+	}
+
+	for i := 0; i < 3; i++ {
+		ra := ea[i]
+		rb := eb[0]*absR[i][0] + eb[1]*absR[i][1] + eb[2]*absR[i][2]
+
+		if Abs(t[i]) > ra+rb {
+			return false
+		}
+	}
+
+	for j := 0; j < 3; j++ {
+		ra := ea[0]*absR[0][j] + ea[1]*absR[1][j] + ea[2]*absR[2][j]
+		rb := eb[j]
+
+		if Abs(t[0]*r[0][j]+t[1]*r[1][j]+t[2]*r[2][j]) > ra+rb {
+			return false
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		i1, i2 := (i+1)%3, (i+2)%3
+
+		for j := 0; j < 3; j++ {
+			j1, j2 := (j+1)%3, (j+2)%3
+
+			ra := ea[i1]*absR[i2][j] + ea[i2]*absR[i1][j]
+			rb := eb[j1]*absR[i][j2] + eb[j2]*absR[i][j1]
+
+			if Abs(t[i2]*r[i1][j]-t[i1]*r[i2][j]) > ra+rb {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// checks if this and box intersect
+func (this *OBB3) IntersectsAABB3(box *AABB3) bool {
+	var other OBB3
+	var center, halfSize Vec3
+	var rotation Mat3
+
+	other.Center = box.Center(&center)
+	other.HalfSize = box.Size(&halfSize).SMul(0.5)
+	other.Rotation = rotation.Identity()
+
+	return this.Intersects(&other)
+}
+
+// checks if this and sphere intersect
+func (this *OBB3) IntersectsSphere(sphere *Sphere) bool {
+	var p Vec3
+
+	return this.ClosestPoint(sphere.Center, &p).DistanceToSq(sphere.Center) <= sphere.Radius*sphere.Radius
+}
+
+// checks if this and triangle a, b, c intersect using the separating axis test
+func (this *OBB3) IntersectsTriangle(a, b, c *Vec3) bool {
+	var v [3]Vec3
+	var e [3]Vec3
+	var axis Vec3
+	h := this.HalfSize
+
+	this.toLocal(a, &v[0])
+	this.toLocal(b, &v[1])
+	this.toLocal(c, &v[2])
+
+	e[0].VSub(&v[1], &v[0])
+	e[1].VSub(&v[2], &v[1])
+	e[2].VSub(&v[0], &v[2])
+
+	separated := func(n *Vec3) bool {
+		if n.LengthSq() < Epsilon*Epsilon {
+			return false
+		}
+
+		p0, p1, p2 := v[0].Dot(n), v[1].Dot(n), v[2].Dot(n)
+		r := h[0]*Abs(n[0]) + h[1]*Abs(n[1]) + h[2]*Abs(n[2])
+		min, max := p0, p0
+
+		if p1 < min {
+			min = p1
+		}
+		if p1 > max {
+			max = p1
+		}
+		if p2 < min {
+			min = p2
+		}
+		if p2 > max {
+			max = p2
+		}
+
+		return min > r || max < -r
+	}
+
+	for i := 0; i < 3; i++ {
+		axis.Set(0, 0, 0)
+		axis[i] = 1
+
+		if separated(&axis) {
+			return false
+		}
+
+		for j := 0; j < 3; j++ {
+			var u Vec3
+
+			u[i] = 1
+			if separated(axis.VCross(&u, &e[j])) {
+				return false
+			}
+		}
+	}
+
+	return !separated(axis.VCross(&e[0], &e[1]))
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *OBB3) Equals(other *OBB3) bool {
+
+	return this.Center.Equals(other.Center) && this.HalfSize.Equals(other.HalfSize) && this.Rotation.Equals(other.Rotation)
+}
+
+// checks if this equals other within absolute tolerance tol
+func (this *OBB3) EqualsTol(other *OBB3, tol float32) bool {
+
+	return this.Center.EqualsTol(other.Center, tol) && this.HalfSize.EqualsTol(other.HalfSize, tol) && this.Rotation.EqualsTol(other.Rotation, tol)
+}
+
+// checks if this equals other within tol relative to the magnitude of its elements
+func (this *OBB3) EqualsRel(other *OBB3, tol float32) bool {
+
+	return this.Center.EqualsRel(other.Center, tol) && this.HalfSize.EqualsRel(other.HalfSize, tol) && this.Rotation.EqualsRel(other.Rotation, tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *OBB3) EqualsULP(other *OBB3, ulps int) bool {
+
+	return this.Center.EqualsULP(other.Center, ulps) && this.HalfSize.EqualsULP(other.HalfSize, ulps) && this.Rotation.EqualsULP(other.Rotation, ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *OBB3) MarshalText() ([]byte, error) {
+	e := this.elements()
+
+	return appendStructText(nil, obb3Text, e[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *OBB3) UnmarshalText(text []byte) error {
+	var e [15]float32
+
+	if err := parseStructText(text, obb3Text, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns this as a JSON array of Center, HalfSize and Rotation, implements json.Marshaler
+func (this *OBB3) MarshalJSON() ([]byte, error) {
+	e := this.elements()
+
+	return appendJSON(nil, e[:])
+}
+
+// parses a JSON array of Center, HalfSize and Rotation into this, implements json.Unmarshaler
+func (this *OBB3) UnmarshalJSON(data []byte) error {
+	var e [15]float32
+
+	if isJSONNull(data) {
+		return nil
+	}
+	if err := parseJSON(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Center, HalfSize and Rotation of this as little endian floats, implements encoding.BinaryMarshaler
+func (this *OBB3) MarshalBinary() ([]byte, error) {
+	e := this.elements()
+
+	return appendBinary(nil, e[:]), nil
+}
+
+// parses little endian floats of Center, HalfSize and Rotation into this, implements encoding.BinaryUnmarshaler
+func (this *OBB3) UnmarshalBinary(data []byte) error {
+	var e [15]float32
+
+	if err := parseBinary(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Center, HalfSize and Rotation of this as marshaling elements, nil fields are zero
+func (this *OBB3) elements() [15]float32 {
+	center, halfSize, rotation := valueOrZero(this.Center), valueOrZero(this.HalfSize), valueOrZero(this.Rotation)
+
+	return [15]float32{center[0], center[1], center[2], halfSize[0], halfSize[1], halfSize[2], rotation[0], rotation[1], rotation[2], rotation[3], rotation[4], rotation[5], rotation[6], rotation[7], rotation[8]}
+}
+
+// sets Center, HalfSize and Rotation of this from marshaling elements, nil fields are allocated
+func (this *OBB3) setElements(e *[15]float32) {
+	if this.Center == nil {
+		this.Center = new(Vec3)
+	}
+	if this.HalfSize == nil {
+		this.HalfSize = new(Vec3)
+	}
+	if this.Rotation == nil {
+		this.Rotation = new(Mat3)
+	}
+
+	copy(this.Center[:], e[0:3])
+	copy(this.HalfSize[:], e[3:6])
+	copy(this.Rotation[:], e[6:15])
+}
+
+// returns this as string type
+func (this *OBB3) String() string {
+
+	return fmt.Sprintf("OBB3[ Center: %s, HalfSize: %s, Rotation: %s ]", this.Center, this.HalfSize, this.Rotation)
+}
@@ -0,0 +1,196 @@
+package mathf
+
+import (
+	"math"
+	"testing"
+)
+
+// returns OBB3 with center and half size rotated by angle about axis
+func newRotatedOBB3(center, halfSize, axis *Vec3, angle float32) *OBB3 {
+
+	return NewOBB3(center, halfSize, NewMat3()).SetRotation(NewQuat().FromAxisAngle(axis.Clone().Normalize(), angle))
+}
+
+func TestOBB3SetRotation(t *testing.T) {
+	q := NewQuat().FromAxisAngle(NewVec3(1, 2, 3).Normalize(), 0.8)
+	box := NewOBB3(&Vec3{}, &Vec3{1, 1, 1}, NewMat3()).SetRotation(q)
+
+	for i := 0; i < 3; i++ {
+		var axis, want Vec3
+
+		want[i] = 1
+		want.ApplyQuat(q)
+
+		if box.Axis(i, &axis); !axis.EqualsTol(&want, 1e-6) {
+			t.Errorf("Axis(%d) = %v, want %v", i, axis, want)
+		}
+	}
+}
+
+func TestOBB3Intersects(t *testing.T) {
+	unit := Vec3{1, 1, 1}
+	identity := NewMat3()
+	z, y := Vec3{0, 0, 1}, Vec3{0, 1, 0}
+	rotated := newRotatedOBB3(&Vec3{0, 0, 0}, &unit, NewVec3(1, 2, 3), 0.7)
+	axis := rotated.Axis(0, new(Vec3))
+
+	// a is a cube turned 45 degrees about z and b one turned 45 degrees about y,
+	// their closest edges are along z and y so only the x = z cross y axis separates them
+	edgeA := newRotatedOBB3(&Vec3{0, 0, 0}, &unit, &z, Pi*0.25)
+	edgeB := func(gap float32) *OBB3 {
+		return newRotatedOBB3(&Vec3{2*math.Sqrt2 + gap, 0, 0}, &unit, &y, Pi*0.25)
+	}
+
+	tests := []struct {
+		name string
+		a, b *OBB3
+		want bool
+	}{
+		{"same", NewOBB3(&Vec3{}, &unit, identity), NewOBB3(&Vec3{}, &unit, identity), true},
+		{"overlapping", NewOBB3(&Vec3{}, &unit, identity), NewOBB3(&Vec3{1.5, 0.5, 0}, &unit, identity), true},
+		{"touching", NewOBB3(&Vec3{}, &unit, identity), NewOBB3(&Vec3{2, 0, 0}, &unit, identity), true},
+		{"separated x", NewOBB3(&Vec3{}, &unit, identity), NewOBB3(&Vec3{2.01, 0, 0}, &unit, identity), false},
+		{"separated z", NewOBB3(&Vec3{}, &unit, identity), NewOBB3(&Vec3{0, 0, -2.5}, &Vec3{1, 1, 0.4}, identity), false},
+		{"contained", NewOBB3(&Vec3{}, &Vec3{5, 5, 5}, identity), rotated, true},
+		{"rotated corner in", NewOBB3(&Vec3{}, &unit, identity), newRotatedOBB3(&Vec3{1 + math.Sqrt2 - 0.05, 0, 0}, &unit, &z, Pi*0.25), true},
+		{"rotated corner out", NewOBB3(&Vec3{}, &unit, identity), newRotatedOBB3(&Vec3{1 + math.Sqrt2 + 0.05, 0, 0}, &unit, &z, Pi*0.25), false},
+		{"parallel edges overlapping", rotated, NewOBB3(axis.Clone().SMul(1.999), &unit, rotated.Rotation), true},
+		{"parallel edges separated", rotated, NewOBB3(axis.Clone().SMul(2.01), &unit, rotated.Rotation), false},
+		{"edge cross separated", edgeA, edgeB(0.05), false},
+		{"edge cross touching", edgeA, edgeB(0), true},
+		{"edge cross overlapping", edgeA, edgeB(-0.05), true},
+	}
+
+	for _, test := range tests {
+		if got := test.a.Intersects(test.b); got != test.want {
+			t.Errorf("%s: %v.Intersects(%v) = %v, want %v", test.name, test.a, test.b, got, test.want)
+		}
+		if got := test.b.Intersects(test.a); got != test.want {
+			t.Errorf("%s: %v.Intersects(%v) = %v, want %v", test.name, test.b, test.a, got, test.want)
+		}
+	}
+}
+
+func TestOBB3IntersectsTriangle(t *testing.T) {
+	box := NewOBB3(&Vec3{}, &Vec3{1, 1, 1}, NewMat3())
+	rotated := newRotatedOBB3(&Vec3{1, 2, 3}, &Vec3{2, 1, 0.5}, NewVec3(0, 1, 1), 1)
+
+	tests := []struct {
+		name    string
+		box     *OBB3
+		a, b, c Vec3
+		want    bool
+	}{
+		{"inside", box, Vec3{-0.5, 0, 0}, Vec3{0.5, 0, 0}, Vec3{0, 0.5, 0}, true},
+		{"through face", box, Vec3{0, 0, -5}, Vec3{0, 0, 5}, Vec3{5, 0, 0}, true},
+		{"box inside large triangle", box, Vec3{-10, -10, 0.5}, Vec3{10, -10, 0.5}, Vec3{0, 10, 0.5}, true},
+		{"face axis", box, Vec3{2, 0, 0}, Vec3{3, 0, 0}, Vec3{2, 1, 0}, false},
+		{"triangle plane", box, Vec3{2, 0, 0}, Vec3{0, 2, 0}, Vec3{0, 0, 2}, true},
+		{"triangle plane separated", box, Vec3{3.1, 0, 0}, Vec3{0, 3.1, 0}, Vec3{0, 0, 3.1}, false},
+		// in the z = 0 plane only the cross of z with the edge from (2+d, 0) to (0, 2+d) separates
+		{"edge cross separated", box, Vec3{2.05, 0, 0}, Vec3{0, 2.05, 0}, Vec3{3, 3, 0}, false},
+		{"edge cross overlapping", box, Vec3{1.95, 0, 0}, Vec3{0, 1.95, 0}, Vec3{3, 3, 0}, true},
+		{"degenerate segment through", box, Vec3{-3, 0.5, 0.5}, Vec3{-3, 0.5, 0.5}, Vec3{3, 0.5, 0.5}, true},
+		{"degenerate segment outside", box, Vec3{-3, 1.5, 0.5}, Vec3{-3, 1.5, 0.5}, Vec3{3, 1.5, 0.5}, false},
+		{"rotated center", rotated, Vec3{1, 2, 3}, Vec3{1.1, 2, 3}, Vec3{1, 2.1, 3}, true},
+		{"rotated far", rotated, Vec3{10, 2, 3}, Vec3{11, 2, 3}, Vec3{10, 3, 3}, false},
+	}
+
+	for _, test := range tests {
+		if got := test.box.IntersectsTriangle(&test.a, &test.b, &test.c); got != test.want {
+			t.Errorf("%s: IntersectsTriangle(%v, %v, %v) = %v, want %v", test.name, test.a, test.b, test.c, got, test.want)
+		}
+	}
+}
+
+func TestOBB3FromPoints(t *testing.T) {
+	center, halfSize := Vec3{1, -2, 3}, Vec3{4, 2, 1}
+	q := NewQuat().FromAxisAngle(NewVec3(2, -1, 3).Normalize(), 0.9)
+	var points []*Vec3
+
+	// the corners and face centers of a known box have its axes as principal components
+	for _, p := range [][3]float32{
+		{-1, -1, -1}, {1, -1, -1}, {-1, 1, -1}, {1, 1, -1}, {-1, -1, 1}, {1, -1, 1}, {-1, 1, 1}, {1, 1, 1},
+		{1, 0, 0}, {-1, 0, 0}, {0, 1, 0}, {0, -1, 0}, {0, 0, 1}, {0, 0, -1},
+	} {
+		v := Vec3{p[0] * halfSize[0], p[1] * halfSize[1], p[2] * halfSize[2]}
+
+		points = append(points, v.ApplyQuat(q).Add(&center))
+	}
+
+	box := NewOBB3(&Vec3{}, &Vec3{}, NewMat3()).FromPoints(points)
+
+	if !box.Center.EqualsTol(&center, 1e-4) {
+		t.Errorf("FromPoints center = %v, want %v", box.Center, center)
+	}
+	if det := box.Rotation.Determinant(); !EqualsTol(det, 1, 1e-5) {
+		t.Errorf("FromPoints rotation determinant = %v, want 1", det)
+	}
+
+	// eigenvectors come back in any order and sign, so match each to the known axis along it
+	for i := 0; i < 3; i++ {
+		var axis Vec3
+
+		box.Axis(i, &axis)
+		found := false
+
+		for j := 0; j < 3; j++ {
+			var want Vec3
+
+			want[j] = 1
+			want.ApplyQuat(q)
+
+			if Abs(axis.Dot(&want)) > 1-1e-5 {
+				found = true
+
+				if !EqualsTol(box.HalfSize[i], halfSize[j], 1e-4) {
+					t.Errorf("FromPoints half size along axis %d = %v, want %v", j, box.HalfSize[i], halfSize[j])
+				}
+			}
+		}
+
+		if !found {
+			t.Errorf("FromPoints axis %v is not a principal axis", axis)
+		}
+	}
+
+	for _, p := range points {
+		if !box.Contains(p) && box.DistanceToPoint(p) > 1e-4 {
+			t.Errorf("FromPoints box does not contain %v", p)
+		}
+	}
+
+	if box.FromPoints(nil); !box.HalfSize.Equals(&Vec3{}) || !box.Rotation.Equals(NewMat3()) {
+		t.Errorf("FromPoints(nil) = %v, want empty identity box", box)
+	}
+}
+
+func TestOBB3ContainsClosestPoint(t *testing.T) {
+	box := newRotatedOBB3(&Vec3{1, 2, 3}, &Vec3{2, 1, 0.5}, &Vec3{0, 0, 1}, HALF_PI)
+
+	// after 90 degrees about z the box spans 1 in x, 2 in y and 0.5 in z around its center
+	tests := []struct {
+		p, closest Vec3
+		inside     bool
+	}{
+		{Vec3{1, 2, 3}, Vec3{1, 2, 3}, true},
+		{Vec3{1.9, 3.9, 3.4}, Vec3{1.9, 3.9, 3.4}, true},
+		{Vec3{1, 5, 3}, Vec3{1, 4, 3}, false},
+		{Vec3{3, 2, 3}, Vec3{2, 2, 3}, false},
+		{Vec3{5, 7, -1}, Vec3{2, 4, 2.5}, false},
+	}
+
+	for _, test := range tests {
+		var got Vec3
+
+		if inside := box.Contains(&test.p); inside != test.inside {
+			t.Errorf("Contains(%v) = %v, want %v", test.p, inside, test.inside)
+		}
+		if box.ClosestPoint(&test.p, &got); !got.EqualsTol(&test.closest, 1e-5) {
+			t.Errorf("ClosestPoint(%v) = %v, want %v", test.p, got, test.closest)
+		}
+		if d, want := box.DistanceToPoint(&test.p), test.p.DistanceTo(&test.closest); !EqualsTol(d, want, 1e-5) {
+			t.Errorf("DistanceToPoint(%v) = %v, want %v", test.p, d, want)
+		}
+	}
+}
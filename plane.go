@@ -0,0 +1,253 @@
+package mathf
+
+import (
+	"fmt"
+	"math"
+)
+
+// plane represented by a unit normal and a constant, points p on the plane satisfy Normal . p + Constant = 0
+type Plane struct {
+	Normal   *Vec3
+	Constant float32
+}
+
+// returns new Plane
+func NewPlane(normal *Vec3, constant float32) *Plane {
+	this := new(Plane)
+
+	this.Normal = new(Vec3).Copy(normal)
+	this.Constant = constant
+
+	return this
+}
+
+// returns a copy of this
+func (this *Plane) Clone() *Plane {
+
+	return NewPlane(this.Normal, this.Constant)
+}
+
+// copies other
+func (this *Plane) Copy(other *Plane) *Plane {
+
+	this.Normal.Copy(other.Normal)
+	this.Constant = other.Constant
+
+	return this
+}
+
+// sets this from values
+func (this *Plane) Set(normal *Vec3, constant float32) *Plane {
+
+	this.Normal.Copy(normal)
+	this.Constant = constant
+
+	return this
+}
+
+// sets this from components of the plane equation x, y, z and w
+func (this *Plane) SetComponents(x, y, z, w float32) *Plane {
+
+	this.Normal.Set(x, y, z)
+	this.Constant = w
+
+	return this
+}
+
+// sets this from normal and a point on the plane
+func (this *Plane) FromNormalAndCoplanarPoint(normal, v *Vec3) *Plane {
+
+	this.Normal.Copy(normal)
+	this.Constant = -v.Dot(normal)
+
+	return this
+}
+
+// sets this from three points on the plane, counter clockwise winding faces the normal
+func (this *Plane) FromCoplanarPoints(a, b, c *Vec3) *Plane {
+	var e1, e2 Vec3
+
+	e1.VSub(c, b)
+	e2.VSub(a, b)
+	this.Normal.VCross(&e1, &e2).Normalize()
+	this.Constant = -a.Dot(this.Normal)
+
+	return this
+}
+
+// normalizes normal and scales constant to match
+func (this *Plane) Normalize() *Plane {
+	l := this.Normal.LengthSq()
+
+	if l == 0 {
+		return this
+	}
+
+	l = 1 / float32(math.Sqrt(float64(l)))
+	this.Normal.SMul(l)
+	this.Constant *= l
+
+	return this
+}
+
+// flips the side this faces
+func (this *Plane) Negate() *Plane {
+
+	this.Normal.Inverse()
+	this.Constant *= -1
+
+	return this
+}
+
+// returns signed distance from this to point
+func (this *Plane) DistanceToPoint(v *Vec3) float32 {
+
+	return this.Normal.Dot(v) + this.Constant
+}
+
+// returns signed distance from this to the surface of sphere
+func (this *Plane) DistanceToSphere(sphere *Sphere) float32 {
+
+	return this.DistanceToPoint(sphere.Center) - sphere.Radius
+}
+
+// projects point onto this, saves in target
+func (this *Plane) ProjectPoint(v, target *Vec3) *Vec3 {
+	d := this.DistanceToPoint(v)
+	n := this.Normal
+
+	target[0] = v[0] - n[0]*d
+	target[1] = v[1] - n[1]*d
+	target[2] = v[2] - n[2]*d
+
+	return target
+}
+
+// returns the point on this closest to the origin, saves in target
+func (this *Plane) CoplanarPoint(target *Vec3) *Vec3 {
+
+	return target.Copy(this.Normal).SMul(-this.Constant)
+}
+
+// transforms this by Mat4, normal is transformed by the inverse transpose to handle non uniform scale
+func (this *Plane) ApplyMat4(m *Mat4) *Plane {
+	var normalMatrix Mat3
+	var v Vec3
+
+	this.CoplanarPoint(&v).ApplyMat4(m)
+	normalMatrix.Mat4Inverse(m).Transpose()
+	this.Normal.ApplyMat3(&normalMatrix).Normalize()
+	this.Constant = -v.Dot(this.Normal)
+
+	return this
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *Plane) Equals(other *Plane) bool {
+
+	return this.Normal.Equals(other.Normal) && this.Constant == other.Constant
+}
+
+// checks if this equals other within absolute tolerance tol
+func (this *Plane) EqualsTol(other *Plane, tol float32) bool {
+
+	return this.Normal.EqualsTol(other.Normal, tol) && EqualsTol(this.Constant, other.Constant, tol)
+}
+
+// checks if this equals other within tol relative to the magnitude of its elements
+func (this *Plane) EqualsRel(other *Plane, tol float32) bool {
+
+	return this.Normal.EqualsRel(other.Normal, tol) && EqualsRel(this.Constant, other.Constant, tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *Plane) EqualsULP(other *Plane, ulps int) bool {
+
+	return this.Normal.EqualsULP(other.Normal, ulps) && EqualsULP(this.Constant, other.Constant, ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *Plane) MarshalText() ([]byte, error) {
+	e := this.elements()
+
+	return appendStructText(nil, planeText, e[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *Plane) UnmarshalText(text []byte) error {
+	var e [4]float32
+
+	if err := parseStructText(text, planeText, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns this as a JSON array of Normal and Constant, implements json.Marshaler
+func (this *Plane) MarshalJSON() ([]byte, error) {
+	e := this.elements()
+
+	return appendJSON(nil, e[:])
+}
+
+// parses a JSON array of Normal and Constant into this, implements json.Unmarshaler
+func (this *Plane) UnmarshalJSON(data []byte) error {
+	var e [4]float32
+
+	if isJSONNull(data) {
+		return nil
+	}
+	if err := parseJSON(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Normal and Constant of this as little endian floats, implements encoding.BinaryMarshaler
+func (this *Plane) MarshalBinary() ([]byte, error) {
+	e := this.elements()
+
+	return appendBinary(nil, e[:]), nil
+}
+
+// parses little endian floats of Normal and Constant into this, implements encoding.BinaryUnmarshaler
+func (this *Plane) UnmarshalBinary(data []byte) error {
+	var e [4]float32
+
+	if err := parseBinary(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Normal and Constant of this as marshaling elements, nil fields are zero
+func (this *Plane) elements() [4]float32 {
+	normal := valueOrZero(this.Normal)
+
+	return [4]float32{normal[0], normal[1], normal[2], this.Constant}
+}
+
+// sets Normal and Constant of this from marshaling elements, nil fields are allocated
+func (this *Plane) setElements(e *[4]float32) {
+	if this.Normal == nil {
+		this.Normal = new(Vec3)
+	}
+
+	copy(this.Normal[:], e[0:3])
+	this.Constant = e[3]
+}
+
+// returns this as string type
+func (this *Plane) String() string {
+
+	return fmt.Sprintf("Plane[ Normal: %s, Constant: %f ]", this.Normal, this.Constant)
+}
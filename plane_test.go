@@ -0,0 +1,74 @@
+package mathf
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPlaneFrom(t *testing.T) {
+	tests := []struct {
+		name  string
+		plane *Plane
+		want  Plane
+	}{
+		{"normal and point", NewPlane(new(Vec3), 0).FromNormalAndCoplanarPoint(&Vec3{0, 1, 0}, &Vec3{3, 2, 1}), Plane{&Vec3{0, 1, 0}, -2}},
+		// counter clockwise seen from +z faces +z
+		{"coplanar points", NewPlane(new(Vec3), 0).FromCoplanarPoints(&Vec3{0, 0, 2}, &Vec3{1, 0, 2}, &Vec3{0, 1, 2}), Plane{&Vec3{0, 0, 1}, -2}},
+		{"clockwise points", NewPlane(new(Vec3), 0).FromCoplanarPoints(&Vec3{0, 0, 2}, &Vec3{0, 1, 2}, &Vec3{1, 0, 2}), Plane{&Vec3{0, 0, -1}, 2}},
+		{"components", NewPlane(new(Vec3), 0).SetComponents(0, 0, 2, -4).Normalize(), Plane{&Vec3{0, 0, 1}, -2}},
+		{"zero normal", NewPlane(new(Vec3), 3).Normalize(), Plane{&Vec3{}, 3}},
+		{"negate", NewPlane(&Vec3{1, 0, 0}, -2).Negate(), Plane{&Vec3{-1, 0, 0}, 2}},
+	}
+
+	for _, test := range tests {
+		if !test.plane.EqualsTol(&test.want, 1e-6) {
+			t.Errorf("%s = %v, want %v", test.name, test.plane, &test.want)
+		}
+	}
+}
+
+func TestPlaneDistance(t *testing.T) {
+	plane := NewPlane(&Vec3{0, 1, 0}, -2)
+	var v Vec3
+
+	if d := plane.DistanceToPoint(&Vec3{1, 5, 3}); d != 3 {
+		t.Errorf("DistanceToPoint() = %v, want 3", d)
+	}
+	if d := plane.DistanceToPoint(&Vec3{1, -1, 3}); d != -3 {
+		t.Errorf("DistanceToPoint() below = %v, want -3", d)
+	}
+	if d := plane.DistanceToSphere(NewSphere(&Vec3{1, 5, 3}, 1)); d != 2 {
+		t.Errorf("DistanceToSphere() = %v, want 2", d)
+	}
+	if plane.ProjectPoint(&Vec3{1, 5, 3}, &v); !v.Equals(&Vec3{1, 2, 3}) {
+		t.Errorf("ProjectPoint() = %v, want [1 2 3]", v)
+	}
+	if plane.CoplanarPoint(&v); !v.Equals(&Vec3{0, 2, 0}) {
+		t.Errorf("CoplanarPoint() = %v, want [0 2 0]", v)
+	}
+}
+
+func TestPlaneApplyMat4(t *testing.T) {
+	// y = 2 turned 90 degrees about z becomes x = -2, then moves with the translation
+	m := NewMat4().Compose(&Vec3{0, 3, 0}, &Vec3{1, 1, 1}, NewQuat().FromAxisAngle(&Vec3{0, 0, 1}, HALF_PI))
+	want := Plane{&Vec3{-1, 0, 0}, -2}
+
+	if got := NewPlane(&Vec3{0, 1, 0}, -2).ApplyMat4(m); !got.EqualsTol(&want, 1e-6) {
+		t.Errorf("ApplyMat4() = %v, want %v", got, &want)
+	}
+
+	// non uniform scale keeps transformed points on the plane
+	m.Compose(&Vec3{1, -2, 3}, &Vec3{2, 1, 0.5}, NewQuat().FromAxisAngle(NewVec3(1, 2, 3).Normalize(), 0.6))
+	plane := NewPlane(&Vec3{1 / math.Sqrt2, 1 / math.Sqrt2, 0}, -1)
+	points := []Vec3{{1 / math.Sqrt2, 1 / math.Sqrt2, 0}, {2, math.Sqrt2 - 2, 5}, {-3, 3 + math.Sqrt2, -1}}
+	transformed := plane.Clone().ApplyMat4(m)
+
+	for _, p := range points {
+		if d := plane.DistanceToPoint(&p); !EqualsTol(d, 0, 1e-5) {
+			t.Fatalf("test point %v is %v from the plane", p, d)
+		}
+		if d := transformed.DistanceToPoint(p.ApplyMat4(m)); !EqualsTol(d, 0, 1e-5) {
+			t.Errorf("ApplyMat4() = %v is %v from transformed point %v", transformed, d, p)
+		}
+	}
+}
@@ -5,8 +5,14 @@ import (
 	"math"
 )
 
-// float32 Array representing a Quaternion
-type Quat [4]float32
+// Array representing a Quaternion of float32 or float64
+type QuatT[T Float] [4]T
+
+// float32 Quat
+type Quat = QuatT[float32]
+
+// float64 Quat
+type Quatd = QuatT[float64]
 
 // returns new Quat
 func NewQuat() *Quat {
@@ -17,14 +23,23 @@ func NewQuat() *Quat {
 	return this
 }
 
+// returns new Quatd
+func NewQuatd() *Quatd {
+	this := new(Quatd)
+
+	this[0], this[1], this[2], this[3] = 0, 0, 0, 1
+
+	return this
+}
+
 // returns a copy of this
-func (this *Quat) Clone() *Quat {
+func (this *QuatT[T]) Clone() *QuatT[T] {
 
-	return new(Quat).Copy(this)
+	return new(QuatT[T]).Copy(this)
 }
 
 // copies other
-func (this *Quat) Copy(other *Quat) *Quat {
+func (this *QuatT[T]) Copy(other *QuatT[T]) *QuatT[T] {
 
 	this[0] = other[0]
 	this[1] = other[1]
@@ -35,7 +50,7 @@ func (this *Quat) Copy(other *Quat) *Quat {
 }
 
 // sets this from values
-func (this *Quat) Set(x, y, z, w float32) *Quat {
+func (this *QuatT[T]) Set(x, y, z, w T) *QuatT[T] {
 
 	this[0] = x
 	this[1] = y
@@ -46,7 +61,7 @@ func (this *Quat) Set(x, y, z, w float32) *Quat {
 }
 
 // mutiples this by other
-func (this *Quat) Mul(other *Quat) *Quat {
+func (this *QuatT[T]) Mul(other *QuatT[T]) *QuatT[T] {
 	ax, ay, az, aw := this[0], this[1], this[2], this[3]
 	bx, by, bz, bw := other[0], other[1], other[2], other[3]
 
@@ -59,7 +74,7 @@ func (this *Quat) Mul(other *Quat) *Quat {
 }
 
 // mutiples a and b saves in this
-func (this *Quat) QMul(a, b *Quat) *Quat {
+func (this *QuatT[T]) QMul(a, b *QuatT[T]) *QuatT[T] {
 	ax, ay, az, aw := a[0], a[1], a[2], a[3]
 	bx, by, bz, bw := b[0], b[1], b[2], b[3]
 
@@ -72,7 +87,7 @@ func (this *Quat) QMul(a, b *Quat) *Quat {
 }
 
 // mutiples this by scalar
-func (this *Quat) SMul(s float32) *Quat {
+func (this *QuatT[T]) SMul(s T) *QuatT[T] {
 
 	this[0] *= s
 	this[1] *= s
@@ -83,7 +98,7 @@ func (this *Quat) SMul(s float32) *Quat {
 }
 
 // divides this by other
-func (this *Quat) Div(other *Quat) *Quat {
+func (this *QuatT[T]) Div(other *QuatT[T]) *QuatT[T] {
 	ax, ay, az, aw := this[0], this[1], this[2], this[3]
 	bx, by, bz, bw := -other[0], -other[1], -other[2], -other[3]
 
@@ -96,7 +111,7 @@ func (this *Quat) Div(other *Quat) *Quat {
 }
 
 // divides a and b saves in this
-func (this *Quat) QDiv(a, b *Quat) *Quat {
+func (this *QuatT[T]) QDiv(a, b *QuatT[T]) *QuatT[T] {
 	ax, ay, az, aw := a[0], a[1], a[2], a[3]
 	bx, by, bz, bw := -b[0], -b[1], -b[2], -b[3]
 
@@ -109,7 +124,7 @@ func (this *Quat) QDiv(a, b *Quat) *Quat {
 }
 
 // divides this by scalar
-func (this *Quat) SDiv(s float32) *Quat {
+func (this *QuatT[T]) SDiv(s T) *QuatT[T] {
 	if s != 0 {
 		s = 1 / s
 	}
@@ -123,14 +138,14 @@ func (this *Quat) SDiv(s float32) *Quat {
 }
 
 // normalize vector, makes length of 1
-func (this *Quat) Normalize() *Quat {
+func (this *QuatT[T]) Normalize() *QuatT[T] {
 	l := this[0]*this[0] + this[1]*this[1] + this[2]*this[2] + this[3]*this[3]
 
 	if l == 0 {
 		return this
 	}
 
-	l = 1 / float32(math.Sqrt(float64(l)))
+	l = 1 / T(math.Sqrt(float64(l)))
 	this[0] *= l
 	this[1] *= l
 	this[2] *= l
@@ -140,41 +155,41 @@ func (this *Quat) Normalize() *Quat {
 }
 
 // returns length of this
-func (this *Quat) Length() float32 {
+func (this *QuatT[T]) Length() T {
 	l := this[0]*this[0] + this[1]*this[1] + this[2]*this[2] + this[3]*this[3]
 
 	if l == 0 {
 		return 0
 	}
 
-	return float32(math.Sqrt(float64(l)))
+	return T(math.Sqrt(float64(l)))
 }
 
 // returns length squared of this
-func (this *Quat) LengthSq() float32 {
+func (this *QuatT[T]) LengthSq() T {
 
 	return this[0]*this[0] + this[1]*this[1] + this[2]*this[2] + this[3]*this[3]
 }
 
 // returns dot product of this and other
-func (this *Quat) Dot(other *Quat) float32 {
+func (this *QuatT[T]) Dot(other *QuatT[T]) T {
 
 	return this[0]*other[0] + this[1]*other[1] + this[2]*other[2] + this[3]*other[3]
 }
 
 // returns dot product of a and b
-func (this *Quat) QDot(a, b *Quat) float32 {
+func (this *QuatT[T]) QDot(a, b *QuatT[T]) T {
 
 	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2] + a[3]*b[3]
 }
 
 // returns inverse of this
-func (this *Quat) Inverse() *Quat {
+func (this *QuatT[T]) Inverse() *QuatT[T] {
 	d := this[0]*this[0] + this[1]*this[1] + this[2]*this[2] + this[3]*this[3]
 	if d == 0 {
 		return this.Identity()
 	}
-	d = 1 / float32(math.Sqrt(float64(d)))
+	d = 1 / T(math.Sqrt(float64(d)))
 
 	this[0] *= -d
 	this[1] *= -d
@@ -185,13 +200,13 @@ func (this *Quat) Inverse() *Quat {
 }
 
 // saves inverse of other in this
-func (this *Quat) QInverse(other *Quat) *Quat {
+func (this *QuatT[T]) QInverse(other *QuatT[T]) *QuatT[T] {
 	x, y, z, w := other[0], other[1], other[2], other[3]
 	d := x*x + y*y + z*z + w*w
 	if d == 0 {
 		return this.Identity()
 	}
-	d = 1 / float32(math.Sqrt(float64(d)))
+	d = 1 / T(math.Sqrt(float64(d)))
 
 	this[0] = x * -d
 	this[1] = y * -d
@@ -202,7 +217,7 @@ func (this *Quat) QInverse(other *Quat) *Quat {
 }
 
 // if this is normalized this is faster than Inverse
-func (this *Quat) Conjugate() *Quat {
+func (this *QuatT[T]) Conjugate() *QuatT[T] {
 
 	this[0] *= -1
 	this[1] *= -1
@@ -212,7 +227,7 @@ func (this *Quat) Conjugate() *Quat {
 }
 
 // returns this as identity
-func (this *Quat) Identity() *Quat {
+func (this *QuatT[T]) Identity() *QuatT[T] {
 
 	this[0] = 0
 	this[1] = 0
@@ -223,7 +238,7 @@ func (this *Quat) Identity() *Quat {
 }
 
 // lerps this and other by x
-func (this *Quat) Lerp(other *Quat, x float32) *Quat {
+func (this *QuatT[T]) Lerp(other *QuatT[T], x T) *QuatT[T] {
 
 	this[0] += (other[0] - this[0]) * x
 	this[1] += (other[1] - this[1]) * x
@@ -234,7 +249,7 @@ func (this *Quat) Lerp(other *Quat, x float32) *Quat {
 }
 
 // lerps a and b by x, saves in this
-func (this *Quat) QLerp(a, b *Quat, x float32) *Quat {
+func (this *QuatT[T]) QLerp(a, b *QuatT[T], x T) *QuatT[T] {
 
 	this[0] = a[0] + (b[0]-a[0])*x
 	this[1] = a[1] + (b[1]-a[1])*x
@@ -245,7 +260,7 @@ func (this *Quat) QLerp(a, b *Quat, x float32) *Quat {
 }
 
 // lerps this and other by x and normalizes
-func (this *Quat) Nlerp(other *Quat, x float32) *Quat {
+func (this *QuatT[T]) Nlerp(other *QuatT[T], x T) *QuatT[T] {
 
 	this[0] += (other[0] - this[0]) * x
 	this[1] += (other[1] - this[1]) * x
@@ -256,7 +271,7 @@ func (this *Quat) Nlerp(other *Quat, x float32) *Quat {
 }
 
 // lerps a and b by x, saves in this
-func (this *Quat) QNlerp(a, b *Quat, x float32) *Quat {
+func (this *QuatT[T]) QNlerp(a, b *QuatT[T], x T) *QuatT[T] {
 
 	this[0] = a[0] + (b[0]-a[0])*x
 	this[1] = a[1] + (b[1]-a[1])*x
@@ -266,12 +281,12 @@ func (this *Quat) QNlerp(a, b *Quat, x float32) *Quat {
 	return this.Normalize()
 }
 
-func (this *Quat) Slerp(other *Quat, x float32) *Quat {
+func (this *QuatT[T]) Slerp(other *QuatT[T], x T) *QuatT[T] {
 	ax, ay, az, aw := this[0], this[1], this[2], this[3]
 	bx, by, bz, bw := other[0], other[1], other[2], other[3]
 
 	cosom := ax*bx + ay*by + az*bz + aw*bw
-	var omega, sinom, scale0, scale1 float32
+	var omega, sinom, scale0, scale1 T
 
 	if cosom < 0 {
 		cosom *= -1
@@ -282,10 +297,10 @@ func (this *Quat) Slerp(other *Quat, x float32) *Quat {
 	}
 
 	if 1-cosom > 0 {
-		omega = float32(math.Acos(float64(cosom)))
-		sinom = 1 / float32(math.Sin(float64(omega)))
-		scale0 = float32(math.Sin(float64((1-x)*omega))) * sinom
-		scale1 = float32(math.Sin(float64(x*omega))) * sinom
+		omega = T(math.Acos(float64(cosom)))
+		sinom = 1 / T(math.Sin(float64(omega)))
+		scale0 = T(math.Sin(float64((1-x)*omega))) * sinom
+		scale1 = T(math.Sin(float64(x*omega))) * sinom
 	} else {
 		scale0 = 1 - x
 		scale1 = x
@@ -299,12 +314,12 @@ func (this *Quat) Slerp(other *Quat, x float32) *Quat {
 	return this
 }
 
-func (this *Quat) QSlerp(a, b *Quat, x float32) *Quat {
+func (this *QuatT[T]) QSlerp(a, b *QuatT[T], x T) *QuatT[T] {
 	ax, ay, az, aw := a[0], a[1], a[2], a[3]
 	bx, by, bz, bw := b[0], b[1], b[2], b[3]
 
 	cosom := ax*bx + ay*by + az*bz + aw*bw
-	var omega, sinom, scale0, scale1 float32
+	var omega, sinom, scale0, scale1 T
 
 	if cosom < 0 {
 		cosom *= -1
@@ -315,10 +330,10 @@ func (this *Quat) QSlerp(a, b *Quat, x float32) *Quat {
 	}
 
 	if 1-cosom > 0 {
-		omega = float32(math.Acos(float64(cosom)))
-		sinom = 1 / float32(math.Sin(float64(omega)))
-		scale0 = float32(math.Sin(float64((1-x)*omega))) * sinom
-		scale1 = float32(math.Sin(float64(x*omega))) * sinom
+		omega = T(math.Acos(float64(cosom)))
+		sinom = 1 / T(math.Sin(float64(omega)))
+		scale0 = T(math.Sin(float64((1-x)*omega))) * sinom
+		scale1 = T(math.Sin(float64(x*omega))) * sinom
 	} else {
 		scale0 = 1 - x
 		scale1 = x
@@ -332,10 +347,100 @@ func (this *Quat) QSlerp(a, b *Quat, x float32) *Quat {
 	return this
 }
 
-func (this *Quat) RotateX(angle float32) *Quat {
+// sets this to its natural logarithm
+func (this *QuatT[T]) Log() *QuatT[T] {
+	x, y, z, w := this[0], this[1], this[2], this[3]
+	v := T(math.Sqrt(float64(x*x + y*y + z*z)))
+	l := T(math.Sqrt(float64(v*v + w*w)))
+
+	if l == 0 {
+		return this.Set(0, 0, 0, 0)
+	}
+
+	s := T(0)
+	if v > T(Epsilon) {
+		s = T(math.Atan2(float64(v), float64(w))) / v
+	}
+
+	this[0] = x * s
+	this[1] = y * s
+	this[2] = z * s
+	this[3] = T(math.Log(float64(l)))
+
+	return this
+}
+
+// sets this to its exponential
+func (this *QuatT[T]) Exp() *QuatT[T] {
+	x, y, z, w := this[0], this[1], this[2], this[3]
+	v := T(math.Sqrt(float64(x*x + y*y + z*z)))
+	e := T(math.Exp(float64(w)))
+
+	s := e
+	if v > T(Epsilon) {
+		s = e * T(math.Sin(float64(v))) / v
+	}
+
+	this[0] = x * s
+	this[1] = y * s
+	this[2] = z * s
+	this[3] = e * T(math.Cos(float64(v)))
+
+	return this
+}
+
+// raises this to the power of x, this should be normalized
+func (this *QuatT[T]) Pow(x T) *QuatT[T] {
+
+	return this.Log().SMul(x).Exp()
+}
+
+// spherical quadrangle interpolation from this to b by x with inner control points sa and sb
+func (this *QuatT[T]) Squad(sa, sb, b *QuatT[T], x T) *QuatT[T] {
+
+	return this.QSquad(this, sa, sb, b, x)
+}
+
+// spherical quadrangle interpolation from a to b by x with inner control points sa and sb, saves in this
+func (this *QuatT[T]) QSquad(a, sa, sb, b *QuatT[T], x T) *QuatT[T] {
+	var q, s QuatT[T]
+
+	q.QSlerp(a, b, x)
+	s.QSlerp(sa, sb, x)
+
+	return this.QSlerp(&q, &s, 2*x*(1-x))
+}
+
+// sets this to the Squad inner control point of key q between keys prev and next
+func (this *QuatT[T]) SquadControl(prev, q, next *QuatT[T]) *QuatT[T] {
+	var inv, a, b QuatT[T]
+
+	inv.QInverse(q)
+	a.QMul(&inv, next)
+	b.QMul(&inv, prev)
+
+	if a[3] < 0 {
+		a.SMul(-1)
+	}
+	if b[3] < 0 {
+		b.SMul(-1)
+	}
+
+	a.Log()
+	b.Log()
+
+	a[0] = (a[0] + b[0]) * -0.25
+	a[1] = (a[1] + b[1]) * -0.25
+	a[2] = (a[2] + b[2]) * -0.25
+	a[3] = (a[3] + b[3]) * -0.25
+
+	return this.QMul(q, a.Exp())
+}
+
+func (this *QuatT[T]) RotateX(angle T) *QuatT[T] {
 	halfAngle := angle * 0.5
 	x, y, z, w := this[0], this[1], this[2], this[3]
-	s, c := float32(math.Sin(float64(halfAngle))), float32(math.Cos(float64(halfAngle)))
+	s, c := T(math.Sin(float64(halfAngle))), T(math.Cos(float64(halfAngle)))
 
 	this[0] = x*c + w*s
 	this[1] = y*c + z*s
@@ -345,10 +450,10 @@ func (this *Quat) RotateX(angle float32) *Quat {
 	return this
 }
 
-func (this *Quat) RotateY(angle float32) *Quat {
+func (this *QuatT[T]) RotateY(angle T) *QuatT[T] {
 	halfAngle := angle * 0.5
 	x, y, z, w := this[0], this[1], this[2], this[3]
-	s, c := float32(math.Sin(float64(halfAngle))), float32(math.Cos(float64(halfAngle)))
+	s, c := T(math.Sin(float64(halfAngle))), T(math.Cos(float64(halfAngle)))
 
 	this[0] = x*c - z*s
 	this[1] = y*c + w*s
@@ -358,10 +463,10 @@ func (this *Quat) RotateY(angle float32) *Quat {
 	return this
 }
 
-func (this *Quat) RotateZ(angle float32) *Quat {
+func (this *QuatT[T]) RotateZ(angle T) *QuatT[T] {
 	halfAngle := angle * 0.5
 	x, y, z, w := this[0], this[1], this[2], this[3]
-	s, c := float32(math.Sin(float64(halfAngle))), float32(math.Cos(float64(halfAngle)))
+	s, c := T(math.Sin(float64(halfAngle))), T(math.Cos(float64(halfAngle)))
 
 	this[0] = x*c + y*s
 	this[1] = y*c - x*s
@@ -371,7 +476,8 @@ func (this *Quat) RotateZ(angle float32) *Quat {
 	return this
 }
 
-func (this *Quat) Rotate(x, y, z float32) *Quat {
+// rotates this by angles around z, x then y, matches EULER_ZXY, see FromEuler for other orders
+func (this *QuatT[T]) Rotate(x, y, z T) *QuatT[T] {
 
 	this.RotateZ(z)
 	this.RotateX(x)
@@ -381,28 +487,175 @@ func (this *Quat) Rotate(x, y, z float32) *Quat {
 }
 
 // sets values from axis and angle
-func (this *Quat) FromAxisAngle(axis *Vec3, angle float32) *Quat {
+func (this *QuatT[T]) FromAxisAngle(axis *Vec3T[T], angle T) *QuatT[T] {
 	halfAngle := angle * 0.5
-	s := float32(math.Sin(float64(halfAngle)))
+	s := T(math.Sin(float64(halfAngle)))
 
 	this[0] = axis[0] * s
 	this[1] = axis[1] * s
 	this[2] = axis[2] * s
-	this[3] = float32(math.Cos(float64(halfAngle)))
+	this[3] = T(math.Cos(float64(halfAngle)))
+
+	return this
+}
+
+// returns angle in radians and saves the rotation axis in axis, this should be normalized
+func (this *QuatT[T]) ToAxisAngle(axis *Vec3T[T]) T {
+	w := clamp(this[3], -1, 1)
+	s := T(math.Sqrt(float64(1 - w*w)))
+
+	if s < T(Epsilon) {
+		axis.Set(1, 0, 0)
+	} else {
+		s = 1 / s
+		axis.Set(this[0]*s, this[1]*s, this[2]*s)
+	}
+
+	return 2 * T(math.Acos(float64(w)))
+}
+
+// sets this to the shortest rotation from unit vector a to unit vector b
+func (this *QuatT[T]) FromUnitVectors(a, b *Vec3T[T]) *QuatT[T] {
+	r := a.Dot(b) + 1
+
+	if r < T(Epsilon) {
+		if abs(a[0]) > abs(a[2]) {
+			this.Set(-a[1], a[0], 0, 0)
+		} else {
+			this.Set(0, -a[2], a[1], 0)
+		}
+	} else {
+		this.Set(
+			a[1]*b[2]-a[2]*b[1],
+			a[2]*b[0]-a[0]*b[2],
+			a[0]*b[1]-a[1]*b[0],
+			r,
+		)
+	}
+
+	return this.Normalize()
+}
+
+// sets this to the rotation that points the z axis along forward with the y axis towards up
+func (this *QuatT[T]) LookRotation(forward, up *Vec3T[T]) *QuatT[T] {
+	var x, y, z Vec3T[T]
+	var m Mat3T[T]
+
+	z.Copy(forward).Normalize()
+	x.VCross(up, &z)
+
+	if x.LengthSq() < T(Epsilon) {
+		if abs(z[1]) < 0.9 {
+			x.VCross(y.Set(0, 1, 0), &z)
+		} else {
+			x.VCross(y.Set(0, 0, 1), &z)
+		}
+	}
+
+	x.Normalize()
+	y.VCross(&z, &x)
+
+	m[0], m[3], m[6] = x[0], y[0], z[0]
+	m[1], m[4], m[7] = x[1], y[1], z[1]
+	m[2], m[5], m[8] = x[2], y[2], z[2]
+
+	return this.FromMat3(&m).Normalize()
+}
+
+// returns the angle in radians between this and other
+func (this *QuatT[T]) Angle(other *QuatT[T]) T {
+	d := abs(this.Dot(other))
+
+	if d >= 1 {
+		return 0
+	}
+
+	return 2 * T(math.Acos(float64(d)))
+}
+
+// rotates this towards other by at most maxDelta radians
+func (this *QuatT[T]) RotateTowards(other *QuatT[T], maxDelta T) *QuatT[T] {
+	angle := this.Angle(other)
+
+	if angle == 0 {
+		return this
+	}
+
+	return this.Slerp(other, clamp01(maxDelta/angle))
+}
+
+// decomposes this into a swing perpendicular to unit axis and a twist around axis, this = swing * twist
+func (this *QuatT[T]) SwingTwist(axis *Vec3T[T], swing, twist *QuatT[T]) *QuatT[T] {
+	d := this[0]*axis[0] + this[1]*axis[1] + this[2]*axis[2]
+
+	twist.Set(axis[0]*d, axis[1]*d, axis[2]*d, this[3])
+
+	if twist.LengthSq() < T(Epsilon) {
+		twist.Identity()
+	} else {
+		twist.Normalize()
+	}
+
+	swing[0], swing[1], swing[2], swing[3] = -twist[0], -twist[1], -twist[2], twist[3]
+	swing.QMul(this, swing)
+
+	return this
+}
+
+// sets values from Euler angles using its rotation order
+func (this *QuatT[T]) FromEuler(e *Euler) *QuatT[T] {
+	c1, s1 := T(math.Cos(float64(e.X*0.5))), T(math.Sin(float64(e.X*0.5)))
+	c2, s2 := T(math.Cos(float64(e.Y*0.5))), T(math.Sin(float64(e.Y*0.5)))
+	c3, s3 := T(math.Cos(float64(e.Z*0.5))), T(math.Sin(float64(e.Z*0.5)))
+
+	switch e.Order {
+	case EULER_XYZ:
+		this[0] = s1*c2*c3 + c1*s2*s3
+		this[1] = c1*s2*c3 - s1*c2*s3
+		this[2] = c1*c2*s3 + s1*s2*c3
+		this[3] = c1*c2*c3 - s1*s2*s3
+	case EULER_YXZ:
+		this[0] = s1*c2*c3 + c1*s2*s3
+		this[1] = c1*s2*c3 - s1*c2*s3
+		this[2] = c1*c2*s3 - s1*s2*c3
+		this[3] = c1*c2*c3 + s1*s2*s3
+	case EULER_ZXY:
+		this[0] = s1*c2*c3 - c1*s2*s3
+		this[1] = c1*s2*c3 + s1*c2*s3
+		this[2] = c1*c2*s3 + s1*s2*c3
+		this[3] = c1*c2*c3 - s1*s2*s3
+	case EULER_ZYX:
+		this[0] = s1*c2*c3 - c1*s2*s3
+		this[1] = c1*s2*c3 + s1*c2*s3
+		this[2] = c1*c2*s3 - s1*s2*c3
+		this[3] = c1*c2*c3 + s1*s2*s3
+	case EULER_YZX:
+		this[0] = s1*c2*c3 + c1*s2*s3
+		this[1] = c1*s2*c3 + s1*c2*s3
+		this[2] = c1*c2*s3 - s1*s2*c3
+		this[3] = c1*c2*c3 - s1*s2*s3
+	case EULER_XZY:
+		this[0] = s1*c2*c3 - c1*s2*s3
+		this[1] = c1*s2*c3 - s1*c2*s3
+		this[2] = c1*c2*s3 + s1*s2*c3
+		this[3] = c1*c2*c3 + s1*s2*s3
+	default:
+		this.Identity()
+	}
 
 	return this
 }
 
 // sets values from Mat3
-func (this *Quat) FromMat3(m *Mat3) *Quat {
+func (this *QuatT[T]) FromMat3(m *Mat3T[T]) *QuatT[T] {
 	m11, m12, m13 := m[0], m[3], m[6]
 	m21, m22, m23 := m[1], m[4], m[7]
 	m31, m32, m33 := m[2], m[5], m[8]
 	trace := m11 + m22 + m33
-	var s, invS float32
+	var s, invS T
 
 	if trace > 0 {
-		s = 0.5 / float32(math.Sqrt(float64(trace+1)))
+		s = 0.5 / T(math.Sqrt(float64(trace+1)))
 
 		this[3] = 0.25 / s
 		this[0] = (m32 - m23) * s
@@ -410,7 +663,7 @@ func (this *Quat) FromMat3(m *Mat3) *Quat {
 		this[2] = (m21 - m12) * s
 
 	} else if m11 > m22 && m11 > m33 {
-		s = 2 * float32(math.Sqrt(float64(1+m11-m22-m33)))
+		s = 2 * T(math.Sqrt(float64(1+m11-m22-m33)))
 		invS = 1 / s
 
 		this[3] = (m32 - m23) * invS
@@ -419,7 +672,7 @@ func (this *Quat) FromMat3(m *Mat3) *Quat {
 		this[2] = (m13 + m31) * invS
 
 	} else if m22 > m33 {
-		s = 2 * float32(math.Sqrt(float64(1+m22-m11-m33)))
+		s = 2 * T(math.Sqrt(float64(1+m22-m11-m33)))
 		invS = 1 / s
 
 		this[3] = (m13 - m31) * invS
@@ -428,7 +681,7 @@ func (this *Quat) FromMat3(m *Mat3) *Quat {
 		this[2] = (m23 + m32) * invS
 
 	} else {
-		s = 2 * float32(math.Sqrt(float64(1+m33-m11-m22)))
+		s = 2 * T(math.Sqrt(float64(1+m33-m11-m22)))
 		invS = 1 / s
 
 		this[3] = (m21 - m12) * invS
@@ -441,15 +694,15 @@ func (this *Quat) FromMat3(m *Mat3) *Quat {
 }
 
 // sets values from Mat4
-func (this *Quat) FromMat4(m *Mat4) *Quat {
+func (this *QuatT[T]) FromMat4(m *Mat4T[T]) *QuatT[T] {
 	m11, m12, m13 := m[0], m[4], m[8]
 	m21, m22, m23 := m[1], m[5], m[9]
 	m31, m32, m33 := m[2], m[6], m[10]
 	trace := m11 + m22 + m33
-	var s, invS float32
+	var s, invS T
 
 	if trace > 0 {
-		s = 0.5 / float32(math.Sqrt(float64(trace+1)))
+		s = 0.5 / T(math.Sqrt(float64(trace+1)))
 
 		this[3] = 0.25 / s
 		this[0] = (m32 - m23) * s
@@ -457,7 +710,7 @@ func (this *Quat) FromMat4(m *Mat4) *Quat {
 		this[2] = (m21 - m12) * s
 
 	} else if m11 > m22 && m11 > m33 {
-		s = 2 * float32(math.Sqrt(float64(1+m11-m22-m33)))
+		s = 2 * T(math.Sqrt(float64(1+m11-m22-m33)))
 		invS = 1 / s
 
 		this[3] = (m32 - m23) * invS
@@ -466,7 +719,7 @@ func (this *Quat) FromMat4(m *Mat4) *Quat {
 		this[2] = (m13 + m31) * invS
 
 	} else if m22 > m33 {
-		s = 2 * float32(math.Sqrt(float64(1+m22-m11-m33)))
+		s = 2 * T(math.Sqrt(float64(1+m22-m11-m33)))
 		invS = 1 / s
 
 		this[3] = (m13 - m31) * invS
@@ -475,7 +728,7 @@ func (this *Quat) FromMat4(m *Mat4) *Quat {
 		this[2] = (m23 + m32) * invS
 
 	} else {
-		s = 2 * float32(math.Sqrt(float64(1+m33-m11-m22)))
+		s = 2 * T(math.Sqrt(float64(1+m33-m11-m22)))
 		invS = 1 / s
 
 		this[3] = (m21 - m12) * invS
@@ -487,8 +740,64 @@ func (this *Quat) FromMat4(m *Mat4) *Quat {
 	return this
 }
 
-// checks if this equals other
-func (this *Quat) Equals(other *Quat) bool {
+// returns product of this and other by value
+func (this QuatT[T]) Times(other QuatT[T]) QuatT[T] {
+
+	return *this.Mul(&other)
+}
+
+// returns this normalized by value
+func (this QuatT[T]) Normalized() QuatT[T] {
+
+	return *this.Normalize()
+}
+
+// returns inverse of this by value
+func (this QuatT[T]) Inverted() QuatT[T] {
+
+	return *this.Inverse()
+}
+
+// returns conjugate of this by value
+func (this QuatT[T]) Conjugated() QuatT[T] {
+
+	return *this.Conjugate()
+}
+
+// returns lerp of this and other by x normalized by value
+func (this QuatT[T]) Nlerped(other QuatT[T], x T) QuatT[T] {
+
+	return *this.Nlerp(&other, x)
+}
+
+// returns slerp of this and other by x by value
+func (this QuatT[T]) Slerped(other QuatT[T], x T) QuatT[T] {
+
+	return *this.Slerp(&other, x)
+}
+
+// converts this to float32, saves in target
+func (this *QuatT[T]) Float32(target *Quat) *Quat {
+
+	for i, v := range this {
+		target[i] = float32(v)
+	}
+
+	return target
+}
+
+// converts this to float64, saves in target
+func (this *QuatT[T]) Float64(target *Quatd) *Quatd {
+
+	for i, v := range this {
+		target[i] = float64(v)
+	}
+
+	return target
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *QuatT[T]) Equals(other *QuatT[T]) bool {
 
 	if this[0] != other[0] {
 		return false
@@ -506,8 +815,69 @@ func (this *Quat) Equals(other *Quat) bool {
 	return true
 }
 
+// checks if this and other are the same rotation within absolute tolerance tol, q and -q are equal
+func (this *QuatT[T]) EqualsRotation(other *QuatT[T], tol T) bool {
+	negated := QuatT[T]{-other[0], -other[1], -other[2], -other[3]}
+
+	return equalsTolSlice(this[:], other[:], tol) || equalsTolSlice(this[:], negated[:], tol)
+}
+
+// checks if this equals other within absolute tolerance tol
+func (this *QuatT[T]) EqualsTol(other *QuatT[T], tol T) bool {
+
+	return equalsTolSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other within tol relative to its largest element magnitude
+func (this *QuatT[T]) EqualsRel(other *QuatT[T], tol T) bool {
+
+	return equalsRelSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *QuatT[T]) EqualsULP(other *QuatT[T], ulps int) bool {
+
+	return equalsULPSlice(this[:], other[:], ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *QuatT[T]) MarshalText() ([]byte, error) {
+
+	return appendText(nil, quatText, this[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *QuatT[T]) UnmarshalText(text []byte) error {
+
+	return parseText(text, quatText, this[:])
+}
+
+// returns this as a JSON array, implements json.Marshaler
+func (this *QuatT[T]) MarshalJSON() ([]byte, error) {
+
+	return appendJSON(nil, this[:])
+}
+
+// parses a JSON array into this, implements json.Unmarshaler
+func (this *QuatT[T]) UnmarshalJSON(data []byte) error {
+
+	return parseJSON(data, this[:])
+}
+
+// returns this as little endian floats, implements encoding.BinaryMarshaler
+func (this *QuatT[T]) MarshalBinary() ([]byte, error) {
+
+	return appendBinary(nil, this[:]), nil
+}
+
+// parses little endian floats into this, implements encoding.BinaryUnmarshaler
+func (this *QuatT[T]) UnmarshalBinary(data []byte) error {
+
+	return parseBinary(data, this[:])
+}
+
 // returns this as string type
-func (this *Quat) String() string {
+func (this *QuatT[T]) String() string {
 
 	return fmt.Sprintf("Quat[ %f, %f, %f, %f ]", this[0], this[1], this[2], this[3])
 }
@@ -0,0 +1,230 @@
+package mathf
+
+import (
+	"testing"
+)
+
+// keeps benchmarked results alive
+var quatSink Quat
+
+func TestQuatValueAllocs(t *testing.T) {
+	a := *NewQuat().FromAxisAngle(&Vec3{0, 1, 0}, 0.5)
+	b := *NewQuat().FromAxisAngle(&Vec3{1, 0, 0}, 1.5)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		quatSink = a.Times(b).Conjugated().Inverted().Normalized().Slerped(b, 0.25).Nlerped(a, 0.5)
+	})
+
+	if allocs != 0 {
+		t.Errorf("value API allocates %v times per run, want 0", allocs)
+	}
+}
+
+func TestQuatFromUnitVectors(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *Vec3
+	}{
+		{"x to y", NewVec3(1, 0, 0), NewVec3(0, 1, 0)},
+		{"same", NewVec3(0, 0, 1), NewVec3(0, 0, 1)},
+		{"general", NewVec3(1, 2, 3).Normalize(), NewVec3(-2, 0.5, 1).Normalize()},
+		// opposite vectors take a half turn about any axis perpendicular to a
+		{"antiparallel x", NewVec3(1, 0, 0), NewVec3(-1, 0, 0)},
+		{"antiparallel y", NewVec3(0, 1, 0), NewVec3(0, -1, 0)},
+		{"antiparallel z", NewVec3(0, 0, 1), NewVec3(0, 0, -1)},
+		{"antiparallel general", NewVec3(1, -2, 3).Normalize(), NewVec3(-1, 2, -3).Normalize()},
+	}
+
+	for _, test := range tests {
+		q := NewQuat().FromUnitVectors(test.a, test.b)
+
+		if l := q.Length(); !EqualsTol(l, 1, 1e-6) {
+			t.Errorf("%s FromUnitVectors() length = %v, want 1", test.name, l)
+		}
+		if got := test.a.Clone().ApplyQuat(q); !got.EqualsTol(test.b, 1e-5) {
+			t.Errorf("%s FromUnitVectors(%v, %v) maps a to %v", test.name, *test.a, *test.b, *got)
+		}
+	}
+}
+
+func TestQuatLookRotation(t *testing.T) {
+	tests := []struct {
+		name        string
+		forward, up Vec3
+	}{
+		{"identity", Vec3{0, 0, 1}, Vec3{0, 1, 0}},
+		{"x", Vec3{2, 0, 0}, Vec3{0, 1, 0}},
+		{"tilted up", Vec3{1, 1, -1}, Vec3{0, 1, 0}},
+		// an up parallel to forward falls back to another up
+		{"up parallel", Vec3{0, 3, 0}, Vec3{0, 1, 0}},
+		{"up antiparallel", Vec3{0, -1, 0}, Vec3{0, 1, 0}},
+		{"up parallel z", Vec3{0, 0, 1}, Vec3{0, 0, 1}},
+	}
+
+	for _, test := range tests {
+		q := NewQuat().LookRotation(&test.forward, &test.up)
+		forward := test.forward.Clone().Normalize()
+		z := NewVec3(0, 0, 1).ApplyQuat(q)
+		y := NewVec3(0, 1, 0).ApplyQuat(q)
+
+		if !z.EqualsTol(forward, 1e-5) {
+			t.Errorf("%s LookRotation(%v, %v) z axis = %v, want %v", test.name, test.forward, test.up, *z, *forward)
+		}
+		if l := q.Length(); !EqualsTol(l, 1, 1e-6) {
+			t.Errorf("%s LookRotation() length = %v, want 1", test.name, l)
+		}
+
+		// y is as close to up as it can be, never pointing away from it
+		if x := forward.Clone().Cross(&test.up); x.LengthSq() > 1e-6 && y.Dot(&test.up) <= 0 {
+			t.Errorf("%s LookRotation(%v, %v) y axis = %v points away from up", test.name, test.forward, test.up, *y)
+		}
+	}
+
+	want := NewQuat().FromAxisAngle(&Vec3{0, 1, 0}, HALF_PI)
+
+	if got := NewQuat().LookRotation(&Vec3{1, 0, 0}, &Vec3{0, 1, 0}); !got.EqualsRotation(want, 1e-6) {
+		t.Errorf("LookRotation(x, y) = %v, want %v", *got, *want)
+	}
+}
+
+func TestQuatRotateTowards(t *testing.T) {
+	axis := Vec3{0, 0, 1}
+	from := NewQuat().FromAxisAngle(&axis, 0.25)
+	to := NewQuat().FromAxisAngle(&axis, 1.25)
+
+	tests := []struct {
+		name     string
+		to       *Quat
+		maxDelta float32
+		want     *Quat
+	}{
+		{"step", to, 0.5, NewQuat().FromAxisAngle(&axis, 0.75)},
+		{"zero step", to, 0, from},
+		{"exact", to, 1, to},
+		// a step past the target stops on it
+		{"overshoot", to, 5, to},
+		{"overshoot negated", to.Clone().SMul(-1), 5, to},
+		{"same", from, 1, from},
+	}
+
+	for _, test := range tests {
+		if got := from.Clone().RotateTowards(test.to, test.maxDelta); !got.EqualsRotation(test.want, 1e-6) {
+			t.Errorf("%s RotateTowards(%v, %v) = %v, want %v", test.name, *test.to, test.maxDelta, *got, *test.want)
+		}
+	}
+}
+
+func TestQuatSwingTwist(t *testing.T) {
+	axis := Vec3{0, 1, 0}
+	twistOnly := NewQuat().FromAxisAngle(&axis, 1.2)
+	swingOnly := NewQuat().FromAxisAngle(&Vec3{1, 0, 0}, 0.7)
+
+	tests := []struct {
+		name string
+		q    *Quat
+	}{
+		{"identity", NewQuat()},
+		{"twist only", twistOnly},
+		{"swing only", swingOnly},
+		{"general", NewQuat().FromAxisAngle(NewVec3(1, 2, 3).Normalize(), 2.1)},
+		{"swing then twist", swingOnly.Clone().Mul(twistOnly)},
+	}
+
+	for _, test := range tests {
+		var swing, twist Quat
+
+		test.q.SwingTwist(&axis, &swing, &twist)
+
+		if got := new(Quat).QMul(&swing, &twist); !got.EqualsRotation(test.q, 1e-6) {
+			t.Errorf("%s swing*twist = %v, want %v", test.name, *got, *test.q)
+		}
+
+		// the twist rotates only around axis and the swing only around an axis perpendicular to it
+		if twist[0] != 0 || twist[2] != 0 {
+			t.Errorf("%s twist = %v is not around %v", test.name, twist, axis)
+		}
+		if d := swing[0]*axis[0] + swing[1]*axis[1] + swing[2]*axis[2]; !EqualsTol(d, 0, 1e-6) {
+			t.Errorf("%s swing = %v has a component around %v", test.name, swing, axis)
+		}
+	}
+
+	var swing, twist Quat
+
+	if twistOnly.SwingTwist(&axis, &swing, &twist); !swing.EqualsRotation(NewQuat(), 1e-6) || !twist.EqualsRotation(twistOnly, 1e-6) {
+		t.Errorf("twist only SwingTwist() = %v %v, want identity and %v", swing, twist, *twistOnly)
+	}
+	if swingOnly.SwingTwist(&axis, &swing, &twist); !swing.EqualsRotation(swingOnly, 1e-6) || !twist.EqualsRotation(NewQuat(), 1e-6) {
+		t.Errorf("swing only SwingTwist() = %v %v, want %v and identity", swing, twist, *swingOnly)
+	}
+}
+
+func TestQuatToAxisAngle(t *testing.T) {
+	tests := []struct {
+		axis  *Vec3
+		angle float32
+	}{
+		{NewVec3(0, 0, 1), 0.5},
+		{NewVec3(1, 2, 3).Normalize(), 2},
+		{NewVec3(-1, 0, 1).Normalize(), Pi - 0.01},
+	}
+
+	for _, test := range tests {
+		var axis Vec3
+
+		q := NewQuat().FromAxisAngle(test.axis, test.angle)
+
+		if angle := q.ToAxisAngle(&axis); !EqualsTol(angle, test.angle, 1e-5) || !axis.EqualsTol(test.axis, 1e-5) {
+			t.Errorf("%v.ToAxisAngle() = %v %v, want %v %v", *q, axis, angle, *test.axis, test.angle)
+		}
+	}
+
+	var axis Vec3
+
+	if angle := NewQuat().ToAxisAngle(&axis); angle != 0 || !axis.Equals(&Vec3{1, 0, 0}) {
+		t.Errorf("identity ToAxisAngle() = %v %v, want [1 0 0] 0", axis, angle)
+	}
+}
+
+func TestQuatAngle(t *testing.T) {
+	axis := NewVec3(1, 1, 0).Normalize()
+	a := NewQuat().FromAxisAngle(axis, 0.3)
+	b := NewQuat().FromAxisAngle(axis, 1.2)
+
+	tests := []struct {
+		name string
+		a, b *Quat
+		want float32
+	}{
+		{"same", a, a, 0},
+		{"apart", a, b, 0.9},
+		// q and -q are the same rotation
+		{"negated", a, b.Clone().SMul(-1), 0.9},
+		{"half turn", NewQuat(), NewQuat().FromAxisAngle(axis, Pi), Pi},
+	}
+
+	for _, test := range tests {
+		if got := test.a.Angle(test.b); !EqualsTol(got, test.want, 1e-3) {
+			t.Errorf("%s %v.Angle(%v) = %v, want %v", test.name, *test.a, *test.b, got, test.want)
+		}
+	}
+}
+
+func BenchmarkQuatPointer(b *testing.B) {
+	q, other := NewQuat().FromAxisAngle(&Vec3{0, 1, 0}, 0.5), NewQuat().FromAxisAngle(&Vec3{1, 0, 0}, 1.5)
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		quatSink = *q.Clone().Mul(other).Slerp(other, 0.25)
+	}
+}
+
+func BenchmarkQuatValue(b *testing.B) {
+	q, other := *NewQuat().FromAxisAngle(&Vec3{0, 1, 0}, 0.5), *NewQuat().FromAxisAngle(&Vec3{1, 0, 0}, 1.5)
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		quatSink = q.Times(other).Slerped(other, 0.25)
+	}
+}
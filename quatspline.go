@@ -0,0 +1,135 @@
+package mathf
+
+import (
+	"fmt"
+)
+
+// orientation spline through uniformly spaced Quat keys using Squad
+type QuatSpline struct {
+	Keys     []*Quat
+	controls []*Quat
+}
+
+// returns new QuatSpline from keys, keys are copied
+func NewQuatSpline(keys []*Quat) *QuatSpline {
+	this := new(QuatSpline)
+
+	return this.SetKeys(keys)
+}
+
+// returns a copy of this
+func (this *QuatSpline) Clone() *QuatSpline {
+
+	return NewQuatSpline(this.Keys)
+}
+
+// sets keys of this, keys are copied
+func (this *QuatSpline) SetKeys(keys []*Quat) *QuatSpline {
+
+	this.Keys = make([]*Quat, len(keys))
+
+	for i, key := range keys {
+		this.Keys[i] = key.Clone()
+	}
+
+	return this.Update()
+}
+
+// recomputes control points, call after changing Keys
+func (this *QuatSpline) Update() *QuatSpline {
+	l := len(this.Keys)
+
+	for i := 1; i < l; i++ {
+		if this.Keys[i].Dot(this.Keys[i-1]) < 0 {
+			this.Keys[i].SMul(-1)
+		}
+	}
+
+	if cap(this.controls) < l {
+		this.controls = make([]*Quat, l)
+	}
+	this.controls = this.controls[:l]
+
+	for i := 0; i < l; i++ {
+		prev, next := this.Keys[i], this.Keys[i]
+
+		if i > 0 {
+			prev = this.Keys[i-1]
+		}
+		if i < l-1 {
+			next = this.Keys[i+1]
+		}
+		if this.controls[i] == nil {
+			this.controls[i] = new(Quat)
+		}
+
+		this.controls[i].SquadControl(prev, this.Keys[i], next)
+	}
+
+	return this
+}
+
+// returns the number of segments of this
+func (this *QuatSpline) Segments() int {
+	l := len(this.Keys)
+
+	if l < 2 {
+		return 0
+	}
+
+	return l - 1
+}
+
+// returns orientation at x from 0 to 1 across all keys, saves in target
+func (this *QuatSpline) Evaluate(x float32, target *Quat) *Quat {
+	i, t := this.segment(x)
+
+	if i < 0 {
+		return target.Identity()
+	}
+	if i == len(this.Keys)-1 {
+		return target.Copy(this.Keys[i])
+	}
+
+	return target.QSquad(this.Keys[i], this.controls[i], this.controls[i+1], this.Keys[i+1], t)
+}
+
+// returns orientation at x from 0 to 1 across all keys using Nlerp between keys, saves in target
+func (this *QuatSpline) EvaluateLinear(x float32, target *Quat) *Quat {
+	i, t := this.segment(x)
+
+	if i < 0 {
+		return target.Identity()
+	}
+	if i == len(this.Keys)-1 {
+		return target.Copy(this.Keys[i])
+	}
+
+	return target.QNlerp(this.Keys[i], this.Keys[i+1], t)
+}
+
+// returns segment index and local x for x from 0 to 1, -1 if this has no keys
+func (this *QuatSpline) segment(x float32) (int, float32) {
+	l := len(this.Keys)
+
+	if l == 0 {
+		return -1, 0
+	}
+	if l == 1 || x >= 1 {
+		return l - 1, 0
+	}
+	if x <= 0 {
+		return 0, 0
+	}
+
+	x *= float32(l - 1)
+	i := int(x)
+
+	return i, x - float32(i)
+}
+
+// returns this as string type
+func (this *QuatSpline) String() string {
+
+	return fmt.Sprintf("QuatSpline[ Keys: %v ]", this.Keys)
+}
@@ -0,0 +1,113 @@
+package mathf
+
+import (
+	"testing"
+)
+
+// orientations with changing axes and angular speeds
+var quatSplineKeys = []*Quat{
+	NewQuat(),
+	NewQuat().FromAxisAngle(&Vec3{0, 1, 0}, 0.8),
+	NewQuat().FromAxisAngle(NewVec3(1, 1, 0).Normalize(), 1.9),
+	NewQuat().FromAxisAngle(&Vec3{0, 0, 1}, -0.6),
+	NewQuat().FromAxisAngle(NewVec3(-1, 2, 1).Normalize(), 2.8),
+}
+
+// returns angular velocity from a to b over step h
+func quatVelocity(a, b *Quat, h float32) Vec3 {
+	var d Quat
+
+	if d.QMul(a.Clone().Conjugate(), b); d[3] < 0 {
+		d.SMul(-1)
+	}
+	d.Log()
+
+	return Vec3{d[0] * 2 / h, d[1] * 2 / h, d[2] * 2 / h}
+}
+
+// returns the largest relative change of angular velocity across each interior key, it shrinks with h only if the spline is C1
+func quatSplineKink(evaluate func(x float32, target *Quat) *Quat, keys int) float32 {
+	var worst float32
+	h := float32(2e-4)
+
+	for i := 1; i < keys-1; i++ {
+		var before, at, after Quat
+		x := float32(i) / float32(keys-1)
+
+		evaluate(x-h, &before)
+		evaluate(x, &at)
+		evaluate(x+h, &after)
+
+		left, right := quatVelocity(&before, &at, h), quatVelocity(&at, &after, h)
+
+		if d := left.Sub(&right).Length() / right.Length(); d > worst {
+			worst = d
+		}
+	}
+
+	return worst
+}
+
+func TestQuatSplineContinuity(t *testing.T) {
+	spline := NewQuatSpline(quatSplineKeys)
+
+	for i, key := range quatSplineKeys {
+		var got Quat
+
+		if spline.Evaluate(float32(i)/float32(len(quatSplineKeys)-1), &got); !got.EqualsRotation(key, 1e-5) {
+			t.Errorf("Evaluate at key %d = %v, want %v", i, got, *key)
+		}
+	}
+
+	if kink := quatSplineKink(spline.Evaluate, len(quatSplineKeys)); kink > 0.02 {
+		t.Errorf("Evaluate angular velocity changes by %v at a key, want C1 continuity", kink)
+	}
+	if kink := quatSplineKink(spline.EvaluateLinear, len(quatSplineKeys)); kink < 0.1 {
+		t.Errorf("EvaluateLinear angular velocity changes by only %v at a key, the continuity check is not sensitive", kink)
+	}
+}
+
+func TestQuatSquad(t *testing.T) {
+	a, b := quatSplineKeys[1], quatSplineKeys[2]
+	var sa, sb Quat
+
+	sa.SquadControl(quatSplineKeys[0], a, b)
+	sb.SquadControl(a, b, quatSplineKeys[3])
+
+	if got := a.Clone().Squad(&sa, &sb, b, 0); !got.EqualsRotation(a, 1e-6) {
+		t.Errorf("Squad at 0 = %v, want %v", *got, *a)
+	}
+	if got := a.Clone().Squad(&sa, &sb, b, 1); !got.EqualsRotation(b, 1e-6) {
+		t.Errorf("Squad at 1 = %v, want %v", *got, *b)
+	}
+
+	// with controls equal to the keys Squad is Slerp
+	for _, x := range []float32{0.25, 0.5, 0.8} {
+		want := a.Clone().Slerp(b, x)
+
+		if got := new(Quat).QSquad(a, a, b, b, x); !got.EqualsRotation(want, 1e-5) {
+			t.Errorf("QSquad(a, a, b, b, %v) = %v, want %v", x, *got, *want)
+		}
+	}
+}
+
+func TestQuatLogExpPow(t *testing.T) {
+
+	for _, q := range quatSplineKeys {
+		if got := q.Clone().Log().Exp(); !got.EqualsTol(q, 1e-6) {
+			t.Errorf("%v.Log().Exp() = %v", *q, *got)
+		}
+		if got := q.Clone().Pow(1); !got.EqualsTol(q, 1e-6) {
+			t.Errorf("%v.Pow(1) = %v", *q, *got)
+		}
+		if got := q.Clone().Pow(0); !got.EqualsTol(NewQuat(), 1e-6) {
+			t.Errorf("%v.Pow(0) = %v, want identity", *q, *got)
+		}
+
+		half := q.Clone().Pow(0.5)
+
+		if got := half.Clone().Mul(half); !got.EqualsRotation(q, 1e-6) {
+			t.Errorf("%v.Pow(0.5) squared = %v", *q, *got)
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package mathf
+
+import "fmt"
+
+// 2D ray represented by an origin and direction, hit distances are in units of direction
+type Ray2 struct {
+	Origin, Direction *Vec2
+}
+
+// returns new Ray2
+func NewRay2(origin, direction *Vec2) *Ray2 {
+	this := new(Ray2)
+
+	this.Origin = new(Vec2).Copy(origin)
+	this.Direction = new(Vec2).Copy(direction)
+
+	return this
+}
+
+// returns a copy of this
+func (this *Ray2) Clone() *Ray2 {
+
+	return NewRay2(this.Origin, this.Direction)
+}
+
+// copies other
+func (this *Ray2) Copy(other *Ray2) *Ray2 {
+
+	this.Origin.Copy(other.Origin)
+	this.Direction.Copy(other.Direction)
+
+	return this
+}
+
+// sets this from values
+func (this *Ray2) Set(origin, direction *Vec2) *Ray2 {
+
+	this.Origin.Copy(origin)
+	this.Direction.Copy(direction)
+
+	return this
+}
+
+// returns point at distance t along this, saves in target
+func (this *Ray2) At(t float32, target *Vec2) *Vec2 {
+	o, d := this.Origin, this.Direction
+
+	target[0] = o[0] + d[0]*t
+	target[1] = o[1] + d[1]*t
+
+	return target
+}
+
+// points direction of this at v
+func (this *Ray2) LookAt(v *Vec2) *Ray2 {
+
+	this.Direction.VSub(v, this.Origin).Normalize()
+
+	return this
+}
+
+// returns closest point on this to point, saves in target
+func (this *Ray2) ClosestPointToPoint(v, target *Vec2) *Vec2 {
+	o, d := this.Origin, this.Direction
+	l := d.LengthSq()
+
+	if l == 0 {
+		return target.Copy(o)
+	}
+
+	t := ((v[0]-o[0])*d[0] + (v[1]-o[1])*d[1]) / l
+	if t < 0 {
+		return target.Copy(o)
+	}
+
+	return this.At(t, target)
+}
+
+// returns distance squared from this to point
+func (this *Ray2) DistanceToPointSq(v *Vec2) float32 {
+	var p Vec2
+
+	return this.ClosestPointToPoint(v, &p).DistanceToSq(v)
+}
+
+// returns distance from this to point
+func (this *Ray2) DistanceToPoint(v *Vec2) float32 {
+	var p Vec2
+
+	return this.ClosestPointToPoint(v, &p).DistanceTo(v)
+}
+
+// transforms origin as a point and direction as a direction by Mat32
+func (this *Ray2) ApplyMat32(m *Mat32) *Ray2 {
+
+	this.Origin.ApplyMat32(m)
+	this.Direction.ApplyMat32Direction(m)
+
+	return this
+}
+
+// returns distance to the first hit with box, if normal is not nil saves the hit edge normal in it
+// if origin is inside box the exit point is returned
+func (this *Ray2) IntersectAABB2(box *AABB2, normal *Vec2) (float32, bool) {
+	o, d := this.Origin, this.Direction
+	tmin, tmax := float32(-Inf), float32(Inf)
+	minAxis, maxAxis := -1, -1
+	var minSign, maxSign float32
+
+	for i := 0; i < 2; i++ {
+		if d[i] == 0 {
+			if o[i] < box.Min[i] || o[i] > box.Max[i] {
+				return 0, false
+			}
+			continue
+		}
+
+		inv := 1 / d[i]
+		t1 := (box.Min[i] - o[i]) * inv
+		t2 := (box.Max[i] - o[i]) * inv
+		s := -Sign(d[i])
+
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		if t1 > tmin {
+			tmin, minAxis, minSign = t1, i, s
+		}
+		if t2 < tmax {
+			tmax, maxAxis, maxSign = t2, i, -s
+		}
+		if tmin > tmax {
+			return 0, false
+		}
+	}
+
+	if tmax < 0 || maxAxis == -1 {
+		return 0, false
+	}
+
+	t, axis, sign := tmin, minAxis, minSign
+	if tmin < 0 {
+		t, axis, sign = tmax, maxAxis, maxSign
+	}
+
+	if normal != nil {
+		normal.Set(0, 0)
+		normal[axis] = sign
+	}
+
+	return t, true
+}
+
+// returns distance to the hit with segment a to b, if normal is not nil saves the segment normal
+// facing the origin of this in it
+func (this *Ray2) IntersectSegment(a, b *Vec2, normal *Vec2) (float32, bool) {
+	o, d := this.Origin, this.Direction
+	ex, ey := b[0]-a[0], b[1]-a[1]
+	ax, ay := a[0]-o[0], a[1]-o[1]
+
+	denom := d[0]*ey - d[1]*ex
+	if denom == 0 {
+		return 0, false
+	}
+	inv := 1 / denom
+
+	t := (ax*ey - ay*ex) * inv
+	if t < 0 {
+		return 0, false
+	}
+
+	u := (ax*d[1] - ay*d[0]) * inv
+	if u < 0 || u > 1 {
+		return 0, false
+	}
+
+	if normal != nil {
+		normal.Set(-ey, ex).Normalize()
+
+		if normal.Dot(d) > 0 {
+			normal.Inverse()
+		}
+	}
+
+	return t, true
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *Ray2) MarshalText() ([]byte, error) {
+	e := this.elements()
+
+	return appendStructText(nil, ray2Text, e[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *Ray2) UnmarshalText(text []byte) error {
+	var e [4]float32
+
+	if err := parseStructText(text, ray2Text, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns this as a JSON array of Origin and Direction, implements json.Marshaler
+func (this *Ray2) MarshalJSON() ([]byte, error) {
+	e := this.elements()
+
+	return appendJSON(nil, e[:])
+}
+
+// parses a JSON array of Origin and Direction into this, implements json.Unmarshaler
+func (this *Ray2) UnmarshalJSON(data []byte) error {
+	var e [4]float32
+
+	if isJSONNull(data) {
+		return nil
+	}
+	if err := parseJSON(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Origin and Direction of this as little endian floats, implements encoding.BinaryMarshaler
+func (this *Ray2) MarshalBinary() ([]byte, error) {
+	e := this.elements()
+
+	return appendBinary(nil, e[:]), nil
+}
+
+// parses little endian floats of Origin and Direction into this, implements encoding.BinaryUnmarshaler
+func (this *Ray2) UnmarshalBinary(data []byte) error {
+	var e [4]float32
+
+	if err := parseBinary(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Origin and Direction of this as marshaling elements, nil fields are zero
+func (this *Ray2) elements() [4]float32 {
+	origin, direction := valueOrZero(this.Origin), valueOrZero(this.Direction)
+
+	return [4]float32{origin[0], origin[1], direction[0], direction[1]}
+}
+
+// sets Origin and Direction of this from marshaling elements, nil fields are allocated
+func (this *Ray2) setElements(e *[4]float32) {
+	if this.Origin == nil {
+		this.Origin = new(Vec2)
+	}
+	if this.Direction == nil {
+		this.Direction = new(Vec2)
+	}
+
+	copy(this.Origin[:], e[0:2])
+	copy(this.Direction[:], e[2:4])
+}
+
+// returns this as string type
+func (this *Ray2) String() string {
+
+	return fmt.Sprintf("Ray2[ Origin: %s, Direction: %s ]", this.Origin, this.Direction)
+}
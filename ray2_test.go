@@ -0,0 +1,100 @@
+package mathf
+
+import (
+	"testing"
+)
+
+func TestRay2IntersectAABB2(t *testing.T) {
+	box := NewAABB2().Set(&Vec2{0, 0}, &Vec2{2, 1})
+
+	tests := []struct {
+		name              string
+		origin, direction Vec2
+		want              float32
+		normal            Vec2
+		hit               bool
+	}{
+		{"from left", Vec2{-2, 0.5}, Vec2{1, 0}, 2, Vec2{-1, 0}, true},
+		{"from above", Vec2{1, 3}, Vec2{0, -1}, 2, Vec2{0, 1}, true},
+		{"oblique unnormalized", Vec2{-1, 0}, Vec2{2, 1}, 0.5, Vec2{-1, 0}, true},
+		{"along edge", Vec2{-2, 1}, Vec2{1, 0}, 2, Vec2{-1, 0}, true},
+		// from inside the hit is where the ray leaves the box
+		{"origin inside", Vec2{1, 0.5}, Vec2{1, 0}, 1, Vec2{1, 0}, true},
+		{"origin inside oblique", Vec2{1, 0.5}, Vec2{0, -1}, 0.5, Vec2{0, -1}, true},
+		{"miss", Vec2{-2, 0}, Vec2{1, 2}, 0, Vec2{}, false},
+		{"parallel outside", Vec2{-2, 1.5}, Vec2{1, 0}, 0, Vec2{}, false},
+		{"pointing away", Vec2{-2, 0.5}, Vec2{-1, 0}, 0, Vec2{}, false},
+		{"zero direction", Vec2{1, 0.5}, Vec2{0, 0}, 0, Vec2{}, false},
+	}
+
+	for _, test := range tests {
+		var normal Vec2
+		ray := Ray2{&test.origin, &test.direction}
+
+		got, hit := ray.IntersectAABB2(box, &normal)
+
+		if hit != test.hit || !EqualsTol(got, test.want, 1e-6) {
+			t.Errorf("%s: IntersectAABB2() = %v, %v, want %v, %v", test.name, got, hit, test.want, test.hit)
+			continue
+		}
+		if !hit {
+			continue
+		}
+		if normal != test.normal {
+			t.Errorf("%s: IntersectAABB2() normal = %v, want %v", test.name, normal, test.normal)
+		}
+		if at := ray.At(got, new(Vec2)); box.DistanceToPoint(at) > 1e-6 {
+			t.Errorf("%s: hit point %v is outside %v", test.name, *at, box)
+		}
+		if _, hit := ray.IntersectAABB2(box, nil); !hit {
+			t.Errorf("%s: IntersectAABB2() with nil normal missed", test.name)
+		}
+	}
+}
+
+func TestRay2IntersectSegment(t *testing.T) {
+	a, b := Vec2{0, 0}, Vec2{0, 2}
+
+	tests := []struct {
+		name              string
+		origin, direction Vec2
+		want              float32
+		normal            Vec2
+		hit               bool
+	}{
+		{"from left", Vec2{-2, 1}, Vec2{1, 0}, 2, Vec2{-1, 0}, true},
+		{"from right", Vec2{3, 1}, Vec2{-1, 0}, 3, Vec2{1, 0}, true},
+		{"oblique unnormalized", Vec2{-1, 0}, Vec2{2, 2}, 0.5, Vec2{-1, 0}, true},
+		{"end point", Vec2{-2, 2}, Vec2{1, 0}, 2, Vec2{-1, 0}, true},
+		{"origin on segment", Vec2{0, 1}, Vec2{1, 0}, 0, Vec2{-1, 0}, true},
+		{"past end", Vec2{-2, 2.5}, Vec2{1, 0}, 0, Vec2{}, false},
+		{"pointing away", Vec2{-2, 1}, Vec2{-1, 0}, 0, Vec2{}, false},
+		{"parallel", Vec2{-1, 0}, Vec2{0, 1}, 0, Vec2{}, false},
+		// a collinear ray has no single hit point and misses
+		{"collinear", Vec2{0, -1}, Vec2{0, 1}, 0, Vec2{}, false},
+	}
+
+	for _, test := range tests {
+		var normal Vec2
+		ray := Ray2{&test.origin, &test.direction}
+
+		got, hit := ray.IntersectSegment(&a, &b, &normal)
+
+		if hit != test.hit || !EqualsTol(got, test.want, 1e-6) {
+			t.Errorf("%s: IntersectSegment() = %v, %v, want %v, %v", test.name, got, hit, test.want, test.hit)
+			continue
+		}
+		if !hit {
+			continue
+		}
+		if !normal.EqualsTol(&test.normal, 1e-6) {
+			t.Errorf("%s: IntersectSegment() normal = %v, want %v", test.name, normal, test.normal)
+		}
+		if at := ray.At(got, new(Vec2)); !EqualsTol(at[0], 0, 1e-6) || at[1] < 0 || at[1] > 2 {
+			t.Errorf("%s: hit point %v is not on the segment", test.name, *at)
+		}
+		if _, hit := ray.IntersectSegment(&a, &b, nil); !hit {
+			t.Errorf("%s: IntersectSegment() with nil normal missed", test.name)
+		}
+	}
+}
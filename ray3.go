@@ -0,0 +1,375 @@
+package mathf
+
+import (
+	"fmt"
+	"math"
+)
+
+// 3D ray represented by an origin and direction, hit distances are in units of direction
+type Ray3 struct {
+	Origin, Direction *Vec3
+}
+
+// returns new Ray3
+func NewRay3(origin, direction *Vec3) *Ray3 {
+	this := new(Ray3)
+
+	this.Origin = new(Vec3).Copy(origin)
+	this.Direction = new(Vec3).Copy(direction)
+
+	return this
+}
+
+// returns a copy of this
+func (this *Ray3) Clone() *Ray3 {
+
+	return NewRay3(this.Origin, this.Direction)
+}
+
+// copies other
+func (this *Ray3) Copy(other *Ray3) *Ray3 {
+
+	this.Origin.Copy(other.Origin)
+	this.Direction.Copy(other.Direction)
+
+	return this
+}
+
+// sets this from values
+func (this *Ray3) Set(origin, direction *Vec3) *Ray3 {
+
+	this.Origin.Copy(origin)
+	this.Direction.Copy(direction)
+
+	return this
+}
+
+// returns point at distance t along this, saves in target
+func (this *Ray3) At(t float32, target *Vec3) *Vec3 {
+	o, d := this.Origin, this.Direction
+
+	target[0] = o[0] + d[0]*t
+	target[1] = o[1] + d[1]*t
+	target[2] = o[2] + d[2]*t
+
+	return target
+}
+
+// points direction of this at v
+func (this *Ray3) LookAt(v *Vec3) *Ray3 {
+
+	this.Direction.VSub(v, this.Origin).Normalize()
+
+	return this
+}
+
+// returns closest point on this to point, saves in target
+func (this *Ray3) ClosestPointToPoint(v, target *Vec3) *Vec3 {
+	o, d := this.Origin, this.Direction
+	l := d.LengthSq()
+
+	if l == 0 {
+		return target.Copy(o)
+	}
+
+	t := ((v[0]-o[0])*d[0] + (v[1]-o[1])*d[1] + (v[2]-o[2])*d[2]) / l
+	if t < 0 {
+		return target.Copy(o)
+	}
+
+	return this.At(t, target)
+}
+
+// returns distance squared from this to point
+func (this *Ray3) DistanceToPointSq(v *Vec3) float32 {
+	var p Vec3
+
+	return this.ClosestPointToPoint(v, &p).DistanceToSq(v)
+}
+
+// returns distance from this to point
+func (this *Ray3) DistanceToPoint(v *Vec3) float32 {
+	var p Vec3
+
+	return this.ClosestPointToPoint(v, &p).DistanceTo(v)
+}
+
+// transforms origin as a point and direction as a direction by Mat4
+func (this *Ray3) ApplyMat4(m *Mat4) *Ray3 {
+
+	this.Origin.ApplyMat4(m)
+	this.Direction.ApplyMat4Direction(m)
+
+	return this
+}
+
+// returns distance to the first hit with box, if normal is not nil saves the hit face normal in it
+// if origin is inside box the exit point is returned
+func (this *Ray3) IntersectAABB3(box *AABB3, normal *Vec3) (float32, bool) {
+	o, d := this.Origin, this.Direction
+	tmin, tmax := float32(-Inf), float32(Inf)
+	minAxis, maxAxis := -1, -1
+	var minSign, maxSign float32
+
+	for i := 0; i < 3; i++ {
+		if d[i] == 0 {
+			if o[i] < box.Min[i] || o[i] > box.Max[i] {
+				return 0, false
+			}
+			continue
+		}
+
+		inv := 1 / d[i]
+		t1 := (box.Min[i] - o[i]) * inv
+		t2 := (box.Max[i] - o[i]) * inv
+		s := -Sign(d[i])
+
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		if t1 > tmin {
+			tmin, minAxis, minSign = t1, i, s
+		}
+		if t2 < tmax {
+			tmax, maxAxis, maxSign = t2, i, -s
+		}
+		if tmin > tmax {
+			return 0, false
+		}
+	}
+
+	if tmax < 0 || maxAxis == -1 {
+		return 0, false
+	}
+
+	t, axis, sign := tmin, minAxis, minSign
+	if tmin < 0 {
+		t, axis, sign = tmax, maxAxis, maxSign
+	}
+
+	if normal != nil {
+		normal.Set(0, 0, 0)
+		normal[axis] = sign
+	}
+
+	return t, true
+}
+
+// returns distance to the first hit with sphere, if normal is not nil saves the hit normal in it
+// if origin is inside sphere the exit point is returned
+func (this *Ray3) IntersectSphere(sphere *Sphere, normal *Vec3) (float32, bool) {
+	o, d, c := this.Origin, this.Direction, sphere.Center
+	ox, oy, oz := o[0]-c[0], o[1]-c[1], o[2]-c[2]
+
+	a := d.LengthSq()
+	if a == 0 {
+		return 0, false
+	}
+
+	b := ox*d[0] + oy*d[1] + oz*d[2]
+	cc := ox*ox + oy*oy + oz*oz - sphere.Radius*sphere.Radius
+	disc := b*b - a*cc
+
+	if disc < 0 {
+		return 0, false
+	}
+
+	s := float32(math.Sqrt(float64(disc)))
+	t := (-b - s) / a
+
+	if t < 0 {
+		t = (-b + s) / a
+	}
+	if t < 0 {
+		return 0, false
+	}
+
+	if normal != nil {
+		this.At(t, normal).Sub(c).Normalize()
+	}
+
+	return t, true
+}
+
+// returns distance to the hit with plane, if normal is not nil saves the plane normal in it
+func (this *Ray3) IntersectPlane(plane *Plane, normal *Vec3) (float32, bool) {
+	denom := plane.Normal.Dot(this.Direction)
+	dist := plane.DistanceToPoint(this.Origin)
+	var t float32
+
+	if denom == 0 {
+		if dist != 0 {
+			return 0, false
+		}
+	} else {
+		t = -dist / denom
+	}
+
+	if t < 0 {
+		return 0, false
+	}
+
+	if normal != nil {
+		normal.Copy(plane.Normal)
+	}
+
+	return t, true
+}
+
+// returns distance to the hit with triangle a, b, c using Moller-Trumbore, if normal is not nil saves the
+// counter clockwise face normal in it, if cullBackface is true hits on the clockwise side are ignored
+func (this *Ray3) IntersectTriangle(a, b, c *Vec3, cullBackface bool, normal *Vec3) (float32, bool) {
+	var e1, e2, p, s, q Vec3
+	d := this.Direction
+
+	e1.VSub(b, a)
+	e2.VSub(c, a)
+	p.VCross(d, &e2)
+
+	det := e1.Dot(&p)
+
+	if cullBackface {
+		if det < Epsilon {
+			return 0, false
+		}
+	} else if det > -Epsilon && det < Epsilon {
+		return 0, false
+	}
+	inv := 1 / det
+
+	s.VSub(this.Origin, a)
+	u := s.Dot(&p) * inv
+	if u < 0 || u > 1 {
+		return 0, false
+	}
+
+	q.VCross(&s, &e1)
+	v := d.Dot(&q) * inv
+	if v < 0 || u+v > 1 {
+		return 0, false
+	}
+
+	t := e2.Dot(&q) * inv
+	if t < 0 {
+		return 0, false
+	}
+
+	if normal != nil {
+		normal.VCross(&e1, &e2).Normalize()
+	}
+
+	return t, true
+}
+
+// sets this to the ray from the near plane through window point, viewport is x, y, width, height
+func (this *Ray3) FromScreenPoint(point *Vec2, view, projection *Mat4, viewport *Vec4) *Ray3 {
+
+	return this.FromScreenPointClip(point, view, projection, viewport, 0)
+}
+
+// sets this to the ray from the near plane through window point with projection built with clip space options
+func (this *Ray3) FromScreenPointClip(point *Vec2, view, projection *Mat4, viewport *Vec4, flags int) *Ray3 {
+	var mid Vec3
+	near := float32(0)
+
+	if flags&CLIP_REVERSE_Z != 0 {
+		near = 1
+	}
+
+	// the far plane may be at infinity so the direction is taken from halfway through the depth range
+	this.Origin.Set(point[0], point[1], near).UnprojectClip(view, projection, viewport, flags)
+	mid.Set(point[0], point[1], 0.5).UnprojectClip(view, projection, viewport, flags)
+
+	this.Direction.VSub(&mid, this.Origin).Normalize()
+
+	return this
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *Ray3) MarshalText() ([]byte, error) {
+	e := this.elements()
+
+	return appendStructText(nil, ray3Text, e[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *Ray3) UnmarshalText(text []byte) error {
+	var e [6]float32
+
+	if err := parseStructText(text, ray3Text, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns this as a JSON array of Origin and Direction, implements json.Marshaler
+func (this *Ray3) MarshalJSON() ([]byte, error) {
+	e := this.elements()
+
+	return appendJSON(nil, e[:])
+}
+
+// parses a JSON array of Origin and Direction into this, implements json.Unmarshaler
+func (this *Ray3) UnmarshalJSON(data []byte) error {
+	var e [6]float32
+
+	if isJSONNull(data) {
+		return nil
+	}
+	if err := parseJSON(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Origin and Direction of this as little endian floats, implements encoding.BinaryMarshaler
+func (this *Ray3) MarshalBinary() ([]byte, error) {
+	e := this.elements()
+
+	return appendBinary(nil, e[:]), nil
+}
+
+// parses little endian floats of Origin and Direction into this, implements encoding.BinaryUnmarshaler
+func (this *Ray3) UnmarshalBinary(data []byte) error {
+	var e [6]float32
+
+	if err := parseBinary(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Origin and Direction of this as marshaling elements, nil fields are zero
+func (this *Ray3) elements() [6]float32 {
+	origin, direction := valueOrZero(this.Origin), valueOrZero(this.Direction)
+
+	return [6]float32{origin[0], origin[1], origin[2], direction[0], direction[1], direction[2]}
+}
+
+// sets Origin and Direction of this from marshaling elements, nil fields are allocated
+func (this *Ray3) setElements(e *[6]float32) {
+	if this.Origin == nil {
+		this.Origin = new(Vec3)
+	}
+	if this.Direction == nil {
+		this.Direction = new(Vec3)
+	}
+
+	copy(this.Origin[:], e[0:3])
+	copy(this.Direction[:], e[3:6])
+}
+
+// returns this as string type
+func (this *Ray3) String() string {
+
+	return fmt.Sprintf("Ray3[ Origin: %s, Direction: %s ]", this.Origin, this.Direction)
+}
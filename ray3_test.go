@@ -0,0 +1,91 @@
+package mathf
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRay3FromScreenPointClip(t *testing.T) {
+	view := NewMat4().Compose(&Vec3{1, 2, 3}, &Vec3{1, 1, 1}, NewQuat().FromAxisAngle(&Vec3{0, 1, 0}, 0.3))
+	inverse := view.Clone().Inverse()
+	viewport := Vec4{0, 0, 200, 100}
+
+	tests := []struct {
+		name      string
+		point     Vec2
+		direction Vec3
+	}{
+		{"center", Vec2{100, 50}, Vec3{0, 0, -1}},
+		{"right edge", Vec2{200, 50}, Vec3{2, 0, -1}},
+		{"top edge", Vec2{100, 100}, Vec3{0, 1, -1}},
+	}
+
+	for _, flags := range clipFlagTests {
+		var projection Mat4
+
+		projection.MakePerspectiveClip(Pi*0.5, 2, 1, 100, flags.flags)
+
+		for _, test := range tests {
+			ray := NewRay3(new(Vec3), new(Vec3))
+			origin, direction := test.direction, test.direction
+
+			if flags.flags&CLIP_FLIP_Y != 0 {
+				origin[1], direction[1] = -origin[1], -direction[1]
+			}
+
+			origin.ApplyMat4(inverse)
+			direction.Normalize().ApplyMat4Direction(inverse)
+
+			ray.FromScreenPointClip(&test.point, view, &projection, &viewport, flags.flags)
+
+			if !ray.Origin.EqualsTol(&origin, 1e-4) || !ray.Direction.EqualsTol(&direction, 1e-4) {
+				t.Errorf("%s %s: ray = %v, want origin %v direction %v", flags.name, test.name, ray, origin, direction)
+			}
+		}
+	}
+}
+
+func TestRay3IntersectPlane(t *testing.T) {
+	plane := Plane{&Vec3{0, 1, 0}, -2}
+	diagonal := *NewVec3(1, 1, 0).Normalize()
+
+	tests := []struct {
+		name              string
+		origin, direction Vec3
+		want              float32
+		hit               bool
+	}{
+		{"from below", Vec3{0, 0, 0}, Vec3{0, 1, 0}, 2, true},
+		{"from above", Vec3{4, 5, -1}, Vec3{0, -1, 0}, 3, true},
+		{"oblique", Vec3{0, 0, 0}, diagonal, float32(2 * math.Sqrt2), true},
+		{"unnormalized direction", Vec3{0, 0, 0}, Vec3{0, 4, 0}, 0.5, true},
+		{"pointing away", Vec3{0, 0, 0}, Vec3{0, -1, 0}, 0, false},
+		{"parallel", Vec3{0, 0, 0}, Vec3{1, 0, 0}, 0, false},
+		{"in plane", Vec3{1, 2, 3}, Vec3{1, 0, 0}, 0, true},
+		{"on plane", Vec3{1, 2, 3}, Vec3{0, 1, 0}, 0, true},
+	}
+
+	for _, test := range tests {
+		var normal Vec3
+		ray := Ray3{&test.origin, &test.direction}
+
+		got, hit := ray.IntersectPlane(&plane, &normal)
+
+		if hit != test.hit || !EqualsTol(got, test.want, 1e-5) {
+			t.Errorf("%s: IntersectPlane() = %v, %v, want %v, %v", test.name, got, hit, test.want, test.hit)
+			continue
+		}
+		if !hit {
+			continue
+		}
+		if normal != *plane.Normal {
+			t.Errorf("%s: IntersectPlane() normal = %v, want %v", test.name, normal, *plane.Normal)
+		}
+		if at := ray.At(got, new(Vec3)); !EqualsTol(plane.DistanceToPoint(at), 0, 1e-5) {
+			t.Errorf("%s: hit point %v is %v from the plane", test.name, *at, plane.DistanceToPoint(at))
+		}
+		if _, hit := ray.IntersectPlane(&plane, nil); !hit {
+			t.Errorf("%s: IntersectPlane() with nil normal missed", test.name)
+		}
+	}
+}
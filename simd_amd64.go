@@ -0,0 +1,57 @@
+//go:build amd64 && !purego
+
+package mathf
+
+// SSE paths for float32 Mat4 operations and SSE or AVX paths for Vec4 batches, set to false to use the pure Go paths
+var useSIMD = true
+
+// true when the cpu and os support AVX, only TransformVec4s has an AVX path
+var hasAVX = detectAVX()
+
+// checks cpuid and xgetbv for AVX support
+func detectAVX() bool {
+	_, _, ecx, _ := cpuid(1, 0)
+
+	if ecx&(1<<27) == 0 || ecx&(1<<28) == 0 {
+		return false
+	}
+
+	eax, _ := xgetbv()
+
+	return eax&6 == 6
+}
+
+// transforms n Vec4s from src by m, saves in dst, dst may be src
+func transformVec4sSIMD(dst, src *Vec4, n int, m *Mat4) {
+
+	if hasAVX {
+		transformVec4sAVX(dst, src, n, m)
+	} else {
+		transformVec4sSSE(dst, src, n, m)
+	}
+}
+
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+func xgetbv() (eax, edx uint32)
+
+// mutiples a and b saves in out, out may be a or b
+//
+//go:noescape
+func mat4MulSIMD(out, a, b *Mat4)
+
+// saves inverse of m in out and returns the determinant, out is undefined if it is 0
+//
+//go:noescape
+func mat4InverseSIMD(out, m *Mat4) float32
+
+// saves transpose of m in out, out may be m
+//
+//go:noescape
+func mat4TransposeSIMD(out, m *Mat4)
+
+//go:noescape
+func transformVec4sSSE(dst, src *Vec4, n int, m *Mat4)
+
+//go:noescape
+func transformVec4sAVX(dst, src *Vec4, n int, m *Mat4)
@@ -0,0 +1,355 @@
+//go:build amd64 && !purego
+
+#include "textflag.h"
+
+// signs of the adjugate blocks for mat4InverseSIMD
+DATA adjSign<>+0(SB)/4, $0x3f800000
+DATA adjSign<>+4(SB)/4, $0xbf800000
+DATA adjSign<>+8(SB)/4, $0xbf800000
+DATA adjSign<>+12(SB)/4, $0x3f800000
+GLOBL adjSign<>(SB), RODATA|NOPTR, $16
+
+// func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func xgetbv() (eax, edx uint32)
+TEXT ·xgetbv(SB), NOSPLIT, $0-8
+	MOVL $0, CX
+	XGETBV
+	MOVL AX, eax+0(FP)
+	MOVL DX, edx+4(FP)
+	RET
+
+// multiplies columns X0 to X3 by column off of DX, saves in column off of DI
+#define MULCOL(off) \
+	MOVSS  off+0(DX), X4; \
+	SHUFPS $0x00, X4, X4; \
+	MULPS  X0, X4; \
+	MOVSS  off+4(DX), X5; \
+	SHUFPS $0x00, X5, X5; \
+	MULPS  X1, X5; \
+	ADDPS  X5, X4; \
+	MOVSS  off+8(DX), X5; \
+	SHUFPS $0x00, X5, X5; \
+	MULPS  X2, X5; \
+	ADDPS  X5, X4; \
+	MOVSS  off+12(DX), X5; \
+	SHUFPS $0x00, X5, X5; \
+	MULPS  X3, X5; \
+	ADDPS  X5, X4; \
+	MOVUPS X4, off(DI)
+
+// func mat4MulSIMD(out, a, b *Mat4)
+TEXT ·mat4MulSIMD(SB), NOSPLIT, $0-24
+	MOVQ out+0(FP), DI
+	MOVQ a+8(FP), SI
+	MOVQ b+16(FP), DX
+
+	MOVUPS 0(SI), X0
+	MOVUPS 16(SI), X1
+	MOVUPS 32(SI), X2
+	MOVUPS 48(SI), X3
+
+	MULCOL(0)
+	MULCOL(16)
+	MULCOL(32)
+	MULCOL(48)
+	RET
+
+// func mat4TransposeSIMD(out, m *Mat4)
+TEXT ·mat4TransposeSIMD(SB), NOSPLIT, $0-16
+	MOVQ out+0(FP), DI
+	MOVQ m+8(FP), SI
+
+	MOVUPS 0(SI), X0
+	MOVUPS 16(SI), X1
+	MOVUPS 32(SI), X2
+	MOVUPS 48(SI), X3
+
+	MOVAPS   X0, X4
+	UNPCKLPS X1, X4
+	UNPCKHPS X1, X0
+	MOVAPS   X2, X5
+	UNPCKLPS X3, X5
+	UNPCKHPS X3, X2
+
+	MOVAPS  X4, X1
+	MOVLHPS X5, X1
+	MOVHLPS X4, X5
+	MOVAPS  X0, X3
+	MOVLHPS X2, X3
+	MOVHLPS X0, X2
+
+	MOVUPS X1, 0(DI)
+	MOVUPS X5, 16(DI)
+	MOVUPS X3, 32(DI)
+	MOVUPS X2, 48(DI)
+	RET
+
+// 2x2 block inverse, the columns are treated as rows which gives the
+// transposed inverse of the transpose, that is the same inverse
+//
+// func mat4InverseSIMD(out, m *Mat4) float32
+TEXT ·mat4InverseSIMD(SB), NOSPLIT, $0-20
+	MOVQ out+0(FP), DI
+	MOVQ m+8(FP), SI
+
+	MOVUPS 0(SI), X0
+	MOVUPS 16(SI), X1
+	MOVUPS 32(SI), X2
+	MOVUPS 48(SI), X3
+
+	// determinants of the 2x2 blocks A, B, C, D in X8
+	MOVAPS X0, X8
+	SHUFPS $0x88, X2, X8
+	MOVAPS X1, X9
+	SHUFPS $0xDD, X3, X9
+	MULPS  X9, X8
+	MOVAPS X0, X10
+	SHUFPS $0xDD, X2, X10
+	MOVAPS X1, X11
+	SHUFPS $0x88, X3, X11
+	MULPS  X11, X10
+	SUBPS  X10, X8
+
+	// blocks A, B, C, D in X4 to X7
+	MOVAPS  X0, X4
+	MOVLHPS X1, X4
+	MOVAPS  X1, X5
+	MOVHLPS X0, X5
+	MOVAPS  X2, X6
+	MOVLHPS X3, X6
+	MOVAPS  X3, X7
+	MOVHLPS X2, X7
+
+	// |A|, |B|, |C|, |D| broadcast in X0 to X3
+	MOVAPS X8, X0
+	SHUFPS $0x00, X0, X0
+	MOVAPS X8, X1
+	SHUFPS $0x55, X1, X1
+	MOVAPS X8, X2
+	SHUFPS $0xAA, X2, X2
+	MOVAPS X8, X3
+	SHUFPS $0xFF, X3, X3
+
+	// adj(D) C in X8
+	MOVAPS X7, X8
+	SHUFPS $0x0F, X8, X8
+	MULPS  X6, X8
+	MOVAPS X7, X9
+	SHUFPS $0xA5, X9, X9
+	MOVAPS X6, X10
+	SHUFPS $0x4E, X10, X10
+	MULPS  X10, X9
+	SUBPS  X9, X8
+
+	// adj(A) B in X9
+	MOVAPS X4, X9
+	SHUFPS $0x0F, X9, X9
+	MULPS  X5, X9
+	MOVAPS X4, X10
+	SHUFPS $0xA5, X10, X10
+	MOVAPS X5, X11
+	SHUFPS $0x4E, X11, X11
+	MULPS  X11, X10
+	SUBPS  X10, X9
+
+	// X = |D| A - B adj(D) C in X10
+	MOVAPS X8, X11
+	SHUFPS $0xCC, X11, X11
+	MULPS  X5, X11
+	MOVAPS X5, X12
+	SHUFPS $0xB1, X12, X12
+	MOVAPS X8, X13
+	SHUFPS $0x66, X13, X13
+	MULPS  X13, X12
+	ADDPS  X12, X11
+	MOVAPS X3, X10
+	MULPS  X4, X10
+	SUBPS  X11, X10
+
+	// W = |A| D - C adj(A) B in X11
+	MOVAPS X9, X12
+	SHUFPS $0xCC, X12, X12
+	MULPS  X6, X12
+	MOVAPS X6, X13
+	SHUFPS $0xB1, X13, X13
+	MOVAPS X9, X14
+	SHUFPS $0x66, X14, X14
+	MULPS  X14, X13
+	ADDPS  X13, X12
+	MOVAPS X0, X11
+	MULPS  X7, X11
+	SUBPS  X12, X11
+
+	// Y = |B| C - D adj(adj(A) B) in X12
+	MOVAPS X9, X13
+	SHUFPS $0x33, X13, X13
+	MULPS  X7, X13
+	MOVAPS X7, X14
+	SHUFPS $0xB1, X14, X14
+	MOVAPS X9, X15
+	SHUFPS $0x66, X15, X15
+	MULPS  X15, X14
+	SUBPS  X14, X13
+	MOVAPS X1, X12
+	MULPS  X6, X12
+	SUBPS  X13, X12
+
+	// Z = |C| B - A adj(adj(D) C) in X13
+	MOVAPS X8, X14
+	SHUFPS $0x33, X14, X14
+	MULPS  X4, X14
+	MOVAPS X4, X15
+	SHUFPS $0xB1, X15, X15
+	MOVAPS X8, X7
+	SHUFPS $0x66, X7, X7
+	MULPS  X7, X15
+	SUBPS  X15, X14
+	MOVAPS X2, X13
+	MULPS  X5, X13
+	SUBPS  X14, X13
+
+	// |M| = |A||D| + |B||C| - tr(adj(A) B adj(D) C) broadcast in X0
+	MULPS  X3, X0
+	MULPS  X2, X1
+	ADDPS  X1, X0
+	MOVAPS X8, X2
+	SHUFPS $0xD8, X2, X2
+	MULPS  X9, X2
+	MOVAPS X2, X3
+	SHUFPS $0xB1, X3, X3
+	ADDPS  X3, X2
+	MOVAPS X2, X3
+	SHUFPS $0x4E, X3, X3
+	ADDPS  X3, X2
+	SUBPS  X2, X0
+	MOVSS  X0, ret+16(FP)
+
+	MOVUPS adjSign<>(SB), X1
+	DIVPS  X0, X1
+	MULPS  X1, X10
+	MULPS  X1, X11
+	MULPS  X1, X12
+	MULPS  X1, X13
+
+	// adjugate of the blocks back into columns
+	MOVAPS X10, X2
+	SHUFPS $0x77, X12, X2
+	MOVAPS X10, X3
+	SHUFPS $0x22, X12, X3
+	MOVAPS X13, X4
+	SHUFPS $0x77, X11, X4
+	MOVAPS X13, X5
+	SHUFPS $0x22, X11, X5
+
+	MOVUPS X2, 0(DI)
+	MOVUPS X3, 16(DI)
+	MOVUPS X4, 32(DI)
+	MOVUPS X5, 48(DI)
+	RET
+
+// func transformVec4sSSE(dst, src *Vec4, n int, m *Mat4)
+TEXT ·transformVec4sSSE(SB), NOSPLIT, $0-32
+	MOVQ dst+0(FP), DI
+	MOVQ src+8(FP), SI
+	MOVQ n+16(FP), CX
+	MOVQ m+24(FP), DX
+
+	MOVUPS 0(DX), X0
+	MOVUPS 16(DX), X1
+	MOVUPS 32(DX), X2
+	MOVUPS 48(DX), X3
+
+	TESTQ CX, CX
+	JLE   sseDone
+
+sseLoop:
+	MOVUPS (SI), X4
+	MOVAPS X4, X5
+	SHUFPS $0x00, X5, X5
+	MULPS  X0, X5
+	MOVAPS X4, X6
+	SHUFPS $0x55, X6, X6
+	MULPS  X1, X6
+	ADDPS  X6, X5
+	MOVAPS X4, X6
+	SHUFPS $0xAA, X6, X6
+	MULPS  X2, X6
+	ADDPS  X6, X5
+	SHUFPS $0xFF, X4, X4
+	MULPS  X3, X4
+	ADDPS  X4, X5
+	MOVUPS X5, (DI)
+	ADDQ   $16, SI
+	ADDQ   $16, DI
+	DECQ   CX
+	JNZ    sseLoop
+
+sseDone:
+	RET
+
+// func transformVec4sAVX(dst, src *Vec4, n int, m *Mat4)
+TEXT ·transformVec4sAVX(SB), NOSPLIT, $0-32
+	MOVQ dst+0(FP), DI
+	MOVQ src+8(FP), SI
+	MOVQ n+16(FP), CX
+	MOVQ m+24(FP), DX
+
+	VBROADCASTF128 0(DX), Y0
+	VBROADCASTF128 16(DX), Y1
+	VBROADCASTF128 32(DX), Y2
+	VBROADCASTF128 48(DX), Y3
+
+	CMPQ CX, $2
+	JLT  avxTail
+
+avxLoop:
+	VMOVUPS   (SI), Y4
+	VPERMILPS $0x00, Y4, Y5
+	VMULPS    Y0, Y5, Y5
+	VPERMILPS $0x55, Y4, Y6
+	VMULPS    Y1, Y6, Y6
+	VADDPS    Y6, Y5, Y5
+	VPERMILPS $0xAA, Y4, Y6
+	VMULPS    Y2, Y6, Y6
+	VADDPS    Y6, Y5, Y5
+	VPERMILPS $0xFF, Y4, Y6
+	VMULPS    Y3, Y6, Y6
+	VADDPS    Y6, Y5, Y5
+	VMOVUPS   Y5, (DI)
+	ADDQ      $32, SI
+	ADDQ      $32, DI
+	SUBQ      $2, CX
+	CMPQ      CX, $2
+	JGE       avxLoop
+
+avxTail:
+	TESTQ CX, CX
+	JLE   avxDone
+
+	VMOVUPS   (SI), X4
+	VPERMILPS $0x00, X4, X5
+	VMULPS    X0, X5, X5
+	VPERMILPS $0x55, X4, X6
+	VMULPS    X1, X6, X6
+	VADDPS    X6, X5, X5
+	VPERMILPS $0xAA, X4, X6
+	VMULPS    X2, X6, X6
+	VADDPS    X6, X5, X5
+	VPERMILPS $0xFF, X4, X6
+	VMULPS    X3, X6, X6
+	VADDPS    X6, X5, X5
+	VMOVUPS   X5, (DI)
+
+avxDone:
+	VZEROUPPER
+	RET
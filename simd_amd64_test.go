@@ -0,0 +1,19 @@
+//go:build amd64 && !purego
+
+package mathf
+
+// runs f with the pure Go paths
+func withScalar(f func()) {
+	defer func(simd bool) { useSIMD = simd }(useSIMD)
+
+	useSIMD = false
+	f()
+}
+
+// runs f with the SSE paths even when the cpu supports AVX
+func withoutAVX(f func()) {
+	defer func(avx bool) { hasAVX = avx }(hasAVX)
+
+	hasAVX = false
+	f()
+}
@@ -0,0 +1,33 @@
+//go:build !amd64 || purego
+
+package mathf
+
+import (
+	"unsafe"
+)
+
+// no assembly on this platform, the pure Go paths are always used
+const useSIMD = false
+
+func mat4MulSIMD(out, a, b *Mat4) {
+
+	out.MMul(a, b)
+}
+
+func mat4InverseSIMD(out, m *Mat4) float32 {
+	det := m.Determinant()
+
+	out.MInverse(m)
+
+	return det
+}
+
+func mat4TransposeSIMD(out, m *Mat4) {
+
+	out.Copy(m).Transpose()
+}
+
+func transformVec4sSIMD(dst, src *Vec4, n int, m *Mat4) {
+
+	TransformVec4s(unsafe.Slice(dst, n), unsafe.Slice(src, n), m)
+}
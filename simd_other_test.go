@@ -0,0 +1,15 @@
+//go:build !amd64 || purego
+
+package mathf
+
+// runs f with the pure Go paths, which are the only paths on this platform
+func withScalar(f func()) {
+
+	f()
+}
+
+// runs f, there is no AVX path on this platform
+func withoutAVX(f func()) {
+
+	f()
+}
@@ -0,0 +1,116 @@
+package mathf
+
+import (
+	"testing"
+)
+
+// matrices the SIMD and pure Go paths are compared on
+var simdMat4Tests = []struct {
+	name string
+	m    Mat4
+}{
+	{"identity", Mat4{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1}},
+	{"translation", Mat4{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, 5, -6, 7, 1}},
+	{"compose", *NewMat4().Compose(&Vec3{1, 2, 3}, &Vec3{2, 0.5, 3}, NewQuat().FromAxisAngle(NewVec3(1, 2, 3).Normalize(), 0.7))},
+	{"perspective", *NewMat4().MakePerspective(Pi*0.5, 16.0/9, 0.1, 1000)},
+	{"general", Mat4{4, -2, 1, 0.5, 3, 6, -4, 2, 2, 1, 8, -3, 1, -7, 2, 9}},
+	{"singular", Mat4{1, 2, 3, 4, 2, 4, 6, 8, 0, 1, 0, 1, 5, 3, 2, 1}},
+	{"zero", Mat4{}},
+}
+
+func TestMat4SIMD(t *testing.T) {
+
+	for _, a := range simdMat4Tests {
+		for _, b := range simdMat4Tests {
+			var got, want Mat4
+
+			got.MMul(&a.m, &b.m)
+			withScalar(func() { want.MMul(&a.m, &b.m) })
+
+			if !got.EqualsRel(&want, 1e-6) {
+				t.Errorf("%s MMul %s: SIMD = %v, scalar = %v", a.name, b.name, got, want)
+			}
+
+			got = a.m
+			want = a.m
+			got.Mul(&b.m)
+			withScalar(func() { want.Mul(&b.m) })
+
+			if !got.EqualsRel(&want, 1e-6) {
+				t.Errorf("%s Mul %s: SIMD = %v, scalar = %v", a.name, b.name, got, want)
+			}
+		}
+
+		var got, want Mat4
+
+		got.MInverse(&a.m)
+		withScalar(func() { want.MInverse(&a.m) })
+
+		if !got.EqualsRel(&want, 1e-5) {
+			t.Errorf("%s MInverse: SIMD = %v, scalar = %v", a.name, got, want)
+		}
+
+		got = a.m
+		want = a.m
+		got.Inverse()
+		withScalar(func() { want.Inverse() })
+
+		if !got.EqualsRel(&want, 1e-5) {
+			t.Errorf("%s Inverse: SIMD = %v, scalar = %v", a.name, got, want)
+		}
+
+		got = a.m
+		want = a.m
+		got.Transpose()
+		withScalar(func() { want.Transpose() })
+
+		if got != want {
+			t.Errorf("%s Transpose: SIMD = %v, scalar = %v", a.name, got, want)
+		}
+	}
+}
+
+func TestTransformVec4sSIMD(t *testing.T) {
+	src := make([]Vec4, 11)
+
+	for i := range src {
+		f := float32(i)
+		src[i] = Vec4{f - 5, f * 0.5, -f, 1}
+	}
+
+	for _, test := range simdMat4Tests {
+		// lengths around the 1 and 2 vector steps of the kernels
+		for _, n := range []int{0, 1, 2, 3, 8, 11} {
+			want := make([]Vec4, n)
+			withScalar(func() { TransformVec4s(want, src[:n], &test.m) })
+
+			paths := []struct {
+				name string
+				run  func(f func())
+			}{
+				{"SIMD", func(f func()) { f() }},
+				{"SSE", withoutAVX},
+			}
+
+			for _, path := range paths {
+				got := make([]Vec4, n)
+				path.run(func() { TransformVec4s(got, src[:n], &test.m) })
+
+				for i := range got {
+					if !got[i].EqualsRel(&want[i], 1e-6) {
+						t.Errorf("%s %s TransformVec4s[%d] of %d = %v, scalar = %v", test.name, path.name, i, n, got[i], want[i])
+					}
+				}
+
+				inPlace := append([]Vec4(nil), src[:n]...)
+				path.run(func() { TransformVec4s(inPlace, inPlace, &test.m) })
+
+				for i := range inPlace {
+					if !inPlace[i].EqualsRel(&want[i], 1e-6) {
+						t.Errorf("%s %s in place TransformVec4s[%d] of %d = %v, scalar = %v", test.name, path.name, i, n, inPlace[i], want[i])
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,427 @@
+package mathf
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// bounding sphere represented by a center and radius
+type Sphere struct {
+	Center *Vec3
+	Radius float32
+}
+
+// returns new Sphere
+func NewSphere(center *Vec3, radius float32) *Sphere {
+	this := new(Sphere)
+
+	this.Center = new(Vec3).Copy(center)
+	this.Radius = radius
+
+	return this
+}
+
+// returns a copy of this
+func (this *Sphere) Clone() *Sphere {
+
+	return NewSphere(this.Center, this.Radius)
+}
+
+// copies other
+func (this *Sphere) Copy(other *Sphere) *Sphere {
+
+	this.Center.Copy(other.Center)
+	this.Radius = other.Radius
+
+	return this
+}
+
+// sets this from values
+func (this *Sphere) Set(center *Vec3, radius float32) *Sphere {
+
+	this.Center.Copy(center)
+	this.Radius = radius
+
+	return this
+}
+
+// returns this as empty
+func (this *Sphere) Empty() *Sphere {
+
+	this.Center.Set(0, 0, 0)
+	this.Radius = -1
+
+	return this
+}
+
+// checks if this is empty
+func (this *Sphere) IsEmpty() bool {
+
+	return this.Radius < 0
+}
+
+// expands this to contain point
+func (this *Sphere) ExpandPoint(v *Vec3) *Sphere {
+
+	if this.Radius < 0 {
+		this.Center.Copy(v)
+		this.Radius = 0
+
+		return this
+	}
+
+	d := this.Center.DistanceTo(v)
+	if d <= this.Radius {
+		return this
+	}
+
+	r := (this.Radius + d) * 0.5
+	this.Center.Lerp(v, (r-this.Radius)/d)
+	this.Radius = r
+
+	return this
+}
+
+// merges this and other
+func (this *Sphere) Union(other *Sphere) *Sphere {
+
+	if other.Radius < 0 {
+		return this
+	}
+	if this.Radius < 0 {
+		return this.Copy(other)
+	}
+
+	d := this.Center.DistanceTo(other.Center)
+
+	if d+other.Radius <= this.Radius {
+		return this
+	}
+	if d+this.Radius <= other.Radius {
+		return this.Copy(other)
+	}
+
+	r := (d + this.Radius + other.Radius) * 0.5
+	this.Center.Lerp(other.Center, (r-this.Radius)/d)
+	this.Radius = r
+
+	return this
+}
+
+// checks if sphere contains point
+func (this *Sphere) Contains(v *Vec3) bool {
+
+	return this.Center.DistanceToSq(v) <= this.Radius*this.Radius
+}
+
+// checks if this and other intersect
+func (this *Sphere) Intersects(other *Sphere) bool {
+	r := this.Radius + other.Radius
+
+	return this.Center.DistanceToSq(other.Center) <= r*r
+}
+
+// checks if this and box intersect
+func (this *Sphere) IntersectsAABB3(box *AABB3) bool {
+	var p Vec3
+
+	p.Copy(this.Center).Clamp(box.Min, box.Max)
+
+	return p.DistanceToSq(this.Center) <= this.Radius*this.Radius
+}
+
+// checks if this and plane intersect
+func (this *Sphere) IntersectsPlane(plane *Plane) bool {
+
+	return Abs(plane.DistanceToPoint(this.Center)) <= this.Radius
+}
+
+// sets this to the sphere enclosing box
+func (this *Sphere) FromAABB3(box *AABB3) *Sphere {
+
+	this.Center.VAdd(box.Min, box.Max).SMul(0.5)
+	this.Radius = this.Center.DistanceTo(box.Max)
+
+	return this
+}
+
+// transforms this by Mat4, radius is scaled by the largest axis scale
+func (this *Sphere) ApplyMat4(m *Mat4) *Sphere {
+	sx := m[0]*m[0] + m[1]*m[1] + m[2]*m[2]
+	sy := m[4]*m[4] + m[5]*m[5] + m[6]*m[6]
+	sz := m[8]*m[8] + m[9]*m[9] + m[10]*m[10]
+
+	s := sx
+	if sy > s {
+		s = sy
+	}
+	if sz > s {
+		s = sz
+	}
+
+	this.Center.ApplyMat4(m)
+	this.Radius *= float32(math.Sqrt(float64(s)))
+
+	return this
+}
+
+// sets this from array of points using Ritter's approximate bounding sphere
+func (this *Sphere) FromPoints(array []*Vec3) *Sphere {
+	l := len(array)
+
+	if l == 0 {
+		return this.Empty()
+	}
+
+	x := array[0]
+	y := x
+	d := float32(0)
+
+	for i := 1; i < l; i++ {
+		if dd := x.DistanceToSq(array[i]); dd > d {
+			y, d = array[i], dd
+		}
+	}
+
+	z := y
+	d = 0
+
+	for i := 0; i < l; i++ {
+		if dd := y.DistanceToSq(array[i]); dd > d {
+			z, d = array[i], dd
+		}
+	}
+
+	this.Center.VAdd(y, z).SMul(0.5)
+	this.Radius = float32(math.Sqrt(float64(d))) * 0.5
+
+	for i := 0; i < l; i++ {
+		this.ExpandPoint(array[i])
+	}
+
+	return this
+}
+
+// sets this from array of points using Welzl's minimal enclosing sphere
+func (this *Sphere) FromPointsMinimal(array []*Vec3) *Sphere {
+	l := len(array)
+
+	if l == 0 {
+		return this.Empty()
+	}
+
+	points := make([]*Vec3, l)
+	copy(points, array)
+
+	for i := l - 1; i > 0; i-- {
+		j := rand.Intn(i + 1)
+		points[i], points[j] = points[j], points[i]
+	}
+
+	var boundary [4]*Vec3
+
+	return this.welzl(points, l, boundary[:0])
+}
+
+// minimal sphere of the first n points with boundary on its surface
+func (this *Sphere) welzl(points []*Vec3, n int, boundary []*Vec3) *Sphere {
+
+	this.fromBoundary(boundary)
+
+	if len(boundary) == 4 {
+		return this
+	}
+
+	for i := 0; i < n; i++ {
+		if !this.containsEps(points[i]) {
+			this.welzl(points, i, append(boundary, points[i]))
+		}
+	}
+
+	return this
+}
+
+// checks if point is inside this with a relative tolerance
+func (this *Sphere) containsEps(v *Vec3) bool {
+	r := this.Radius * (1 + 1e-5)
+
+	return this.Radius >= 0 && this.Center.DistanceToSq(v) <= r*r
+}
+
+// sets this to the smallest sphere with up to four points on its surface
+func (this *Sphere) fromBoundary(boundary []*Vec3) *Sphere {
+
+	switch len(boundary) {
+	case 0:
+		return this.Empty()
+	case 1:
+		return this.Set(boundary[0], 0)
+	case 2:
+		this.Center.VAdd(boundary[0], boundary[1]).SMul(0.5)
+		this.Radius = this.Center.DistanceTo(boundary[0])
+		return this
+	case 3:
+		return this.fromTriangle(boundary[0], boundary[1], boundary[2])
+	}
+
+	a, b, c, d := boundary[0], boundary[1], boundary[2], boundary[3]
+	var ab, ac, ad, n Vec3
+
+	ab.VSub(b, a)
+	ac.VSub(c, a)
+	ad.VSub(d, a)
+
+	det := 2 * ab.Dot(n.VCross(&ac, &ad))
+	if Abs(det) < Epsilon {
+		return this.fromTriangle(a, b, c).ExpandPoint(d)
+	}
+
+	lab, lac, lad := ab.LengthSq(), ac.LengthSq(), ad.LengthSq()
+	var t Vec3
+
+	t.VCross(&ac, &ad).SMul(lab)
+	this.Center.Copy(&t)
+	t.VCross(&ad, &ab).SMul(lac)
+	this.Center.Add(&t)
+	t.VCross(&ab, &ac).SMul(lad)
+	this.Center.Add(&t).SDiv(det)
+
+	this.Radius = this.Center.Length()
+	this.Center.Add(a)
+
+	return this
+}
+
+// sets this to the circumsphere of triangle a, b, c
+func (this *Sphere) fromTriangle(a, b, c *Vec3) *Sphere {
+	var ab, ac, n, t Vec3
+
+	ab.VSub(b, a)
+	ac.VSub(c, a)
+	n.VCross(&ab, &ac)
+
+	det := 2 * n.LengthSq()
+	if det < Epsilon {
+		this.Set(a, 0).ExpandPoint(b)
+		return this.ExpandPoint(c)
+	}
+
+	t.VCross(&n, &ab).SMul(ac.LengthSq())
+	this.Center.VCross(&ac, &n).SMul(ab.LengthSq()).Add(&t).SDiv(det)
+
+	this.Radius = this.Center.Length()
+	this.Center.Add(a)
+
+	return this
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *Sphere) Equals(other *Sphere) bool {
+
+	return this.Center.Equals(other.Center) && this.Radius == other.Radius
+}
+
+// checks if this equals other within absolute tolerance tol
+func (this *Sphere) EqualsTol(other *Sphere, tol float32) bool {
+
+	return this.Center.EqualsTol(other.Center, tol) && EqualsTol(this.Radius, other.Radius, tol)
+}
+
+// checks if this equals other within tol relative to the magnitude of its elements
+func (this *Sphere) EqualsRel(other *Sphere, tol float32) bool {
+
+	return this.Center.EqualsRel(other.Center, tol) && EqualsRel(this.Radius, other.Radius, tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *Sphere) EqualsULP(other *Sphere, ulps int) bool {
+
+	return this.Center.EqualsULP(other.Center, ulps) && EqualsULP(this.Radius, other.Radius, ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *Sphere) MarshalText() ([]byte, error) {
+	e := this.elements()
+
+	return appendStructText(nil, sphereText, e[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *Sphere) UnmarshalText(text []byte) error {
+	var e [4]float32
+
+	if err := parseStructText(text, sphereText, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns this as a JSON array of Center and Radius, implements json.Marshaler
+func (this *Sphere) MarshalJSON() ([]byte, error) {
+	e := this.elements()
+
+	return appendJSON(nil, e[:])
+}
+
+// parses a JSON array of Center and Radius into this, implements json.Unmarshaler
+func (this *Sphere) UnmarshalJSON(data []byte) error {
+	var e [4]float32
+
+	if isJSONNull(data) {
+		return nil
+	}
+	if err := parseJSON(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Center and Radius of this as little endian floats, implements encoding.BinaryMarshaler
+func (this *Sphere) MarshalBinary() ([]byte, error) {
+	e := this.elements()
+
+	return appendBinary(nil, e[:]), nil
+}
+
+// parses little endian floats of Center and Radius into this, implements encoding.BinaryUnmarshaler
+func (this *Sphere) UnmarshalBinary(data []byte) error {
+	var e [4]float32
+
+	if err := parseBinary(data, e[:]); err != nil {
+		return err
+	}
+
+	this.setElements(&e)
+
+	return nil
+}
+
+// returns Center and Radius of this as marshaling elements, nil fields are zero
+func (this *Sphere) elements() [4]float32 {
+	center := valueOrZero(this.Center)
+
+	return [4]float32{center[0], center[1], center[2], this.Radius}
+}
+
+// sets Center and Radius of this from marshaling elements, nil fields are allocated
+func (this *Sphere) setElements(e *[4]float32) {
+	if this.Center == nil {
+		this.Center = new(Vec3)
+	}
+
+	copy(this.Center[:], e[0:3])
+	this.Radius = e[3]
+}
+
+// returns this as string type
+func (this *Sphere) String() string {
+
+	return fmt.Sprintf("Sphere[ Center: %s, Radius: %f ]", this.Center, this.Radius)
+}
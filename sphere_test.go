@@ -0,0 +1,169 @@
+package mathf
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// returns n points from a fixed seed inside the box from -size to size
+func randomPoints3(n int, size float32) []*Vec3 {
+	r := rand.New(rand.NewSource(1))
+	points := make([]*Vec3, n)
+
+	for i := range points {
+		points[i] = NewVec3((r.Float32()*2-1)*size, (r.Float32()*2-1)*size, (r.Float32()*2-1)*size)
+	}
+
+	return points
+}
+
+// checks sphere contains every point with a small tolerance
+func sphereContainsAll(sphere *Sphere, points []*Vec3) bool {
+
+	for _, p := range points {
+		if sphere.Center.DistanceTo(p) > sphere.Radius*(1+1e-5)+1e-5 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestSphereFromPointsMinimal(t *testing.T) {
+	h := float32(math.Sqrt(3))
+	offset := Vec3{2, 0, -1}
+	tetrahedron := []*Vec3{
+		NewVec3(1, 1, 1).Add(&offset), NewVec3(1, -1, -1).Add(&offset),
+		NewVec3(-1, 1, -1).Add(&offset), NewVec3(-1, -1, 1).Add(&offset),
+	}
+	cube := []*Vec3{
+		NewVec3(-1, -1, -1), NewVec3(1, -1, -1), NewVec3(-1, 1, -1), NewVec3(1, 1, -1),
+		NewVec3(-1, -1, 1), NewVec3(1, -1, 1), NewVec3(-1, 1, 1), NewVec3(1, 1, 1),
+	}
+
+	tests := []struct {
+		name   string
+		points []*Vec3
+		want   Sphere
+	}{
+		{"one point", []*Vec3{NewVec3(1, 2, 3)}, Sphere{&Vec3{1, 2, 3}, 0}},
+		{"two points", []*Vec3{NewVec3(1, 2, 3), NewVec3(3, 2, 3)}, Sphere{&Vec3{2, 2, 3}, 1}},
+		{"equilateral triangle", []*Vec3{NewVec3(0, 0, 1), NewVec3(2, 0, 1), NewVec3(1, h, 1)}, Sphere{&Vec3{1, h / 3, 1}, 2 / h}},
+		// the circumsphere of an obtuse triangle is larger than the sphere on its longest edge
+		{"obtuse triangle", []*Vec3{NewVec3(0, 0, 0), NewVec3(4, 0, 0), NewVec3(2, 0.5, 0)}, Sphere{&Vec3{2, 0, 0}, 2}},
+		{"regular tetrahedron", tetrahedron, Sphere{&offset, h}},
+		{"tetrahedron with inner points", append([]*Vec3{NewVec3(2, 0.5, -1), NewVec3(2.5, 0, -0.5)}, tetrahedron...), Sphere{&offset, h}},
+		{"cube", cube, Sphere{&Vec3{}, h}},
+	}
+
+	for _, test := range tests {
+		// the points are shuffled, so run each a few times
+		for i := 0; i < 10; i++ {
+			got := NewSphere(&Vec3{}, 0).FromPointsMinimal(test.points)
+
+			if !got.EqualsTol(&test.want, 1e-5) {
+				t.Errorf("%s FromPointsMinimal() = %v, want %v", test.name, got, &test.want)
+				break
+			}
+		}
+	}
+
+	points := randomPoints3(200, 5)
+	minimal := NewSphere(&Vec3{}, 0).FromPointsMinimal(points)
+	ritter := NewSphere(&Vec3{}, 0).FromPoints(points)
+
+	if !sphereContainsAll(minimal, points) {
+		t.Errorf("FromPointsMinimal() = %v does not contain every point", minimal)
+	}
+	if minimal.Radius > ritter.Radius*(1+1e-5) {
+		t.Errorf("FromPointsMinimal() radius %v is larger than FromPoints() radius %v", minimal.Radius, ritter.Radius)
+	}
+	if got := NewSphere(&Vec3{1, 1, 1}, 1).FromPointsMinimal(nil); !got.IsEmpty() {
+		t.Errorf("FromPointsMinimal(nil) = %v, want empty", got)
+	}
+}
+
+func TestSphereFromPoints(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []*Vec3
+	}{
+		{"one point", []*Vec3{NewVec3(1, 2, 3)}},
+		{"two points", []*Vec3{NewVec3(1, 2, 3), NewVec3(-3, 0, 1)}},
+		{"line", []*Vec3{NewVec3(0, 0, 0), NewVec3(1, 1, 1), NewVec3(5, 5, 5), NewVec3(2, 2, 2)}},
+		{"random", randomPoints3(200, 5)},
+	}
+
+	for _, test := range tests {
+		if got := NewSphere(&Vec3{}, 0).FromPoints(test.points); !sphereContainsAll(got, test.points) {
+			t.Errorf("%s FromPoints() = %v does not contain every point", test.name, got)
+		}
+	}
+
+	if got := NewSphere(&Vec3{1, 1, 1}, 1).FromPoints(nil); !got.IsEmpty() {
+		t.Errorf("FromPoints(nil) = %v, want empty", got)
+	}
+}
+
+func TestSphereExpandPoint(t *testing.T) {
+	tests := []struct {
+		name   string
+		sphere *Sphere
+		p      Vec3
+		want   Sphere
+	}{
+		{"empty", NewSphere(&Vec3{}, 0).Empty(), Vec3{1, 2, 3}, Sphere{&Vec3{1, 2, 3}, 0}},
+		{"inside", NewSphere(&Vec3{}, 2), Vec3{1, 1, 0}, Sphere{&Vec3{}, 2}},
+		{"outside", NewSphere(&Vec3{}, 1), Vec3{3, 0, 0}, Sphere{&Vec3{1, 0, 0}, 2}},
+	}
+
+	for _, test := range tests {
+		if got := test.sphere.ExpandPoint(&test.p); !got.EqualsTol(&test.want, 1e-6) {
+			t.Errorf("%s ExpandPoint(%v) = %v, want %v", test.name, test.p, got, &test.want)
+		}
+	}
+}
+
+func TestSphereUnion(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *Sphere
+		want Sphere
+	}{
+		{"disjoint", NewSphere(&Vec3{}, 1), NewSphere(&Vec3{4, 0, 0}, 1), Sphere{&Vec3{2, 0, 0}, 3}},
+		{"overlapping", NewSphere(&Vec3{}, 2), NewSphere(&Vec3{0, 2, 0}, 1), Sphere{&Vec3{0, 0.5, 0}, 2.5}},
+		{"other inside", NewSphere(&Vec3{}, 3), NewSphere(&Vec3{1, 0, 0}, 1), Sphere{&Vec3{}, 3}},
+		{"this inside", NewSphere(&Vec3{1, 0, 0}, 1), NewSphere(&Vec3{}, 3), Sphere{&Vec3{}, 3}},
+		{"other empty", NewSphere(&Vec3{1, 0, 0}, 1), NewSphere(&Vec3{}, 0).Empty(), Sphere{&Vec3{1, 0, 0}, 1}},
+		{"this empty", NewSphere(&Vec3{}, 0).Empty(), NewSphere(&Vec3{1, 0, 0}, 1), Sphere{&Vec3{1, 0, 0}, 1}},
+	}
+
+	for _, test := range tests {
+		if got := test.a.Clone().Union(test.b); !got.EqualsTol(&test.want, 1e-6) {
+			t.Errorf("%s %v.Union(%v) = %v, want %v", test.name, test.a, test.b, got, &test.want)
+		}
+	}
+}
+
+func TestSphereIntersects(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *Sphere
+		want bool
+	}{
+		{"overlapping", NewSphere(&Vec3{}, 1), NewSphere(&Vec3{1, 1, 0}, 1), true},
+		{"touching", NewSphere(&Vec3{}, 1), NewSphere(&Vec3{0, 0, 3}, 2), true},
+		{"separated", NewSphere(&Vec3{}, 1), NewSphere(&Vec3{0, 0, 3.01}, 2), false},
+		{"contained", NewSphere(&Vec3{}, 5), NewSphere(&Vec3{1, 0, 0}, 1), true},
+	}
+
+	for _, test := range tests {
+		if got := test.a.Intersects(test.b); got != test.want {
+			t.Errorf("%s %v.Intersects(%v) = %v, want %v", test.name, test.a, test.b, got, test.want)
+		}
+		if got := test.b.Intersects(test.a); got != test.want {
+			t.Errorf("%s %v.Intersects(%v) = %v, want %v", test.name, test.b, test.a, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package mathf
+
+import (
+	"math"
+)
+
+// AgX log2 encoding range in stops around middle grey
+const (
+	agxMinEV = -12.47393
+	agxMaxEV = 4.026069
+)
+
+// returns exposure value at ISO 100 from aperture f-number, shutter time in seconds and ISO sensitivity
+func EV100(aperture, shutter, iso float32) float32 {
+
+	return float32(math.Log2(float64(aperture*aperture/shutter) * 100 / float64(iso)))
+}
+
+// returns exposure scale from EV100 so that the saturation based sensor response maps to 1
+func EV100ToExposure(ev100 float32) float32 {
+
+	return float32(1 / (1.2 * math.Exp2(float64(ev100))))
+}
+
+// scales rgb of this by 2 to the power of ev stops, alpha is unchanged
+func (this *ColorT[T]) Expose(ev T) *ColorT[T] {
+	s := T(math.Exp2(float64(ev)))
+
+	this[0] *= s
+	this[1] *= s
+	this[2] *= s
+
+	return this
+}
+
+// returns relative luminance of this with Rec.709 primaries, this should be linear
+func (this *ColorT[T]) Luminance() T {
+
+	return 0.2126*this[0] + 0.7152*this[1] + 0.0722*this[2]
+}
+
+// returns relative luminance of this with Rec.2020 primaries, this should be linear
+func (this *ColorT[T]) Luminance2020() T {
+
+	return 0.2627*this[0] + 0.6780*this[1] + 0.0593*this[2]
+}
+
+// tone maps linear rgb of this per channel with x / (1 + x), alpha is unchanged
+func (this *ColorT[T]) ToneMapReinhard() *ColorT[T] {
+
+	for i := 0; i < 3; i++ {
+		this[i] /= 1 + this[i]
+	}
+
+	return this
+}
+
+// tone maps linear rgb of this per channel with Reinhard, values at white map to 1, alpha is unchanged
+func (this *ColorT[T]) ToneMapReinhardExtended(white T) *ColorT[T] {
+	w := 1 / (white * white)
+
+	for i := 0; i < 3; i++ {
+		x := this[i]
+		this[i] = x * (1 + x*w) / (1 + x)
+	}
+
+	return this
+}
+
+// tone maps linear rgb of this with Narkowicz's ACES filmic fit, result is clamped to 0 to 1, alpha is unchanged
+func (this *ColorT[T]) ToneMapACES() *ColorT[T] {
+
+	for i := 0; i < 3; i++ {
+		x := this[i]
+		this[i] = clamp01(x * (2.51*x + 0.03) / (x*(2.43*x+0.59) + 0.14))
+	}
+
+	return this
+}
+
+// tone maps linear rgb of this with the Uncharted 2 filmic curve by John Hable, values at white map to 1, alpha is unchanged
+func (this *ColorT[T]) ToneMapHable(white T) *ColorT[T] {
+	w := 1 / hable(white)
+
+	for i := 0; i < 3; i++ {
+		this[i] = hable(this[i]) * w
+	}
+
+	return this
+}
+
+// returns Uncharted 2 filmic curve at x
+func hable[T Float](x T) T {
+	const a, b, c, d, e, f = 0.15, 0.50, 0.10, 0.20, 0.02, 0.30
+
+	return (x*(a*x+c*b)+d*e)/(x*(a*x+b)+d*f) - e/f
+}
+
+// tone maps linear Rec.709 rgb of this with the AgX base sigmoid, result is linear from 0 to 1, alpha is unchanged
+func (this *ColorT[T]) ToneMapAgX() *ColorT[T] {
+	r, g, b := float64(this[0]), float64(this[1]), float64(this[2])
+
+	x := [3]float64{
+		0.842479062253094*r + 0.0784335999999992*g + 0.0792237451477643*b,
+		0.0423282422610123*r + 0.878468636469772*g + 0.0791661274605434*b,
+		0.0423756549057051*r + 0.0784336*g + 0.879142973793104*b,
+	}
+
+	for i := range x {
+		x[i] = clamp(math.Log2(math.Max(x[i], 1e-10)), agxMinEV, agxMaxEV)
+		x[i] = agxContrast((x[i] - agxMinEV) / (agxMaxEV - agxMinEV))
+	}
+
+	r, g, b = x[0], x[1], x[2]
+
+	x = [3]float64{
+		1.19687900512017*r - 0.0980208811401368*g - 0.0990297440797205*b,
+		-0.0528968517574562*r + 1.15190312990417*g - 0.0989611768448433*b,
+		-0.0529716355144438*r - 0.0980434501171241*g + 1.15107367264116*b,
+	}
+
+	for i := range x {
+		this[i] = T(math.Pow(clamp01(x[i]), 2.2))
+	}
+
+	return this
+}
+
+// returns polynomial fit of the AgX default contrast sigmoid at encoded x
+func agxContrast(x float64) float64 {
+	x2 := x * x
+	x4 := x2 * x2
+
+	return 15.5*x4*x2 - 40.14*x4*x + 31.96*x4 - 6.868*x2*x + 0.4298*x2 + 0.1191*x - 0.00232
+}
+
+// sets rgb of this as linear from blackbody temperature in kelvin clamped to 1667 to 25000, brightest channel is 1, alpha is unchanged
+func (this *ColorT[T]) FromTemperature(kelvin T) *ColorT[T] {
+	t := clamp(float64(kelvin), 1667, 25000)
+	t2 := t * t
+	t3 := t2 * t
+	var x, y float64
+
+	if t <= 4000 {
+		x = -0.2661239e9/t3 - 0.2343589e6/t2 + 0.8776956e3/t + 0.179910
+	} else {
+		x = -3.0258469e9/t3 + 2.1070379e6/t2 + 0.2226347e3/t + 0.240390
+	}
+
+	x2 := x * x
+	x3 := x2 * x
+
+	if t <= 2222 {
+		y = -1.1063814*x3 - 1.34811020*x2 + 2.18555832*x - 0.20219683
+	} else if t <= 4000 {
+		y = -0.9549476*x3 - 1.37418593*x2 + 2.09137015*x - 0.16748867
+	} else {
+		y = 3.0817580*x3 - 5.87338670*x2 + 3.75112997*x - 0.37001483
+	}
+
+	xyz := Vec3T[T]{T(x / y), 1, T((1 - x - y) / y)}
+	this.FromXYZ(&xyz)
+
+	max := T(0)
+
+	for i := 0; i < 3; i++ {
+		this[i] = T(math.Max(float64(this[i]), 0))
+
+		if this[i] > max {
+			max = this[i]
+		}
+	}
+	for i := 0; i < 3; i++ {
+		this[i] /= max
+	}
+
+	return this
+}
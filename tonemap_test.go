@@ -0,0 +1,140 @@
+package mathf
+
+import (
+	"testing"
+)
+
+func TestColorLuminance(t *testing.T) {
+	tests := []struct {
+		c               Color
+		rec709, rec2020 float32
+	}{
+		{Color{1, 1, 1, 1}, 1, 1},
+		{Color{0, 0, 0, 1}, 0, 0},
+		{Color{1, 0, 0, 1}, 0.2126, 0.2627},
+		{Color{0, 1, 0, 0}, 0.7152, 0.6780},
+		{Color{0, 0, 1, 1}, 0.0722, 0.0593},
+	}
+
+	for _, test := range tests {
+		if got := test.c.Luminance(); !EqualsTol(got, test.rec709, 1e-6) {
+			t.Errorf("%v.Luminance() = %v, want %v", test.c, got, test.rec709)
+		}
+		if got := test.c.Luminance2020(); !EqualsTol(got, test.rec2020, 1e-6) {
+			t.Errorf("%v.Luminance2020() = %v, want %v", test.c, got, test.rec2020)
+		}
+	}
+}
+
+func TestToneMap(t *testing.T) {
+	tests := []struct {
+		name string
+		op   func(c *Color) *Color
+		x    float32
+		want float32
+	}{
+		{"Reinhard 0", (*Color).ToneMapReinhard, 0, 0},
+		{"Reinhard 1", (*Color).ToneMapReinhard, 1, 0.5},
+		{"Reinhard 3", (*Color).ToneMapReinhard, 3, 0.75},
+		{"ReinhardExtended 1", func(c *Color) *Color { return c.ToneMapReinhardExtended(4) }, 1, 0.53125},
+		{"ReinhardExtended white", func(c *Color) *Color { return c.ToneMapReinhardExtended(4) }, 4, 1},
+		{"ACES 0", (*Color).ToneMapACES, 0, 0},
+		{"ACES 1", (*Color).ToneMapACES, 1, 2.54 / 3.16},
+		{"ACES clamps", (*Color).ToneMapACES, 100, 1},
+		{"Hable 0", func(c *Color) *Color { return c.ToneMapHable(11.2) }, 0, 0},
+		{"Hable 1", func(c *Color) *Color { return c.ToneMapHable(11.2) }, 1, 0.3043006},
+		{"Hable white", func(c *Color) *Color { return c.ToneMapHable(11.2) }, 11.2, 1},
+		{"AgX 0", (*Color).ToneMapAgX, 0, 0},
+		{"AgX middle grey", (*Color).ToneMapAgX, 0.18, 0.2145191},
+		{"AgX 1", (*Color).ToneMapAgX, 1, 0.5901593},
+	}
+
+	for _, test := range tests {
+		c := Color{test.x, test.x, test.x, 0.5}
+		want := Color{test.want, test.want, test.want, 0.5}
+
+		if got := test.op(&c); !got.EqualsTol(&want, 5e-4) {
+			t.Errorf("%s of %v = %v, want %v", test.name, test.x, *got, want)
+		}
+	}
+
+	// every curve is monotonic and stays in 0 to 1 up to the smallest white point
+	for _, test := range tests {
+		prev := float32(-1)
+
+		for x := float32(0); x <= 4; x = x*1.5 + 0.01 {
+			c := Color{x, x, x, 1}
+
+			got := test.op(&c)[0]
+
+			if got < prev-1e-6 || got < 0 || got > 1+1e-6 {
+				t.Errorf("%s of %v = %v after %v, want monotonic in 0 to 1", test.name, x, got, prev)
+				break
+			}
+
+			prev = got
+		}
+	}
+}
+
+func TestExposure(t *testing.T) {
+	tests := []struct {
+		aperture, shutter, iso, want float32
+	}{
+		{1, 1, 100, 0},
+		{16, 0.01, 100, 14.643856},
+		{16, 0.01, 200, 13.643856},
+		{2, 1, 100, 2},
+	}
+
+	for _, test := range tests {
+		if got := EV100(test.aperture, test.shutter, test.iso); !EqualsTol(got, test.want, 1e-5) {
+			t.Errorf("EV100(%v, %v, %v) = %v, want %v", test.aperture, test.shutter, test.iso, got, test.want)
+		}
+	}
+
+	if got := EV100ToExposure(0); !EqualsTol(got, 1/1.2, 1e-6) {
+		t.Errorf("EV100ToExposure(0) = %v, want %v", got, 1/1.2)
+	}
+	if got := EV100ToExposure(1); !EqualsTol(got, 0.5/1.2, 1e-6) {
+		t.Errorf("EV100ToExposure(1) = %v, want %v", got, 0.5/1.2)
+	}
+
+	c := Color{0.5, 0.25, 1, 0.5}
+
+	if got, want := c.Clone().Expose(1), (Color{1, 0.5, 2, 0.5}); !got.EqualsTol(&want, 1e-6) {
+		t.Errorf("%v.Expose(1) = %v, want %v", c, *got, want)
+	}
+	if got, want := c.Clone().Expose(-2), (Color{0.125, 0.0625, 0.25, 0.5}); !got.EqualsTol(&want, 1e-6) {
+		t.Errorf("%v.Expose(-2) = %v, want %v", c, *got, want)
+	}
+}
+
+func TestColorFromTemperature(t *testing.T) {
+	tests := []struct {
+		kelvin float32
+		want   Color
+		tol    float32
+	}{
+		{6500, Color{1, 1, 1, 0.5}, 0.06},
+		{1900, Color{1, 0.2317, 0, 0.5}, 1e-3},
+		{2700, Color{1, 0.4173, 0.1000, 0.5}, 1e-3},
+		{10000, Color{0.6099, 0.6951, 1, 0.5}, 1e-3},
+	}
+
+	for _, test := range tests {
+		c := Color{0, 0, 0, 0.5}
+
+		if got := c.FromTemperature(test.kelvin); !got.EqualsTol(&test.want, test.tol) {
+			t.Errorf("FromTemperature(%v) = %v, want %v", test.kelvin, *got, test.want)
+		}
+	}
+
+	// temperatures outside the fit are clamped
+	low, min := new(Color).FromTemperature(1000), new(Color).FromTemperature(1667)
+	high, max := new(Color).FromTemperature(40000), new(Color).FromTemperature(25000)
+
+	if !low.Equals(min) || !high.Equals(max) {
+		t.Errorf("FromTemperature outside 1667 to 25000 = %v %v, want %v %v", *low, *high, *min, *max)
+	}
+}
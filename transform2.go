@@ -0,0 +1,291 @@
+package mathf
+
+import (
+	"fmt"
+)
+
+// 2D scene graph node with local position, rotation and scale, matrices are cached until changed
+type Transform2 struct {
+	position, scale *Vec2
+	rotation        float32
+	parent          *Transform2
+	children        []*Transform2
+	local, world    *Mat32
+	localDirty      bool
+	worldDirty      bool
+}
+
+// returns new identity Transform2
+func NewTransform2() *Transform2 {
+	this := new(Transform2)
+
+	this.position = NewVec2(0, 0)
+	this.scale = NewVec2(1, 1)
+	this.local = NewMat32()
+	this.world = NewMat32()
+
+	return this
+}
+
+// returns parent of this, nil if this is a root
+func (this *Transform2) Parent() *Transform2 {
+
+	return this.parent
+}
+
+// returns children of this, should not be modified
+func (this *Transform2) Children() []*Transform2 {
+
+	return this.children
+}
+
+// sets parent of this, nil detaches this, if keepWorld the world transform is preserved
+func (this *Transform2) SetParent(parent *Transform2, keepWorld bool) *Transform2 {
+	var world Mat32
+
+	for p := parent; p != nil; p = p.parent {
+		if p == this {
+			return this
+		}
+	}
+
+	if keepWorld {
+		world.Copy(this.WorldMatrix())
+	}
+
+	if this.parent != nil {
+		children := this.parent.children
+
+		for i, child := range children {
+			if child == this {
+				this.parent.children = append(children[:i], children[i+1:]...)
+				break
+			}
+		}
+	}
+
+	this.parent = parent
+
+	if parent != nil {
+		parent.children = append(parent.children, this)
+	}
+
+	if keepWorld {
+		return this.SetWorldMatrix(&world)
+	}
+
+	return this.setWorldDirty()
+}
+
+// returns local position of this, saves in target
+func (this *Transform2) GetPosition(target *Vec2) *Vec2 {
+
+	return target.Copy(this.position)
+}
+
+// sets local position of this
+func (this *Transform2) SetPosition(v *Vec2) *Transform2 {
+
+	this.position.Copy(v)
+
+	return this.setLocalDirty()
+}
+
+// returns local rotation of this in radians
+func (this *Transform2) GetRotation() float32 {
+
+	return this.rotation
+}
+
+// sets local rotation of this in radians
+func (this *Transform2) SetRotation(angle float32) *Transform2 {
+
+	this.rotation = angle
+
+	return this.setLocalDirty()
+}
+
+// returns local scale of this, saves in target
+func (this *Transform2) GetScale(target *Vec2) *Vec2 {
+
+	return target.Copy(this.scale)
+}
+
+// sets local scale of this
+func (this *Transform2) SetScale(v *Vec2) *Transform2 {
+
+	this.scale.Copy(v)
+
+	return this.setLocalDirty()
+}
+
+// sets local position, scale and rotation of this
+func (this *Transform2) Set(position, scale *Vec2, rotation float32) *Transform2 {
+
+	this.position.Copy(position)
+	this.scale.Copy(scale)
+	this.rotation = rotation
+
+	return this.setLocalDirty()
+}
+
+// translates this by Vec2 in local space
+func (this *Transform2) Translate(v *Vec2) *Transform2 {
+
+	this.position.Add(v)
+
+	return this.setLocalDirty()
+}
+
+// rotates this by angle in radians
+func (this *Transform2) Rotate(angle float32) *Transform2 {
+
+	this.rotation += angle
+
+	return this.setLocalDirty()
+}
+
+// returns local matrix of this, should not be modified
+func (this *Transform2) LocalMatrix() *Mat32 {
+
+	if this.localDirty {
+		this.local.Compose(this.position, this.scale, this.rotation)
+		this.localDirty = false
+	}
+
+	return this.local
+}
+
+// returns world matrix of this, should not be modified
+func (this *Transform2) WorldMatrix() *Mat32 {
+
+	if this.worldDirty {
+		if this.parent != nil {
+			this.world.MMul(this.parent.WorldMatrix(), this.LocalMatrix())
+		} else {
+			this.world.Copy(this.LocalMatrix())
+		}
+		this.worldDirty = false
+	}
+
+	return this.world
+}
+
+// sets local transform of this from Mat32
+func (this *Transform2) SetLocalMatrix(m *Mat32) *Transform2 {
+
+	this.rotation = m.Decompose(this.position, this.scale)
+
+	return this.setLocalDirty()
+}
+
+// sets local transform of this so its world matrix is m
+func (this *Transform2) SetWorldMatrix(m *Mat32) *Transform2 {
+	var local Mat32
+
+	if this.parent == nil {
+		return this.SetLocalMatrix(m)
+	}
+
+	local.MInverse(this.parent.WorldMatrix()).Mul(m)
+
+	return this.SetLocalMatrix(&local)
+}
+
+// returns world position of this, saves in target
+func (this *Transform2) GetWorldPosition(target *Vec2) *Vec2 {
+	m := this.WorldMatrix()
+
+	return target.Set(m[4], m[5])
+}
+
+// sets local position of this so its world position is v
+func (this *Transform2) SetWorldPosition(v *Vec2) *Transform2 {
+	var inv Mat32
+
+	this.position.Copy(v)
+
+	if this.parent != nil {
+		this.position.ApplyMat32(inv.MInverse(this.parent.WorldMatrix()))
+	}
+
+	return this.setLocalDirty()
+}
+
+// returns world rotation of this in radians
+func (this *Transform2) GetWorldRotation() float32 {
+	angle := this.rotation
+
+	for p := this.parent; p != nil; p = p.parent {
+		angle += p.rotation
+	}
+
+	return angle
+}
+
+// sets local rotation of this so its world rotation is angle in radians
+func (this *Transform2) SetWorldRotation(angle float32) *Transform2 {
+
+	this.rotation = angle
+
+	if this.parent != nil {
+		this.rotation -= this.parent.GetWorldRotation()
+	}
+
+	return this.setLocalDirty()
+}
+
+// returns world scale of this ignoring shear, saves in target
+func (this *Transform2) GetWorldScale(target *Vec2) *Vec2 {
+
+	target.Copy(this.scale)
+
+	for p := this.parent; p != nil; p = p.parent {
+		target.Mul(p.scale)
+	}
+
+	return target
+}
+
+// sets local scale of this so its world scale ignoring shear is v
+func (this *Transform2) SetWorldScale(v *Vec2) *Transform2 {
+	var s Vec2
+
+	this.scale.Copy(v)
+
+	if this.parent != nil {
+		this.scale.Div(this.parent.GetWorldScale(&s))
+	}
+
+	return this.setLocalDirty()
+}
+
+// marks local and world matrices of this as changed
+func (this *Transform2) setLocalDirty() *Transform2 {
+
+	this.localDirty = true
+
+	return this.setWorldDirty()
+}
+
+// marks world matrices of this and its children as changed
+func (this *Transform2) setWorldDirty() *Transform2 {
+
+	if this.worldDirty {
+		return this
+	}
+
+	this.worldDirty = true
+
+	for _, child := range this.children {
+		child.setWorldDirty()
+	}
+
+	return this
+}
+
+// returns this as string type
+func (this *Transform2) String() string {
+
+	return fmt.Sprintf("Transform2[ Position: %s, Scale: %s, Rotation: %f ]", this.position, this.scale, this.rotation)
+}
@@ -0,0 +1,167 @@
+package mathf
+
+import (
+	"testing"
+)
+
+// returns a root, child and grandchild chain where the root is translated, rotated 90 degrees and scaled by 2
+func newTransform2Chain() (root, child, grandchild *Transform2) {
+	root = NewTransform2().Set(&Vec2{1, 2}, &Vec2{2, 2}, HALF_PI)
+	child = NewTransform2().SetPosition(&Vec2{1, 0}).SetParent(root, false)
+	grandchild = NewTransform2().SetPosition(&Vec2{0, 1}).SetParent(child, false)
+
+	return root, child, grandchild
+}
+
+func TestTransform2Hierarchy(t *testing.T) {
+	root, child, grandchild := newTransform2Chain()
+
+	if child.Parent() != root || grandchild.Parent() != child || root.Parent() != nil {
+		t.Fatalf("parents = %p %p %p, want nil %p %p", root.Parent(), child.Parent(), grandchild.Parent(), root, child)
+	}
+	if len(root.Children()) != 1 || root.Children()[0] != child {
+		t.Errorf("root.Children() = %v, want [child]", root.Children())
+	}
+
+	tests := []struct {
+		name string
+		node *Transform2
+		want Vec2
+	}{
+		{"root", root, Vec2{1, 2}},
+		{"child", child, Vec2{1, 4}},
+		{"grandchild", grandchild, Vec2{-1, 4}},
+	}
+
+	for _, test := range tests {
+		var got Vec2
+
+		if test.node.GetWorldPosition(&got); !got.EqualsTol(&test.want, 1e-5) {
+			t.Errorf("%s.GetWorldPosition() = %v, want %v", test.name, got, test.want)
+		}
+
+		p := Vec2{}
+
+		if p.ApplyMat32(test.node.WorldMatrix()); !p.EqualsTol(&test.want, 1e-5) {
+			t.Errorf("%s origin by WorldMatrix() = %v, want %v", test.name, p, test.want)
+		}
+	}
+}
+
+func TestTransform2DirtyPropagation(t *testing.T) {
+	root, child, grandchild := newTransform2Chain()
+
+	// cache every world matrix, then change only the root
+	grandchild.WorldMatrix()
+	root.SetPosition(&Vec2{0, 0})
+
+	if !child.worldDirty || !grandchild.worldDirty {
+		t.Errorf("worldDirty = %v %v after root changed, want true true", child.worldDirty, grandchild.worldDirty)
+	}
+	if child.localDirty || grandchild.localDirty {
+		t.Errorf("localDirty = %v %v after root changed, want false false", child.localDirty, grandchild.localDirty)
+	}
+
+	var got Vec2
+	want := Vec2{-2, 2}
+
+	if grandchild.GetWorldPosition(&got); !got.EqualsTol(&want, 1e-5) {
+		t.Errorf("grandchild.GetWorldPosition() = %v, want %v", got, want)
+	}
+
+	child.Translate(&Vec2{1, 0})
+	want = Vec2{-2, 4}
+
+	if grandchild.GetWorldPosition(&got); !got.EqualsTol(&want, 1e-5) {
+		t.Errorf("grandchild.GetWorldPosition() after child.Translate = %v, want %v", got, want)
+	}
+
+	root.Rotate(HALF_PI)
+	want = Vec2{-4, -2}
+
+	if grandchild.GetWorldPosition(&got); !got.EqualsTol(&want, 1e-5) {
+		t.Errorf("grandchild.GetWorldPosition() after root.Rotate = %v, want %v", got, want)
+	}
+}
+
+func TestTransform2SetWorld(t *testing.T) {
+	_, child, grandchild := newTransform2Chain()
+
+	position := Vec2{5, -3}
+	rotation := float32(0.7)
+	scale := Vec2{3, 4}
+
+	grandchild.SetWorldPosition(&position).SetWorldRotation(rotation).SetWorldScale(&scale)
+
+	var p, s Vec2
+
+	if grandchild.GetWorldPosition(&p); !p.EqualsTol(&position, 1e-5) {
+		t.Errorf("GetWorldPosition() = %v, want %v", p, position)
+	}
+	if got := grandchild.GetWorldRotation(); !EqualsTol(got, rotation, 1e-6) {
+		t.Errorf("GetWorldRotation() = %v, want %v", got, rotation)
+	}
+	if grandchild.GetWorldScale(&s); !s.EqualsTol(&scale, 1e-5) {
+		t.Errorf("GetWorldScale() = %v, want %v", s, scale)
+	}
+
+	// the parent scale is uniform, so the world matrix has exactly this position, rotation and scale
+	if got := grandchild.WorldMatrix().Decompose(&p, &s); !p.EqualsTol(&position, 1e-5) || !s.EqualsTol(&scale, 1e-4) || !EqualsTol(got, rotation, 1e-5) {
+		t.Errorf("WorldMatrix().Decompose() = %v %v %v, want %v %v %v", p, s, got, position, scale, rotation)
+	}
+
+	var local Vec2
+
+	if want := (Vec2{1.5, 2}); !grandchild.GetScale(&local).EqualsTol(&want, 1e-5) {
+		t.Errorf("local scale = %v, want %v", local, want)
+	}
+
+	m := NewMat32().Compose(&Vec2{-4, 1}, &Vec2{1, 2}, 0.3)
+
+	if got := child.SetWorldMatrix(m).WorldMatrix(); !got.EqualsTol(m, 1e-5) {
+		t.Errorf("SetWorldMatrix(%v).WorldMatrix() = %v", *m, *got)
+	}
+}
+
+func TestTransform2SetParent(t *testing.T) {
+	root, child, grandchild := newTransform2Chain()
+	other := NewTransform2().Set(&Vec2{0, -5}, &Vec2{3, 3}, 1)
+
+	var before, after Vec2
+
+	grandchild.GetWorldPosition(&before)
+	rotation := grandchild.GetWorldRotation()
+	grandchild.SetParent(other, true)
+
+	if grandchild.Parent() != other || len(child.Children()) != 0 || len(other.Children()) != 1 {
+		t.Errorf("after SetParent(other) parent = %p, child children %d, other children %d", grandchild.Parent(), len(child.Children()), len(other.Children()))
+	}
+	if grandchild.GetWorldPosition(&after); !after.EqualsTol(&before, 1e-5) {
+		t.Errorf("SetParent(other, true) world position = %v, want %v", after, before)
+	}
+	if got := grandchild.GetWorldRotation(); !EqualsTol(got, rotation, 1e-5) {
+		t.Errorf("SetParent(other, true) world rotation = %v, want %v", got, rotation)
+	}
+
+	// without keepWorld the local transform is kept and the world follows the new parent
+	grandchild.SetParent(nil, false)
+
+	if grandchild.GetPosition(&before); !grandchild.GetWorldPosition(&after).EqualsTol(&before, 1e-5) {
+		t.Errorf("SetParent(nil, false) world position = %v, want local %v", after, before)
+	}
+	if len(other.Children()) != 0 || grandchild.Parent() != nil {
+		t.Errorf("SetParent(nil) left parent %p with %d children", grandchild.Parent(), len(other.Children()))
+	}
+
+	// a node cannot become its own ancestor
+	child.SetParent(root, false)
+	grandchild.SetParent(child, false)
+	root.SetParent(grandchild, false)
+
+	if root.Parent() != nil {
+		t.Errorf("root.SetParent(grandchild) made a cycle, root parent = %p", root.Parent())
+	}
+	if root.SetParent(root, false); root.Parent() != nil {
+		t.Errorf("root.SetParent(root) set parent to %p", root.Parent())
+	}
+}
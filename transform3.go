@@ -0,0 +1,294 @@
+package mathf
+
+import (
+	"fmt"
+)
+
+// 3D scene graph node with local position, rotation and scale, matrices are cached until changed
+type Transform3 struct {
+	position, scale *Vec3
+	rotation        *Quat
+	parent          *Transform3
+	children        []*Transform3
+	local, world    *Mat4
+	localDirty      bool
+	worldDirty      bool
+}
+
+// returns new identity Transform3
+func NewTransform3() *Transform3 {
+	this := new(Transform3)
+
+	this.position = NewVec3(0, 0, 0)
+	this.scale = NewVec3(1, 1, 1)
+	this.rotation = NewQuat()
+	this.local = NewMat4()
+	this.world = NewMat4()
+
+	return this
+}
+
+// returns parent of this, nil if this is a root
+func (this *Transform3) Parent() *Transform3 {
+
+	return this.parent
+}
+
+// returns children of this, should not be modified
+func (this *Transform3) Children() []*Transform3 {
+
+	return this.children
+}
+
+// sets parent of this, nil detaches this, if keepWorld the world transform is preserved
+func (this *Transform3) SetParent(parent *Transform3, keepWorld bool) *Transform3 {
+	var world Mat4
+
+	for p := parent; p != nil; p = p.parent {
+		if p == this {
+			return this
+		}
+	}
+
+	if keepWorld {
+		world.Copy(this.WorldMatrix())
+	}
+
+	if this.parent != nil {
+		children := this.parent.children
+
+		for i, child := range children {
+			if child == this {
+				this.parent.children = append(children[:i], children[i+1:]...)
+				break
+			}
+		}
+	}
+
+	this.parent = parent
+
+	if parent != nil {
+		parent.children = append(parent.children, this)
+	}
+
+	if keepWorld {
+		return this.SetWorldMatrix(&world)
+	}
+
+	return this.setWorldDirty()
+}
+
+// returns local position of this, saves in target
+func (this *Transform3) GetPosition(target *Vec3) *Vec3 {
+
+	return target.Copy(this.position)
+}
+
+// sets local position of this
+func (this *Transform3) SetPosition(v *Vec3) *Transform3 {
+
+	this.position.Copy(v)
+
+	return this.setLocalDirty()
+}
+
+// returns local rotation of this, saves in target
+func (this *Transform3) GetRotation(target *Quat) *Quat {
+
+	return target.Copy(this.rotation)
+}
+
+// sets local rotation of this
+func (this *Transform3) SetRotation(q *Quat) *Transform3 {
+
+	this.rotation.Copy(q)
+
+	return this.setLocalDirty()
+}
+
+// returns local scale of this, saves in target
+func (this *Transform3) GetScale(target *Vec3) *Vec3 {
+
+	return target.Copy(this.scale)
+}
+
+// sets local scale of this
+func (this *Transform3) SetScale(v *Vec3) *Transform3 {
+
+	this.scale.Copy(v)
+
+	return this.setLocalDirty()
+}
+
+// sets local position, scale and rotation of this
+func (this *Transform3) Set(position, scale *Vec3, rotation *Quat) *Transform3 {
+
+	this.position.Copy(position)
+	this.scale.Copy(scale)
+	this.rotation.Copy(rotation)
+
+	return this.setLocalDirty()
+}
+
+// translates this by Vec3 in local space
+func (this *Transform3) Translate(v *Vec3) *Transform3 {
+
+	this.position.Add(v)
+
+	return this.setLocalDirty()
+}
+
+// rotates this by Quat in local space
+func (this *Transform3) Rotate(q *Quat) *Transform3 {
+
+	this.rotation.Mul(q)
+
+	return this.setLocalDirty()
+}
+
+// returns local matrix of this, should not be modified
+func (this *Transform3) LocalMatrix() *Mat4 {
+
+	if this.localDirty {
+		this.local.Compose(this.position, this.scale, this.rotation)
+		this.localDirty = false
+	}
+
+	return this.local
+}
+
+// returns world matrix of this, should not be modified
+func (this *Transform3) WorldMatrix() *Mat4 {
+
+	if this.worldDirty {
+		if this.parent != nil {
+			this.world.MMul(this.parent.WorldMatrix(), this.LocalMatrix())
+		} else {
+			this.world.Copy(this.LocalMatrix())
+		}
+		this.worldDirty = false
+	}
+
+	return this.world
+}
+
+// sets local transform of this from Mat4
+func (this *Transform3) SetLocalMatrix(m *Mat4) *Transform3 {
+
+	m.Decompose(this.position, this.scale, this.rotation)
+
+	return this.setLocalDirty()
+}
+
+// sets local transform of this so its world matrix is m
+func (this *Transform3) SetWorldMatrix(m *Mat4) *Transform3 {
+	var local Mat4
+
+	if this.parent == nil {
+		return this.SetLocalMatrix(m)
+	}
+
+	local.MInverse(this.parent.WorldMatrix()).Mul(m)
+
+	return this.SetLocalMatrix(&local)
+}
+
+// returns world position of this, saves in target
+func (this *Transform3) GetWorldPosition(target *Vec3) *Vec3 {
+	m := this.WorldMatrix()
+
+	return target.Set(m[12], m[13], m[14])
+}
+
+// sets local position of this so its world position is v
+func (this *Transform3) SetWorldPosition(v *Vec3) *Transform3 {
+	var inv Mat4
+
+	this.position.Copy(v)
+
+	if this.parent != nil {
+		this.position.ApplyMat4(inv.MInverse(this.parent.WorldMatrix()))
+	}
+
+	return this.setLocalDirty()
+}
+
+// returns world rotation of this, saves in target
+func (this *Transform3) GetWorldRotation(target *Quat) *Quat {
+
+	target.Copy(this.rotation)
+
+	for p := this.parent; p != nil; p = p.parent {
+		target.QMul(p.rotation, target)
+	}
+
+	return target
+}
+
+// sets local rotation of this so its world rotation is q
+func (this *Transform3) SetWorldRotation(q *Quat) *Transform3 {
+	var inv Quat
+
+	this.rotation.Copy(q)
+
+	if this.parent != nil {
+		this.rotation.QMul(this.parent.GetWorldRotation(&inv).Conjugate(), this.rotation)
+	}
+
+	return this.setLocalDirty()
+}
+
+// returns world scale of this ignoring shear, saves in target
+func (this *Transform3) GetWorldScale(target *Vec3) *Vec3 {
+
+	target.Copy(this.scale)
+
+	for p := this.parent; p != nil; p = p.parent {
+		target.Mul(p.scale)
+	}
+
+	return target
+}
+
+// sets local scale of this so its world scale ignoring shear is v
+func (this *Transform3) SetWorldScale(v *Vec3) *Transform3 {
+	var s Vec3
+
+	this.scale.Copy(v)
+
+	if this.parent != nil {
+		this.scale.Div(this.parent.GetWorldScale(&s))
+	}
+
+	return this.setLocalDirty()
+}
+
+// marks local and world matrices of this as changed
+func (this *Transform3) setLocalDirty() *Transform3 {
+
+	this.localDirty = true
+
+	return this.setWorldDirty()
+}
+
+// marks world matrices of this and its children as changed
+func (this *Transform3) setWorldDirty() *Transform3 {
+
+	if this.worldDirty {
+		return this
+	}
+
+	this.worldDirty = true
+
+	for _, child := range this.children {
+		child.setWorldDirty()
+	}
+
+	return this
+}
+
+// returns this as string type
+func (this *Transform3) String() string {
+
+	return fmt.Sprintf("Transform3[ Position: %s, Scale: %s, Rotation: %s ]", this.position, this.scale, this.rotation)
+}
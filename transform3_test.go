@@ -0,0 +1,172 @@
+package mathf
+
+import (
+	"testing"
+)
+
+// returns a root, child and grandchild chain where the root is translated, rotated 90 degrees about z and scaled by 2
+func newTransform3Chain() (root, child, grandchild *Transform3) {
+	root = NewTransform3().Set(&Vec3{1, 2, 3}, &Vec3{2, 2, 2}, NewQuat().FromAxisAngle(&Vec3{0, 0, 1}, HALF_PI))
+	child = NewTransform3().SetPosition(&Vec3{1, 0, 0}).SetParent(root, false)
+	grandchild = NewTransform3().SetPosition(&Vec3{0, 1, 0}).SetParent(child, false)
+
+	return root, child, grandchild
+}
+
+func TestTransform3Hierarchy(t *testing.T) {
+	root, child, grandchild := newTransform3Chain()
+
+	if child.Parent() != root || grandchild.Parent() != child || root.Parent() != nil {
+		t.Fatalf("parents = %p %p %p, want nil %p %p", root.Parent(), child.Parent(), grandchild.Parent(), root, child)
+	}
+	if len(root.Children()) != 1 || root.Children()[0] != child {
+		t.Errorf("root.Children() = %v, want [child]", root.Children())
+	}
+
+	tests := []struct {
+		name string
+		node *Transform3
+		want Vec3
+	}{
+		{"root", root, Vec3{1, 2, 3}},
+		{"child", child, Vec3{1, 4, 3}},
+		{"grandchild", grandchild, Vec3{-1, 4, 3}},
+	}
+
+	for _, test := range tests {
+		var got Vec3
+
+		if test.node.GetWorldPosition(&got); !got.EqualsTol(&test.want, 1e-5) {
+			t.Errorf("%s.GetWorldPosition() = %v, want %v", test.name, got, test.want)
+		}
+
+		p := Vec3{}
+
+		if p.ApplyMat4(test.node.WorldMatrix()); !p.EqualsTol(&test.want, 1e-5) {
+			t.Errorf("%s origin by WorldMatrix() = %v, want %v", test.name, p, test.want)
+		}
+	}
+}
+
+func TestTransform3DirtyPropagation(t *testing.T) {
+	root, child, grandchild := newTransform3Chain()
+
+	// cache every world matrix, then change only the root
+	grandchild.WorldMatrix()
+	root.SetPosition(&Vec3{0, 0, 0})
+
+	if !child.worldDirty || !grandchild.worldDirty {
+		t.Errorf("worldDirty = %v %v after root changed, want true true", child.worldDirty, grandchild.worldDirty)
+	}
+	if child.localDirty || grandchild.localDirty {
+		t.Errorf("localDirty = %v %v after root changed, want false false", child.localDirty, grandchild.localDirty)
+	}
+
+	var got Vec3
+	want := Vec3{-2, 2, 0}
+
+	if grandchild.GetWorldPosition(&got); !got.EqualsTol(&want, 1e-5) {
+		t.Errorf("grandchild.GetWorldPosition() = %v, want %v", got, want)
+	}
+
+	// a clean parent with a dirty child must still dirty the grandchild when the child changes
+	child.Translate(&Vec3{0, 0, 1})
+	want = Vec3{-2, 2, 2}
+
+	if grandchild.GetWorldPosition(&got); !got.EqualsTol(&want, 1e-5) {
+		t.Errorf("grandchild.GetWorldPosition() after child.Translate = %v, want %v", got, want)
+	}
+
+	root.Rotate(NewQuat().FromAxisAngle(&Vec3{0, 0, 1}, HALF_PI))
+	want = Vec3{-2, -2, 2}
+
+	if grandchild.GetWorldPosition(&got); !got.EqualsTol(&want, 1e-5) {
+		t.Errorf("grandchild.GetWorldPosition() after root.Rotate = %v, want %v", got, want)
+	}
+}
+
+func TestTransform3SetWorld(t *testing.T) {
+	_, child, grandchild := newTransform3Chain()
+
+	position := Vec3{5, -3, 2}
+	rotation := NewQuat().FromAxisAngle(&Vec3{1, 0, 0}, 0.7)
+	scale := Vec3{3, 4, 5}
+
+	grandchild.SetWorldPosition(&position).SetWorldRotation(rotation).SetWorldScale(&scale)
+
+	var p, s Vec3
+	var q Quat
+
+	if grandchild.GetWorldPosition(&p); !p.EqualsTol(&position, 1e-5) {
+		t.Errorf("GetWorldPosition() = %v, want %v", p, position)
+	}
+	if grandchild.GetWorldRotation(&q); !q.EqualsRotation(rotation, 1e-5) {
+		t.Errorf("GetWorldRotation() = %v, want %v", q, *rotation)
+	}
+	if grandchild.GetWorldScale(&s); !s.EqualsTol(&scale, 1e-5) {
+		t.Errorf("GetWorldScale() = %v, want %v", s, scale)
+	}
+
+	// the parent scale is uniform, so the world matrix has exactly this position, rotation and scale
+	grandchild.WorldMatrix().Decompose(&p, &s, &q)
+
+	if !p.EqualsTol(&position, 1e-5) || !s.EqualsTol(&scale, 1e-4) || !q.EqualsRotation(rotation, 1e-5) {
+		t.Errorf("WorldMatrix().Decompose() = %v %v %v, want %v %v %v", p, s, q, position, scale, *rotation)
+	}
+
+	var local Vec3
+
+	if want := (Vec3{1.5, 2, 2.5}); !grandchild.GetScale(&local).EqualsTol(&want, 1e-5) {
+		t.Errorf("local scale = %v, want %v", local, want)
+	}
+
+	m := NewMat4().Compose(&Vec3{-4, 1, 0}, &Vec3{1, 2, 1}, NewQuat().FromAxisAngle(&Vec3{0, 1, 0}, 0.3))
+
+	if got := child.SetWorldMatrix(m).WorldMatrix(); !got.EqualsTol(m, 1e-5) {
+		t.Errorf("SetWorldMatrix(%v).WorldMatrix() = %v", *m, *got)
+	}
+}
+
+func TestTransform3SetParent(t *testing.T) {
+	root, child, grandchild := newTransform3Chain()
+	other := NewTransform3().Set(&Vec3{0, -5, 0}, &Vec3{1, 1, 1}, NewQuat().FromAxisAngle(&Vec3{1, 0, 0}, 1))
+
+	var before, after Vec3
+	var beforeRotation, afterRotation Quat
+
+	grandchild.GetWorldPosition(&before)
+	grandchild.GetWorldRotation(&beforeRotation)
+	grandchild.SetParent(other, true)
+
+	if grandchild.Parent() != other || len(child.Children()) != 0 || len(other.Children()) != 1 {
+		t.Errorf("after SetParent(other) parent = %p, child children %d, other children %d", grandchild.Parent(), len(child.Children()), len(other.Children()))
+	}
+	if grandchild.GetWorldPosition(&after); !after.EqualsTol(&before, 1e-5) {
+		t.Errorf("SetParent(other, true) world position = %v, want %v", after, before)
+	}
+	if grandchild.GetWorldRotation(&afterRotation); !afterRotation.EqualsRotation(&beforeRotation, 1e-5) {
+		t.Errorf("SetParent(other, true) world rotation = %v, want %v", afterRotation, beforeRotation)
+	}
+
+	// without keepWorld the local transform is kept and the world follows the new parent
+	grandchild.SetParent(nil, false)
+
+	if grandchild.GetPosition(&before); !grandchild.GetWorldPosition(&after).EqualsTol(&before, 1e-5) {
+		t.Errorf("SetParent(nil, false) world position = %v, want local %v", after, before)
+	}
+	if len(other.Children()) != 0 || grandchild.Parent() != nil {
+		t.Errorf("SetParent(nil) left parent %p with %d children", grandchild.Parent(), len(other.Children()))
+	}
+
+	// a node cannot become its own ancestor
+	child.SetParent(root, false)
+	grandchild.SetParent(child, false)
+	root.SetParent(grandchild, false)
+
+	if root.Parent() != nil {
+		t.Errorf("root.SetParent(grandchild) made a cycle, root parent = %p", root.Parent())
+	}
+	if root.SetParent(root, false); root.Parent() != nil {
+		t.Errorf("root.SetParent(root) set parent to %p", root.Parent())
+	}
+}
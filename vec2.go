@@ -5,8 +5,14 @@ import (
 	"math"
 )
 
-// float32 Array representing a 2D Vector
-type Vec2 [2]float32
+// Array representing a 2D Vector of float32 or float64
+type Vec2T[T Float] [2]T
+
+// float32 Vec2
+type Vec2 = Vec2T[float32]
+
+// float64 Vec2
+type Vec2d = Vec2T[float64]
 
 // returns new Vec2
 func NewVec2(x, y float32) *Vec2 {
@@ -17,14 +23,23 @@ func NewVec2(x, y float32) *Vec2 {
 	return this
 }
 
+// returns new Vec2d
+func NewVec2d(x, y float64) *Vec2d {
+	this := new(Vec2d)
+
+	this[0], this[1] = x, y
+
+	return this
+}
+
 // returns a copy of this
-func (this *Vec2) Clone() *Vec2 {
+func (this *Vec2T[T]) Clone() *Vec2T[T] {
 
-	return new(Vec2).Copy(this)
+	return new(Vec2T[T]).Copy(this)
 }
 
 // copies other
-func (this *Vec2) Copy(other *Vec2) *Vec2 {
+func (this *Vec2T[T]) Copy(other *Vec2T[T]) *Vec2T[T] {
 
 	this[0], this[1] = other[0], other[1]
 
@@ -32,7 +47,7 @@ func (this *Vec2) Copy(other *Vec2) *Vec2 {
 }
 
 // sets this from values
-func (this *Vec2) Set(x, y float32) *Vec2 {
+func (this *Vec2T[T]) Set(x, y T) *Vec2T[T] {
 
 	this[0], this[1] = x, y
 
@@ -40,7 +55,7 @@ func (this *Vec2) Set(x, y float32) *Vec2 {
 }
 
 // adds other to this
-func (this *Vec2) Add(other *Vec2) *Vec2 {
+func (this *Vec2T[T]) Add(other *Vec2T[T]) *Vec2T[T] {
 
 	this[0] += other[0]
 	this[1] += other[1]
@@ -49,7 +64,7 @@ func (this *Vec2) Add(other *Vec2) *Vec2 {
 }
 
 // adds a and b saves in this
-func (this *Vec2) VAdd(a, b *Vec2) *Vec2 {
+func (this *Vec2T[T]) VAdd(a, b *Vec2T[T]) *Vec2T[T] {
 
 	this[0] = a[0] + b[0]
 	this[1] = a[1] + b[1]
@@ -58,7 +73,7 @@ func (this *Vec2) VAdd(a, b *Vec2) *Vec2 {
 }
 
 // adds scalar to this
-func (this *Vec2) SAdd(s float32) *Vec2 {
+func (this *Vec2T[T]) SAdd(s T) *Vec2T[T] {
 
 	this[0] += s
 	this[1] += s
@@ -67,7 +82,7 @@ func (this *Vec2) SAdd(s float32) *Vec2 {
 }
 
 // subtracts other from this
-func (this *Vec2) Sub(other *Vec2) *Vec2 {
+func (this *Vec2T[T]) Sub(other *Vec2T[T]) *Vec2T[T] {
 
 	this[0] -= other[0]
 	this[1] -= other[1]
@@ -76,7 +91,7 @@ func (this *Vec2) Sub(other *Vec2) *Vec2 {
 }
 
 // subtracts a and b saves in this
-func (this *Vec2) VSub(a, b *Vec2) *Vec2 {
+func (this *Vec2T[T]) VSub(a, b *Vec2T[T]) *Vec2T[T] {
 
 	this[0] = a[0] - b[0]
 	this[1] = a[1] - b[1]
@@ -85,7 +100,7 @@ func (this *Vec2) VSub(a, b *Vec2) *Vec2 {
 }
 
 // adds scalar to this
-func (this *Vec2) SSub(s float32) *Vec2 {
+func (this *Vec2T[T]) SSub(s T) *Vec2T[T] {
 
 	this[0] -= s
 	this[1] -= s
@@ -94,7 +109,7 @@ func (this *Vec2) SSub(s float32) *Vec2 {
 }
 
 // mutiples this by other
-func (this *Vec2) Mul(other *Vec2) *Vec2 {
+func (this *Vec2T[T]) Mul(other *Vec2T[T]) *Vec2T[T] {
 
 	this[0] *= other[0]
 	this[1] *= other[1]
@@ -103,7 +118,7 @@ func (this *Vec2) Mul(other *Vec2) *Vec2 {
 }
 
 // mutiples a and b saves in this
-func (this *Vec2) VMul(a, b *Vec2) *Vec2 {
+func (this *Vec2T[T]) VMul(a, b *Vec2T[T]) *Vec2T[T] {
 
 	this[0] = a[0] * b[0]
 	this[1] = a[1] * b[1]
@@ -112,7 +127,7 @@ func (this *Vec2) VMul(a, b *Vec2) *Vec2 {
 }
 
 // mutiples this by scalar
-func (this *Vec2) SMul(s float32) *Vec2 {
+func (this *Vec2T[T]) SMul(s T) *Vec2T[T] {
 
 	this[0] *= s
 	this[1] *= s
@@ -121,7 +136,7 @@ func (this *Vec2) SMul(s float32) *Vec2 {
 }
 
 // divides this by other
-func (this *Vec2) Div(other *Vec2) *Vec2 {
+func (this *Vec2T[T]) Div(other *Vec2T[T]) *Vec2T[T] {
 	x, y := other[0], other[1]
 
 	if x != 0 {
@@ -138,7 +153,7 @@ func (this *Vec2) Div(other *Vec2) *Vec2 {
 }
 
 // divides a and b saves in this
-func (this *Vec2) VDiv(a, b *Vec2) *Vec2 {
+func (this *Vec2T[T]) VDiv(a, b *Vec2T[T]) *Vec2T[T] {
 	x, y := b[0], b[1]
 
 	if x != 0 {
@@ -155,7 +170,7 @@ func (this *Vec2) VDiv(a, b *Vec2) *Vec2 {
 }
 
 // divides this by scalar
-func (this *Vec2) SDiv(s float32) *Vec2 {
+func (this *Vec2T[T]) SDiv(s T) *Vec2T[T] {
 	if s != 0 {
 		s = 1 / s
 	}
@@ -167,14 +182,14 @@ func (this *Vec2) SDiv(s float32) *Vec2 {
 }
 
 // normalize vector, makes length of 1
-func (this *Vec2) Normalize() *Vec2 {
+func (this *Vec2T[T]) Normalize() *Vec2T[T] {
 	l := this[0]*this[0] + this[1]*this[1]
 
 	if l == 0 {
 		return this
 	}
 
-	l = 1 / float32(math.Sqrt(float64(l)))
+	l = 1 / T(math.Sqrt(float64(l)))
 	this[0] *= l
 	this[1] *= l
 
@@ -182,31 +197,31 @@ func (this *Vec2) Normalize() *Vec2 {
 }
 
 // returns length of this
-func (this *Vec2) Length() float32 {
+func (this *Vec2T[T]) Length() T {
 	l := this[0]*this[0] + this[1]*this[1]
 
 	if l == 0 {
 		return 0
 	}
 
-	return float32(math.Sqrt(float64(l)))
+	return T(math.Sqrt(float64(l)))
 }
 
 // returns length squared of this
-func (this *Vec2) LengthSq() float32 {
+func (this *Vec2T[T]) LengthSq() T {
 
 	return this[0]*this[0] + this[1]*this[1]
 }
 
 // sets length of vector, if length is zero returns zero vector
-func (this *Vec2) SetLength(length float32) *Vec2 {
+func (this *Vec2T[T]) SetLength(length T) *Vec2T[T] {
 	l := this[0]*this[0] + this[1]*this[1]
 
 	if l == 0 {
 		return this
 	}
 
-	l = 1 / float32(math.Sqrt(float64(l)))
+	l = 1 / T(math.Sqrt(float64(l)))
 	this[0] *= length * l
 	this[1] *= length * l
 
@@ -214,31 +229,31 @@ func (this *Vec2) SetLength(length float32) *Vec2 {
 }
 
 // returns dot product of this and other
-func (this *Vec2) Dot(other *Vec2) float32 {
+func (this *Vec2T[T]) Dot(other *Vec2T[T]) T {
 
 	return this[0]*other[0] + this[1]*other[1]
 }
 
 // returns dot product of a and b
-func (this *Vec2) VDot(a, b *Vec2) float32 {
+func (this *Vec2T[T]) VDot(a, b *Vec2T[T]) T {
 
 	return a[0]*b[0] + a[1]*b[1]
 }
 
 // returns cross product of this and other
-func (this *Vec2) Cross(other *Vec2) float32 {
+func (this *Vec2T[T]) Cross(other *Vec2T[T]) T {
 
 	return this[0]*other[1] - this[1]*other[0]
 }
 
 // returns cross product of a and b
-func (this *Vec2) VCross(a, b *Vec2) float32 {
+func (this *Vec2T[T]) VCross(a, b *Vec2T[T]) T {
 
 	return a[0]*b[1] - a[1]*b[0]
 }
 
 // returns inverse of this
-func (this *Vec2) Inverse() *Vec2 {
+func (this *Vec2T[T]) Inverse() *Vec2T[T] {
 
 	this[0] *= -1
 	this[1] *= -1
@@ -247,7 +262,7 @@ func (this *Vec2) Inverse() *Vec2 {
 }
 
 // saves inverse of other in this
-func (this *Vec2) VInverse(other *Vec2) *Vec2 {
+func (this *Vec2T[T]) VInverse(other *Vec2T[T]) *Vec2T[T] {
 
 	this[0] = -other[0]
 	this[1] = -other[1]
@@ -256,7 +271,7 @@ func (this *Vec2) VInverse(other *Vec2) *Vec2 {
 }
 
 // lerps this and other by x
-func (this *Vec2) Lerp(other *Vec2, x float32) *Vec2 {
+func (this *Vec2T[T]) Lerp(other *Vec2T[T], x T) *Vec2T[T] {
 
 	this[0] += (other[0] - this[0]) * x
 	this[1] += (other[1] - this[1]) * x
@@ -265,7 +280,7 @@ func (this *Vec2) Lerp(other *Vec2, x float32) *Vec2 {
 }
 
 // lerps a and b by x, saves in this
-func (this *Vec2) VLerp(a, b *Vec2, x float32) *Vec2 {
+func (this *Vec2T[T]) VLerp(a, b *Vec2T[T], x T) *Vec2T[T] {
 
 	this[0] = a[0] + (b[0]-a[0])*x
 	this[1] = a[1] + (b[1]-a[1])*x
@@ -274,7 +289,7 @@ func (this *Vec2) VLerp(a, b *Vec2, x float32) *Vec2 {
 }
 
 // returns distance to other
-func (this *Vec2) DistanceTo(other *Vec2) float32 {
+func (this *Vec2T[T]) DistanceTo(other *Vec2T[T]) T {
 	x := this[0] - other[0]
 	y := this[1] - other[1]
 	l := x*x + y*y
@@ -283,11 +298,11 @@ func (this *Vec2) DistanceTo(other *Vec2) float32 {
 		return 0
 	}
 
-	return float32(math.Sqrt(float64(l)))
+	return T(math.Sqrt(float64(l)))
 }
 
 // returns distance squared to other
-func (this *Vec2) DistanceToSq(other *Vec2) float32 {
+func (this *Vec2T[T]) DistanceToSq(other *Vec2T[T]) T {
 	x := this[0] - other[0]
 	y := this[1] - other[1]
 
@@ -295,7 +310,7 @@ func (this *Vec2) DistanceToSq(other *Vec2) float32 {
 }
 
 // sets this values from  min values of this and other
-func (this *Vec2) Min(other *Vec2) *Vec2 {
+func (this *Vec2T[T]) Min(other *Vec2T[T]) *Vec2T[T] {
 
 	if this[0] > other[0] {
 		this[0] = other[0]
@@ -308,7 +323,7 @@ func (this *Vec2) Min(other *Vec2) *Vec2 {
 }
 
 // sets this values from max values of this and other
-func (this *Vec2) Max(other *Vec2) *Vec2 {
+func (this *Vec2T[T]) Max(other *Vec2T[T]) *Vec2T[T] {
 
 	if this[0] < other[0] {
 		this[0] = other[0]
@@ -321,7 +336,7 @@ func (this *Vec2) Max(other *Vec2) *Vec2 {
 }
 
 // clamps this between min and max
-func (this *Vec2) Clamp(min, max *Vec2) *Vec2 {
+func (this *Vec2T[T]) Clamp(min, max *Vec2T[T]) *Vec2T[T] {
 
 	if this[0] < min[0] {
 		this[0] = min[0]
@@ -341,16 +356,118 @@ func (this *Vec2) Clamp(min, max *Vec2) *Vec2 {
 }
 
 // clamps each element between 0 and 1
-func (this *Vec2) Clamp01() *Vec2 {
+func (this *Vec2T[T]) Clamp01() *Vec2T[T] {
+
+	this[0] = clamp01(this[0])
+	this[1] = clamp01(this[1])
+
+	return this
+}
+
+// transforms this by Mat2
+func (this *Vec2T[T]) ApplyMat2(m *Mat2T[T]) *Vec2T[T] {
+	x, y := this[0], this[1]
+
+	this[0] = m[0]*x + m[2]*y
+	this[1] = m[1]*x + m[3]*y
+
+	return this
+}
+
+// transforms v by Mat2 saves in this
+func (this *Vec2T[T]) VApplyMat2(v *Vec2T[T], m *Mat2T[T]) *Vec2T[T] {
+	x, y := v[0], v[1]
+
+	this[0] = m[0]*x + m[2]*y
+	this[1] = m[1]*x + m[3]*y
+
+	return this
+}
+
+// transforms this as a point by Mat32, w of 1
+func (this *Vec2T[T]) ApplyMat32(m *Mat32T[T]) *Vec2T[T] {
+	x, y := this[0], this[1]
+
+	this[0] = m[0]*x + m[2]*y + m[4]
+	this[1] = m[1]*x + m[3]*y + m[5]
 
-	this[0] = Clamp01(this[0])
-	this[1] = Clamp01(this[1])
+	return this
+}
+
+// transforms v as a point by Mat32 saves in this, w of 1
+func (this *Vec2T[T]) VApplyMat32(v *Vec2T[T], m *Mat32T[T]) *Vec2T[T] {
+	x, y := v[0], v[1]
+
+	this[0] = m[0]*x + m[2]*y + m[4]
+	this[1] = m[1]*x + m[3]*y + m[5]
 
 	return this
 }
 
+// transforms this as a direction by Mat32, w of 0
+func (this *Vec2T[T]) ApplyMat32Direction(m *Mat32T[T]) *Vec2T[T] {
+	x, y := this[0], this[1]
+
+	this[0] = m[0]*x + m[2]*y
+	this[1] = m[1]*x + m[3]*y
+
+	return this
+}
+
+// transforms v as a direction by Mat32 saves in this, w of 0
+func (this *Vec2T[T]) VApplyMat32Direction(v *Vec2T[T], m *Mat32T[T]) *Vec2T[T] {
+	x, y := v[0], v[1]
+
+	this[0] = m[0]*x + m[2]*y
+	this[1] = m[1]*x + m[3]*y
+
+	return this
+}
+
+// transforms this as a point by Mat3, w of 1
+func (this *Vec2T[T]) ApplyMat3(m *Mat3T[T]) *Vec2T[T] {
+	x, y := this[0], this[1]
+
+	this[0] = m[0]*x + m[3]*y + m[6]
+	this[1] = m[1]*x + m[4]*y + m[7]
+
+	return this
+}
+
+// transforms v as a point by Mat3 saves in this, w of 1
+func (this *Vec2T[T]) VApplyMat3(v *Vec2T[T], m *Mat3T[T]) *Vec2T[T] {
+	x, y := v[0], v[1]
+
+	this[0] = m[0]*x + m[3]*y + m[6]
+	this[1] = m[1]*x + m[4]*y + m[7]
+
+	return this
+}
+
+// projects this from world space to window coordinates, viewport is x, y, width, height
+func (this *Vec2T[T]) Project(view, projection *Mat32T[T], viewport *Vec4T[T]) *Vec2T[T] {
+	var m Mat32T[T]
+
+	this.ApplyMat32(m.MMul(projection, view))
+
+	this[0] = viewport[0] + (this[0]+1)*0.5*viewport[2]
+	this[1] = viewport[1] + (this[1]+1)*0.5*viewport[3]
+
+	return this
+}
+
+// unprojects this from window coordinates to world space, viewport is x, y, width, height
+func (this *Vec2T[T]) Unproject(view, projection *Mat32T[T], viewport *Vec4T[T]) *Vec2T[T] {
+	var m Mat32T[T]
+
+	this[0] = (this[0]-viewport[0])/viewport[2]*2 - 1
+	this[1] = (this[1]-viewport[1])/viewport[3]*2 - 1
+
+	return this.ApplyMat32(m.MMul(projection, view).Inverse())
+}
+
 // sets values from Vec3
-func (this *Vec2) FromVec3(v *Vec3) *Vec2 {
+func (this *Vec2T[T]) FromVec3(v *Vec3T[T]) *Vec2T[T] {
 
 	this[0] = v[0]
 	this[1] = v[1]
@@ -359,7 +476,7 @@ func (this *Vec2) FromVec3(v *Vec3) *Vec2 {
 }
 
 // sets values from Vec4
-func (this *Vec2) FromVec4(v *Vec4) *Vec2 {
+func (this *Vec2T[T]) FromVec4(v *Vec4T[T]) *Vec2T[T] {
 
 	this[0] = v[0]
 	this[1] = v[1]
@@ -367,8 +484,88 @@ func (this *Vec2) FromVec4(v *Vec4) *Vec2 {
 	return this
 }
 
-// checks if this equals other
-func (this *Vec2) Equals(other *Vec2) bool {
+// returns sum of this and other by value
+func (this Vec2T[T]) Plus(other Vec2T[T]) Vec2T[T] {
+
+	return Vec2T[T]{this[0] + other[0], this[1] + other[1]}
+}
+
+// returns difference of this and other by value
+func (this Vec2T[T]) Minus(other Vec2T[T]) Vec2T[T] {
+
+	return Vec2T[T]{this[0] - other[0], this[1] - other[1]}
+}
+
+// returns component product of this and other by value
+func (this Vec2T[T]) Times(other Vec2T[T]) Vec2T[T] {
+
+	return Vec2T[T]{this[0] * other[0], this[1] * other[1]}
+}
+
+// returns component quotient of this and other by value
+func (this Vec2T[T]) DividedBy(other Vec2T[T]) Vec2T[T] {
+
+	return *this.Div(&other)
+}
+
+// returns this scaled by s by value
+func (this Vec2T[T]) Scaled(s T) Vec2T[T] {
+
+	return Vec2T[T]{this[0] * s, this[1] * s}
+}
+
+// returns this normalized by value
+func (this Vec2T[T]) Normalized() Vec2T[T] {
+
+	return *this.Normalize()
+}
+
+// returns this negated by value
+func (this Vec2T[T]) Negated() Vec2T[T] {
+
+	return Vec2T[T]{-this[0], -this[1]}
+}
+
+// returns lerp of this and other by x by value
+func (this Vec2T[T]) Lerped(other Vec2T[T], x T) Vec2T[T] {
+
+	return Vec2T[T]{this[0] + (other[0]-this[0])*x, this[1] + (other[1]-this[1])*x}
+}
+
+// returns this clamped between min and max by value
+func (this Vec2T[T]) Clamped(min, max Vec2T[T]) Vec2T[T] {
+
+	return *this.Clamp(&min, &max)
+}
+
+// returns this transformed as a point by Mat32 by value
+func (this Vec2T[T]) Transformed(m Mat32T[T]) Vec2T[T] {
+
+	return *this.ApplyMat32(&m)
+}
+
+// converts this to float32, saves in target
+func (this *Vec2T[T]) Float32(target *Vec2) *Vec2 {
+
+	for i, v := range this {
+		target[i] = float32(v)
+	}
+
+	return target
+}
+
+// converts this to float64, saves in target
+func (this *Vec2T[T]) Float64(target *Vec2d) *Vec2d {
+
+	for i, v := range this {
+		target[i] = float64(v)
+	}
+
+	return target
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *Vec2T[T]) Equals(other *Vec2T[T]) bool {
 
 	if this[0] != other[0] {
 		return false
@@ -380,8 +577,62 @@ func (this *Vec2) Equals(other *Vec2) bool {
 	return true
 }
 
+// checks if this equals other within absolute tolerance tol
+func (this *Vec2T[T]) EqualsTol(other *Vec2T[T], tol T) bool {
+
+	return equalsTolSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other within tol relative to its largest element magnitude
+func (this *Vec2T[T]) EqualsRel(other *Vec2T[T], tol T) bool {
+
+	return equalsRelSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *Vec2T[T]) EqualsULP(other *Vec2T[T], ulps int) bool {
+
+	return equalsULPSlice(this[:], other[:], ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *Vec2T[T]) MarshalText() ([]byte, error) {
+
+	return appendText(nil, vec2Text, this[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *Vec2T[T]) UnmarshalText(text []byte) error {
+
+	return parseText(text, vec2Text, this[:])
+}
+
+// returns this as a JSON array, implements json.Marshaler
+func (this *Vec2T[T]) MarshalJSON() ([]byte, error) {
+
+	return appendJSON(nil, this[:])
+}
+
+// parses a JSON array into this, implements json.Unmarshaler
+func (this *Vec2T[T]) UnmarshalJSON(data []byte) error {
+
+	return parseJSON(data, this[:])
+}
+
+// returns this as little endian floats, implements encoding.BinaryMarshaler
+func (this *Vec2T[T]) MarshalBinary() ([]byte, error) {
+
+	return appendBinary(nil, this[:]), nil
+}
+
+// parses little endian floats into this, implements encoding.BinaryUnmarshaler
+func (this *Vec2T[T]) UnmarshalBinary(data []byte) error {
+
+	return parseBinary(data, this[:])
+}
+
 // returns this as string type
-func (this *Vec2) String() string {
+func (this *Vec2T[T]) String() string {
 
 	return fmt.Sprintf("Vec2[ %f, %f ]", this[0], this[1])
 }
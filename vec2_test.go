@@ -0,0 +1,38 @@
+package mathf
+
+import (
+	"testing"
+)
+
+func TestVec2ApplyMatrix(t *testing.T) {
+	rotation := Mat2{0, 1, -1, 0}
+	affine := Mat32{2, 0, 0, 3, 5, -1}
+	affine3 := Mat3{2, 0, 0, 0, 3, 0, 5, -1, 1}
+
+	tests := []struct {
+		name    string
+		apply   func(v *Vec2) *Vec2
+		applyTo func(v *Vec2) *Vec2
+		v, want Vec2
+	}{
+		{"Mat2", func(v *Vec2) *Vec2 { return v.ApplyMat2(&rotation) }, func(v *Vec2) *Vec2 { return new(Vec2).VApplyMat2(v, &rotation) }, Vec2{1, 2}, Vec2{-2, 1}},
+		{"Mat32", func(v *Vec2) *Vec2 { return v.ApplyMat32(&affine) }, func(v *Vec2) *Vec2 { return new(Vec2).VApplyMat32(v, &affine) }, Vec2{1, 2}, Vec2{7, 5}},
+		{"Mat32Direction", func(v *Vec2) *Vec2 { return v.ApplyMat32Direction(&affine) }, func(v *Vec2) *Vec2 { return new(Vec2).VApplyMat32Direction(v, &affine) }, Vec2{1, 2}, Vec2{2, 6}},
+		{"Mat3", func(v *Vec2) *Vec2 { return v.ApplyMat3(&affine3) }, func(v *Vec2) *Vec2 { return new(Vec2).VApplyMat3(v, &affine3) }, Vec2{1, 2}, Vec2{7, 5}},
+	}
+
+	for _, test := range tests {
+		v := test.v
+
+		if got := test.apply(&v); *got != test.want {
+			t.Errorf("%v.Apply%s() = %v, want %v", test.v, test.name, *got, test.want)
+		}
+		if got := test.applyTo(&test.v); *got != test.want {
+			t.Errorf("VApply%s(%v) = %v, want %v", test.name, test.v, *got, test.want)
+		}
+	}
+
+	if got := (Vec2{1, 2}).Transformed(affine); got != (Vec2{7, 5}) {
+		t.Errorf("Transformed = %v, want %v", got, Vec2{7, 5})
+	}
+}
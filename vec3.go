@@ -5,8 +5,14 @@ import (
 	"math"
 )
 
-// float32 Array representing a 3D Vector
-type Vec3 [3]float32
+// Array representing a 3D Vector of float32 or float64
+type Vec3T[T Float] [3]T
+
+// float32 Vec3
+type Vec3 = Vec3T[float32]
+
+// float64 Vec3
+type Vec3d = Vec3T[float64]
 
 // returns new Vec3
 func NewVec3(x, y, z float32) *Vec3 {
@@ -17,14 +23,23 @@ func NewVec3(x, y, z float32) *Vec3 {
 	return this
 }
 
+// returns new Vec3d
+func NewVec3d(x, y, z float64) *Vec3d {
+	this := new(Vec3d)
+
+	this[0], this[1], this[2] = x, y, z
+
+	return this
+}
+
 // returns a copy of this
-func (this *Vec3) Clone() *Vec3 {
+func (this *Vec3T[T]) Clone() *Vec3T[T] {
 
-	return new(Vec3).Copy(this)
+	return new(Vec3T[T]).Copy(this)
 }
 
 // copies other
-func (this *Vec3) Copy(other *Vec3) *Vec3 {
+func (this *Vec3T[T]) Copy(other *Vec3T[T]) *Vec3T[T] {
 
 	this[0], this[1], this[2] = other[0], other[1], other[2]
 
@@ -32,7 +47,7 @@ func (this *Vec3) Copy(other *Vec3) *Vec3 {
 }
 
 // sets this from values
-func (this *Vec3) Set(x, y, z float32) *Vec3 {
+func (this *Vec3T[T]) Set(x, y, z T) *Vec3T[T] {
 
 	this[0], this[1], this[2] = x, y, z
 
@@ -40,7 +55,7 @@ func (this *Vec3) Set(x, y, z float32) *Vec3 {
 }
 
 // adds other to this
-func (this *Vec3) Add(other *Vec3) *Vec3 {
+func (this *Vec3T[T]) Add(other *Vec3T[T]) *Vec3T[T] {
 
 	this[0] += other[0]
 	this[1] += other[1]
@@ -50,7 +65,7 @@ func (this *Vec3) Add(other *Vec3) *Vec3 {
 }
 
 // adds a and b saves in this
-func (this *Vec3) VAdd(a, b *Vec3) *Vec3 {
+func (this *Vec3T[T]) VAdd(a, b *Vec3T[T]) *Vec3T[T] {
 
 	this[0] = a[0] + b[0]
 	this[1] = a[1] + b[1]
@@ -60,7 +75,7 @@ func (this *Vec3) VAdd(a, b *Vec3) *Vec3 {
 }
 
 // adds scalar to this
-func (this *Vec3) SAdd(s float32) *Vec3 {
+func (this *Vec3T[T]) SAdd(s T) *Vec3T[T] {
 
 	this[0] += s
 	this[1] += s
@@ -70,7 +85,7 @@ func (this *Vec3) SAdd(s float32) *Vec3 {
 }
 
 // subtracts other from this
-func (this *Vec3) Sub(other *Vec3) *Vec3 {
+func (this *Vec3T[T]) Sub(other *Vec3T[T]) *Vec3T[T] {
 
 	this[0] -= other[0]
 	this[1] -= other[1]
@@ -80,7 +95,7 @@ func (this *Vec3) Sub(other *Vec3) *Vec3 {
 }
 
 // subtracts a and b saves in this
-func (this *Vec3) VSub(a, b *Vec3) *Vec3 {
+func (this *Vec3T[T]) VSub(a, b *Vec3T[T]) *Vec3T[T] {
 
 	this[0] = a[0] - b[0]
 	this[1] = a[1] - b[1]
@@ -90,7 +105,7 @@ func (this *Vec3) VSub(a, b *Vec3) *Vec3 {
 }
 
 // subtracts scalar from this
-func (this *Vec3) SSub(s float32) *Vec3 {
+func (this *Vec3T[T]) SSub(s T) *Vec3T[T] {
 
 	this[0] -= s
 	this[1] -= s
@@ -100,7 +115,7 @@ func (this *Vec3) SSub(s float32) *Vec3 {
 }
 
 // mutiples this by other
-func (this *Vec3) Mul(other *Vec3) *Vec3 {
+func (this *Vec3T[T]) Mul(other *Vec3T[T]) *Vec3T[T] {
 
 	this[0] *= other[0]
 	this[1] *= other[1]
@@ -110,7 +125,7 @@ func (this *Vec3) Mul(other *Vec3) *Vec3 {
 }
 
 // mutiples a and b saves in this
-func (this *Vec3) VMul(a, b *Vec3) *Vec3 {
+func (this *Vec3T[T]) VMul(a, b *Vec3T[T]) *Vec3T[T] {
 
 	this[0] = a[0] * b[0]
 	this[1] = a[1] * b[1]
@@ -120,7 +135,7 @@ func (this *Vec3) VMul(a, b *Vec3) *Vec3 {
 }
 
 // mutiples this by scalar
-func (this *Vec3) SMul(s float32) *Vec3 {
+func (this *Vec3T[T]) SMul(s T) *Vec3T[T] {
 
 	this[0] *= s
 	this[1] *= s
@@ -130,7 +145,7 @@ func (this *Vec3) SMul(s float32) *Vec3 {
 }
 
 // divides this by other
-func (this *Vec3) Div(other *Vec3) *Vec3 {
+func (this *Vec3T[T]) Div(other *Vec3T[T]) *Vec3T[T] {
 	x, y, z := other[0], other[1], other[2]
 
 	if x != 0 {
@@ -151,7 +166,7 @@ func (this *Vec3) Div(other *Vec3) *Vec3 {
 }
 
 // divides a and b saves in this
-func (this *Vec3) VDiv(a, b *Vec3) *Vec3 {
+func (this *Vec3T[T]) VDiv(a, b *Vec3T[T]) *Vec3T[T] {
 	x, y, z := b[0], b[1], b[2]
 
 	if x != 0 {
@@ -172,7 +187,7 @@ func (this *Vec3) VDiv(a, b *Vec3) *Vec3 {
 }
 
 // divides this by scalar
-func (this *Vec3) SDiv(s float32) *Vec3 {
+func (this *Vec3T[T]) SDiv(s T) *Vec3T[T] {
 	if s != 0 {
 		s = 1 / s
 	}
@@ -185,14 +200,14 @@ func (this *Vec3) SDiv(s float32) *Vec3 {
 }
 
 // normalize vector, makes length of 1
-func (this *Vec3) Normalize() *Vec3 {
+func (this *Vec3T[T]) Normalize() *Vec3T[T] {
 	l := this[0]*this[0] + this[1]*this[1] + this[2]*this[2]
 
 	if l == 0 {
 		return this
 	}
 
-	l = 1 / float32(math.Sqrt(float64(l)))
+	l = 1 / T(math.Sqrt(float64(l)))
 	this[0] *= l
 	this[1] *= l
 	this[2] *= l
@@ -201,31 +216,31 @@ func (this *Vec3) Normalize() *Vec3 {
 }
 
 // returns length of this
-func (this *Vec3) Length() float32 {
+func (this *Vec3T[T]) Length() T {
 	l := this[0]*this[0] + this[1]*this[1] + this[2]*this[2]
 
 	if l == 0 {
 		return 0
 	}
 
-	return float32(math.Sqrt(float64(l)))
+	return T(math.Sqrt(float64(l)))
 }
 
 // returns length squared of this
-func (this *Vec3) LengthSq() float32 {
+func (this *Vec3T[T]) LengthSq() T {
 
 	return this[0]*this[0] + this[1]*this[1] + this[2]*this[2]
 }
 
 // sets length of vector, if length is zero returns zero vector
-func (this *Vec3) SetLength(length float32) *Vec3 {
+func (this *Vec3T[T]) SetLength(length T) *Vec3T[T] {
 	l := this[0]*this[0] + this[1]*this[1] + this[2]*this[2]
 
 	if l == 0 {
 		return this
 	}
 
-	l = 1 / float32(math.Sqrt(float64(l)))
+	l = 1 / T(math.Sqrt(float64(l)))
 	this[0] *= length * l
 	this[1] *= length * l
 	this[2] *= length * l
@@ -234,39 +249,38 @@ func (this *Vec3) SetLength(length float32) *Vec3 {
 }
 
 // returns dot product of this and other
-func (this *Vec3) Dot(other *Vec3) float32 {
+func (this *Vec3T[T]) Dot(other *Vec3T[T]) T {
 
 	return this[0]*other[0] + this[1]*other[1] + this[2]*other[2]
 }
 
 // returns dot product of a and b
-func (this *Vec3) VDot(a, b *Vec3) float32 {
+func (this *Vec3T[T]) VDot(a, b *Vec3T[T]) T {
 
 	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
 }
 
 // returns cross product of this and other
-func (this *Vec3) Cross(other *Vec3) *Vec3 {
-
-	this[0] = this[1]*other[2] - this[2]*other[1]
-	this[1] = this[2]*other[0] - this[0]*other[2]
-	this[2] = this[0]*other[1] - this[1]*other[0]
+func (this *Vec3T[T]) Cross(other *Vec3T[T]) *Vec3T[T] {
 
-	return this
+	return this.VCross(this, other)
 }
 
 // returns cross product of a and b saves it in this
-func (this *Vec3) VCross(a, b *Vec3) *Vec3 {
+func (this *Vec3T[T]) VCross(a, b *Vec3T[T]) *Vec3T[T] {
+	x := a[1]*b[2] - a[2]*b[1]
+	y := a[2]*b[0] - a[0]*b[2]
+	z := a[0]*b[1] - a[1]*b[0]
 
-	this[0] = a[1]*b[2] - a[2]*b[1]
-	this[1] = a[2]*b[0] - a[0]*b[2]
-	this[2] = a[0]*b[1] - a[1]*b[0]
+	this[0] = x
+	this[1] = y
+	this[2] = z
 
 	return this
 }
 
 // returns inverse of this
-func (this *Vec3) Inverse() *Vec3 {
+func (this *Vec3T[T]) Inverse() *Vec3T[T] {
 
 	this[0] *= -1
 	this[1] *= -1
@@ -276,7 +290,7 @@ func (this *Vec3) Inverse() *Vec3 {
 }
 
 // saves inverse of other in this
-func (this *Vec3) VInverse(other *Vec3) *Vec3 {
+func (this *Vec3T[T]) VInverse(other *Vec3T[T]) *Vec3T[T] {
 
 	this[0] = -other[0]
 	this[1] = -other[1]
@@ -286,7 +300,7 @@ func (this *Vec3) VInverse(other *Vec3) *Vec3 {
 }
 
 // lerps this and other by x
-func (this *Vec3) Lerp(other *Vec3, x float32) *Vec3 {
+func (this *Vec3T[T]) Lerp(other *Vec3T[T], x T) *Vec3T[T] {
 
 	this[0] += (other[0] - this[0]) * x
 	this[1] += (other[1] - this[1]) * x
@@ -296,7 +310,7 @@ func (this *Vec3) Lerp(other *Vec3, x float32) *Vec3 {
 }
 
 // lerps a and b by x, saves in this
-func (this *Vec3) VLerp(a, b *Vec3, x float32) *Vec3 {
+func (this *Vec3T[T]) VLerp(a, b *Vec3T[T], x T) *Vec3T[T] {
 
 	this[0] = a[0] + (b[0]-a[0])*x
 	this[1] = a[1] + (b[1]-a[1])*x
@@ -306,7 +320,7 @@ func (this *Vec3) VLerp(a, b *Vec3, x float32) *Vec3 {
 }
 
 // returns distance to other
-func (this *Vec3) DistanceTo(other *Vec3) float32 {
+func (this *Vec3T[T]) DistanceTo(other *Vec3T[T]) T {
 	x := this[0] - other[0]
 	y := this[1] - other[1]
 	z := this[2] - other[2]
@@ -316,11 +330,11 @@ func (this *Vec3) DistanceTo(other *Vec3) float32 {
 		return 0
 	}
 
-	return float32(math.Sqrt(float64(l)))
+	return T(math.Sqrt(float64(l)))
 }
 
 // returns distance squared to other
-func (this *Vec3) DistanceToSq(other *Vec3) float32 {
+func (this *Vec3T[T]) DistanceToSq(other *Vec3T[T]) T {
 	x := this[0] - other[0]
 	y := this[1] - other[1]
 	z := this[2] - other[2]
@@ -329,7 +343,7 @@ func (this *Vec3) DistanceToSq(other *Vec3) float32 {
 }
 
 // sets this values from  min values of this and other
-func (this *Vec3) Min(other *Vec3) *Vec3 {
+func (this *Vec3T[T]) Min(other *Vec3T[T]) *Vec3T[T] {
 
 	if this[0] > other[0] {
 		this[0] = other[0]
@@ -345,7 +359,7 @@ func (this *Vec3) Min(other *Vec3) *Vec3 {
 }
 
 // sets this values from max values of this and other
-func (this *Vec3) Max(other *Vec3) *Vec3 {
+func (this *Vec3T[T]) Max(other *Vec3T[T]) *Vec3T[T] {
 
 	if this[0] < other[0] {
 		this[0] = other[0]
@@ -361,7 +375,7 @@ func (this *Vec3) Max(other *Vec3) *Vec3 {
 }
 
 // clamps this between min and max
-func (this *Vec3) Clamp(min, max *Vec3) *Vec3 {
+func (this *Vec3T[T]) Clamp(min, max *Vec3T[T]) *Vec3T[T] {
 
 	if this[0] < min[0] {
 		this[0] = min[0]
@@ -387,17 +401,217 @@ func (this *Vec3) Clamp(min, max *Vec3) *Vec3 {
 }
 
 // clamps each element between 0 and 1
-func (this *Vec3) Clamp01() *Vec3 {
+func (this *Vec3T[T]) Clamp01() *Vec3T[T] {
+
+	this[0] = clamp01(this[0])
+	this[1] = clamp01(this[1])
+	this[2] = clamp01(this[2])
+
+	return this
+}
+
+// transforms this by Mat3
+func (this *Vec3T[T]) ApplyMat3(m *Mat3T[T]) *Vec3T[T] {
+	x, y, z := this[0], this[1], this[2]
+
+	this[0] = m[0]*x + m[3]*y + m[6]*z
+	this[1] = m[1]*x + m[4]*y + m[7]*z
+	this[2] = m[2]*x + m[5]*y + m[8]*z
+
+	return this
+}
+
+// transforms v by Mat3 saves in this
+func (this *Vec3T[T]) VApplyMat3(v *Vec3T[T], m *Mat3T[T]) *Vec3T[T] {
+	x, y, z := v[0], v[1], v[2]
+
+	this[0] = m[0]*x + m[3]*y + m[6]*z
+	this[1] = m[1]*x + m[4]*y + m[7]*z
+	this[2] = m[2]*x + m[5]*y + m[8]*z
+
+	return this
+}
+
+// transforms this as a point by Mat4, w of 1
+func (this *Vec3T[T]) ApplyMat4(m *Mat4T[T]) *Vec3T[T] {
+	x, y, z := this[0], this[1], this[2]
+
+	this[0] = m[0]*x + m[4]*y + m[8]*z + m[12]
+	this[1] = m[1]*x + m[5]*y + m[9]*z + m[13]
+	this[2] = m[2]*x + m[6]*y + m[10]*z + m[14]
+
+	return this
+}
+
+// transforms v as a point by Mat4 saves in this, w of 1
+func (this *Vec3T[T]) VApplyMat4(v *Vec3T[T], m *Mat4T[T]) *Vec3T[T] {
+	x, y, z := v[0], v[1], v[2]
+
+	this[0] = m[0]*x + m[4]*y + m[8]*z + m[12]
+	this[1] = m[1]*x + m[5]*y + m[9]*z + m[13]
+	this[2] = m[2]*x + m[6]*y + m[10]*z + m[14]
+
+	return this
+}
+
+// transforms this as a direction by Mat4, w of 0
+func (this *Vec3T[T]) ApplyMat4Direction(m *Mat4T[T]) *Vec3T[T] {
+	x, y, z := this[0], this[1], this[2]
+
+	this[0] = m[0]*x + m[4]*y + m[8]*z
+	this[1] = m[1]*x + m[5]*y + m[9]*z
+	this[2] = m[2]*x + m[6]*y + m[10]*z
+
+	return this
+}
+
+// transforms v as a direction by Mat4 saves in this, w of 0
+func (this *Vec3T[T]) VApplyMat4Direction(v *Vec3T[T], m *Mat4T[T]) *Vec3T[T] {
+	x, y, z := v[0], v[1], v[2]
+
+	this[0] = m[0]*x + m[4]*y + m[8]*z
+	this[1] = m[1]*x + m[5]*y + m[9]*z
+	this[2] = m[2]*x + m[6]*y + m[10]*z
+
+	return this
+}
+
+// transforms this as a point by Mat4 and divides by the resulting w, a zero w is a point at infinity and is not divided
+func (this *Vec3T[T]) ApplyProjection(m *Mat4T[T]) *Vec3T[T] {
+	x, y, z := this[0], this[1], this[2]
+	w := m[3]*x + m[7]*y + m[11]*z + m[15]
+
+	if w == 0 {
+		w = 1
+	}
+
+	w = 1 / w
+
+	this[0] = (m[0]*x + m[4]*y + m[8]*z + m[12]) * w
+	this[1] = (m[1]*x + m[5]*y + m[9]*z + m[13]) * w
+	this[2] = (m[2]*x + m[6]*y + m[10]*z + m[14]) * w
+
+	return this
+}
+
+// transforms v as a point by Mat4 and divides by the resulting w, a zero w is a point at infinity and is not divided, saves in this
+func (this *Vec3T[T]) VApplyProjection(v *Vec3T[T], m *Mat4T[T]) *Vec3T[T] {
+	x, y, z := v[0], v[1], v[2]
+	w := m[3]*x + m[7]*y + m[11]*z + m[15]
+
+	if w == 0 {
+		w = 1
+	}
+
+	w = 1 / w
+
+	this[0] = (m[0]*x + m[4]*y + m[8]*z + m[12]) * w
+	this[1] = (m[1]*x + m[5]*y + m[9]*z + m[13]) * w
+	this[2] = (m[2]*x + m[6]*y + m[10]*z + m[14]) * w
+
+	return this
+}
+
+// rotates this by Quat
+func (this *Vec3T[T]) ApplyQuat(q *QuatT[T]) *Vec3T[T] {
+	x, y, z := this[0], this[1], this[2]
+	qx, qy, qz, qw := q[0], q[1], q[2], q[3]
+
+	ix := qw*x + qy*z - qz*y
+	iy := qw*y + qz*x - qx*z
+	iz := qw*z + qx*y - qy*x
+	iw := -qx*x - qy*y - qz*z
+
+	this[0] = ix*qw + iw*-qx + iy*-qz - iz*-qy
+	this[1] = iy*qw + iw*-qy + iz*-qx - ix*-qz
+	this[2] = iz*qw + iw*-qz + ix*-qy - iy*-qx
+
+	return this
+}
+
+// rotates v by Quat saves in this
+func (this *Vec3T[T]) VApplyQuat(v *Vec3T[T], q *QuatT[T]) *Vec3T[T] {
+	x, y, z := v[0], v[1], v[2]
+	qx, qy, qz, qw := q[0], q[1], q[2], q[3]
+
+	ix := qw*x + qy*z - qz*y
+	iy := qw*y + qz*x - qx*z
+	iz := qw*z + qx*y - qy*x
+	iw := -qx*x - qy*y - qz*z
+
+	this[0] = ix*qw + iw*-qx + iy*-qz - iz*-qy
+	this[1] = iy*qw + iw*-qy + iz*-qx - ix*-qz
+	this[2] = iz*qw + iw*-qz + ix*-qy - iy*-qx
+
+	return this
+}
+
+// transforms this by DualQuat, dq should be normalized
+func (this *Vec3T[T]) ApplyDualQuat(dq *DualQuat) *Vec3T[T] {
+	var t Vec3
+
+	dq.GetTranslation(&t)
+	q := QuatT[T]{T(dq[0]), T(dq[1]), T(dq[2]), T(dq[3])}
+
+	this.ApplyQuat(&q)
+
+	this[0] += T(t[0])
+	this[1] += T(t[1])
+	this[2] += T(t[2])
+
+	return this
+}
+
+// transforms v by DualQuat saves in this
+func (this *Vec3T[T]) VApplyDualQuat(v *Vec3T[T], dq *DualQuat) *Vec3T[T] {
+
+	return this.Copy(v).ApplyDualQuat(dq)
+}
+
+// projects this from world space to window coordinates, viewport is x, y, width, height and z is depth from 0 to 1
+func (this *Vec3T[T]) Project(view, projection *Mat4T[T], viewport *Vec4T[T]) *Vec3T[T] {
+
+	return this.ProjectClip(view, projection, viewport, 0)
+}
+
+// projects this from world space to window coordinates with projection built with clip space options, z is the depth buffer value from 0 to 1
+func (this *Vec3T[T]) ProjectClip(view, projection *Mat4T[T], viewport *Vec4T[T], flags int) *Vec3T[T] {
+	var m Mat4T[T]
+
+	this.ApplyProjection(m.MMul(projection, view))
 
-	this[0] = Clamp01(this[0])
-	this[1] = Clamp01(this[1])
-	this[2] = Clamp01(this[2])
+	this[0] = viewport[0] + (this[0]+1)*0.5*viewport[2]
+	this[1] = viewport[1] + (this[1]+1)*0.5*viewport[3]
+
+	if flags&CLIP_ZERO_TO_ONE == 0 {
+		this[2] = (this[2] + 1) * 0.5
+	}
 
 	return this
 }
 
+// unprojects this from window coordinates to world space, viewport is x, y, width, height and z is depth from 0 to 1
+func (this *Vec3T[T]) Unproject(view, projection *Mat4T[T], viewport *Vec4T[T]) *Vec3T[T] {
+
+	return this.UnprojectClip(view, projection, viewport, 0)
+}
+
+// unprojects this from window coordinates to world space with projection built with clip space options, z is the depth buffer value from 0 to 1
+func (this *Vec3T[T]) UnprojectClip(view, projection *Mat4T[T], viewport *Vec4T[T], flags int) *Vec3T[T] {
+	var m Mat4T[T]
+
+	this[0] = (this[0]-viewport[0])/viewport[2]*2 - 1
+	this[1] = (this[1]-viewport[1])/viewport[3]*2 - 1
+
+	if flags&CLIP_ZERO_TO_ONE == 0 {
+		this[2] = this[2]*2 - 1
+	}
+
+	return this.ApplyProjection(m.MMul(projection, view).Inverse())
+}
+
 // sets values from Vec3
-func (this *Vec3) FromVec2(v *Vec2) *Vec3 {
+func (this *Vec3T[T]) FromVec2(v *Vec2T[T]) *Vec3T[T] {
 
 	this[0] = v[0]
 	this[1] = v[1]
@@ -407,7 +621,7 @@ func (this *Vec3) FromVec2(v *Vec2) *Vec3 {
 }
 
 // sets values from Vec4
-func (this *Vec3) FromVec4(v *Vec4) *Vec3 {
+func (this *Vec3T[T]) FromVec4(v *Vec4T[T]) *Vec3T[T] {
 
 	this[0] = v[0]
 	this[1] = v[1]
@@ -416,8 +630,107 @@ func (this *Vec3) FromVec4(v *Vec4) *Vec3 {
 	return this
 }
 
-// checks if this equals other
-func (this *Vec3) Equals(other *Vec3) bool {
+// returns sum of this and other by value
+func (this Vec3T[T]) Plus(other Vec3T[T]) Vec3T[T] {
+
+	return Vec3T[T]{this[0] + other[0], this[1] + other[1], this[2] + other[2]}
+}
+
+// returns difference of this and other by value
+func (this Vec3T[T]) Minus(other Vec3T[T]) Vec3T[T] {
+
+	return Vec3T[T]{this[0] - other[0], this[1] - other[1], this[2] - other[2]}
+}
+
+// returns component product of this and other by value
+func (this Vec3T[T]) Times(other Vec3T[T]) Vec3T[T] {
+
+	return Vec3T[T]{this[0] * other[0], this[1] * other[1], this[2] * other[2]}
+}
+
+// returns component quotient of this and other by value
+func (this Vec3T[T]) DividedBy(other Vec3T[T]) Vec3T[T] {
+
+	return *this.Div(&other)
+}
+
+// returns this scaled by s by value
+func (this Vec3T[T]) Scaled(s T) Vec3T[T] {
+
+	return Vec3T[T]{this[0] * s, this[1] * s, this[2] * s}
+}
+
+// returns this normalized by value
+func (this Vec3T[T]) Normalized() Vec3T[T] {
+
+	return *this.Normalize()
+}
+
+// returns this negated by value
+func (this Vec3T[T]) Negated() Vec3T[T] {
+
+	return Vec3T[T]{-this[0], -this[1], -this[2]}
+}
+
+// returns lerp of this and other by x by value
+func (this Vec3T[T]) Lerped(other Vec3T[T], x T) Vec3T[T] {
+
+	return Vec3T[T]{this[0] + (other[0]-this[0])*x, this[1] + (other[1]-this[1])*x, this[2] + (other[2]-this[2])*x}
+}
+
+// returns this clamped between min and max by value
+func (this Vec3T[T]) Clamped(min, max Vec3T[T]) Vec3T[T] {
+
+	return *this.Clamp(&min, &max)
+}
+
+// returns cross product of this and other by value
+func (this Vec3T[T]) Crossed(other Vec3T[T]) Vec3T[T] {
+	var v Vec3T[T]
+
+	return *v.VCross(&this, &other)
+}
+
+// returns this transformed as a point by Mat4 by value
+func (this Vec3T[T]) Transformed(m Mat4T[T]) Vec3T[T] {
+
+	return *this.ApplyMat4(&m)
+}
+
+// returns this transformed as a direction by Mat4 by value
+func (this Vec3T[T]) TransformedDirection(m Mat4T[T]) Vec3T[T] {
+
+	return *this.ApplyMat4Direction(&m)
+}
+
+// returns this rotated by Quat by value
+func (this Vec3T[T]) Rotated(q QuatT[T]) Vec3T[T] {
+
+	return *this.ApplyQuat(&q)
+}
+
+// converts this to float32, saves in target
+func (this *Vec3T[T]) Float32(target *Vec3) *Vec3 {
+
+	for i, v := range this {
+		target[i] = float32(v)
+	}
+
+	return target
+}
+
+// converts this to float64, saves in target
+func (this *Vec3T[T]) Float64(target *Vec3d) *Vec3d {
+
+	for i, v := range this {
+		target[i] = float64(v)
+	}
+
+	return target
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *Vec3T[T]) Equals(other *Vec3T[T]) bool {
 
 	if this[0] != other[0] {
 		return false
@@ -432,8 +745,62 @@ func (this *Vec3) Equals(other *Vec3) bool {
 	return true
 }
 
+// checks if this equals other within absolute tolerance tol
+func (this *Vec3T[T]) EqualsTol(other *Vec3T[T], tol T) bool {
+
+	return equalsTolSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other within tol relative to its largest element magnitude
+func (this *Vec3T[T]) EqualsRel(other *Vec3T[T], tol T) bool {
+
+	return equalsRelSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *Vec3T[T]) EqualsULP(other *Vec3T[T], ulps int) bool {
+
+	return equalsULPSlice(this[:], other[:], ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *Vec3T[T]) MarshalText() ([]byte, error) {
+
+	return appendText(nil, vec3Text, this[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *Vec3T[T]) UnmarshalText(text []byte) error {
+
+	return parseText(text, vec3Text, this[:])
+}
+
+// returns this as a JSON array, implements json.Marshaler
+func (this *Vec3T[T]) MarshalJSON() ([]byte, error) {
+
+	return appendJSON(nil, this[:])
+}
+
+// parses a JSON array into this, implements json.Unmarshaler
+func (this *Vec3T[T]) UnmarshalJSON(data []byte) error {
+
+	return parseJSON(data, this[:])
+}
+
+// returns this as little endian floats, implements encoding.BinaryMarshaler
+func (this *Vec3T[T]) MarshalBinary() ([]byte, error) {
+
+	return appendBinary(nil, this[:]), nil
+}
+
+// parses little endian floats into this, implements encoding.BinaryUnmarshaler
+func (this *Vec3T[T]) UnmarshalBinary(data []byte) error {
+
+	return parseBinary(data, this[:])
+}
+
 // returns this as string type
-func (this *Vec3) String() string {
+func (this *Vec3T[T]) String() string {
 
 	return fmt.Sprintf("Vec3[ %f, %f, %f ]", this[0], this[1], this[2])
 }
@@ -0,0 +1,178 @@
+package mathf
+
+import (
+	"testing"
+)
+
+// keeps benchmarked results alive
+var vec3Sink Vec3
+
+func TestVec3Cross(t *testing.T) {
+	tests := []struct {
+		a, b, want Vec3
+	}{
+		{Vec3{1, 0, 0}, Vec3{0, 1, 0}, Vec3{0, 0, 1}},
+		{Vec3{0, 1, 0}, Vec3{0, 0, 1}, Vec3{1, 0, 0}},
+		{Vec3{1, 2, 3}, Vec3{4, 5, 6}, Vec3{-3, 6, -3}},
+		{Vec3{2, 2, 2}, Vec3{2, 2, 2}, Vec3{0, 0, 0}},
+	}
+
+	for _, test := range tests {
+		a := test.a
+
+		if got := a.Cross(&test.b); *got != test.want {
+			t.Errorf("%v.Cross(%v) = %v, want %v", test.a, test.b, *got, test.want)
+		}
+		if got := test.a.Crossed(test.b); got != test.want {
+			t.Errorf("%v.Crossed(%v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+
+		a = test.a
+
+		if got := a.VCross(&a, &test.b); *got != test.want {
+			t.Errorf("VCross(%v, %v) aliased = %v, want %v", test.a, test.b, *got, test.want)
+		}
+	}
+}
+
+func TestVec3ValueAllocs(t *testing.T) {
+	a, b := Vec3{1, 2, 3}, Vec3{4, 5, 6}
+	m := Mat4{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, 1, 2, 3, 1}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		vec3Sink = a.Plus(b).Minus(b).Scaled(2).Crossed(b).Normalized().Lerped(a, 0.5).Transformed(m)
+	})
+
+	if allocs != 0 {
+		t.Errorf("value API allocates %v times per run, want 0", allocs)
+	}
+}
+
+func BenchmarkVec3Pointer(b *testing.B) {
+	a, c := NewVec3(1, 2, 3), NewVec3(4, 5, 6)
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		vec3Sink = *a.Clone().Add(c).SMul(2).Cross(c).Normalize()
+	}
+}
+
+func BenchmarkVec3Value(b *testing.B) {
+	a, c := Vec3{1, 2, 3}, Vec3{4, 5, 6}
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		vec3Sink = a.Plus(c).Scaled(2).Crossed(c).Normalized()
+	}
+}
+
+func TestVec3ApplyProjection(t *testing.T) {
+	var perspective Mat4
+
+	perspective.MakePerspective(Pi*0.5, 1, 1, 100)
+
+	tests := []struct {
+		name    string
+		v, want Vec3
+	}{
+		{"near center", Vec3{0, 0, -1}, Vec3{0, 0, -1}},
+		{"far center", Vec3{0, 0, -100}, Vec3{0, 0, 1}},
+		{"near corner", Vec3{1, 1, -1}, Vec3{1, 1, -1}},
+		{"at infinity", Vec3{2, 3, 0}, Vec3{2, 3, perspective[14]}},
+	}
+
+	for _, test := range tests {
+		v := test.v
+
+		if got := v.ApplyProjection(&perspective); !got.EqualsTol(&test.want, 1e-5) {
+			t.Errorf("%s: %v.ApplyProjection() = %v, want %v", test.name, test.v, *got, test.want)
+		}
+		if got := new(Vec3).VApplyProjection(&test.v, &perspective); !got.EqualsTol(&test.want, 1e-5) {
+			t.Errorf("%s: VApplyProjection(%v) = %v, want %v", test.name, test.v, *got, test.want)
+		}
+	}
+}
+
+func TestVec3ProjectClip(t *testing.T) {
+	view := NewMat4().Compose(&Vec3{1, 2, 3}, &Vec3{1, 1, 1}, NewQuat().FromAxisAngle(&Vec3{0, 1, 0}, 0.3))
+	inverse := view.Clone().Inverse()
+	viewport := Vec4{10, 20, 640, 480}
+
+	for _, test := range clipFlagTests {
+		var projection Mat4
+
+		projection.MakePerspectiveClip(Pi*0.5, 640.0/480, 1, 100, test.flags)
+
+		nearDepth, farDepth := float32(0), float32(1)
+
+		if test.flags&CLIP_REVERSE_Z != 0 {
+			nearDepth, farDepth = 1, 0
+		}
+
+		near := *NewVec3(0, 0, -1).ApplyMat4(inverse)
+		far := *NewVec3(0, 0, -100).ApplyMat4(inverse)
+
+		if got := near.Clone().ProjectClip(view, &projection, &viewport, test.flags); !EqualsTol(got[2], nearDepth, 1e-5) {
+			t.Errorf("%s: near depth = %v, want %v", test.name, got[2], nearDepth)
+		}
+		if test.flags&CLIP_INFINITE_FAR == 0 {
+			if got := far.Clone().ProjectClip(view, &projection, &viewport, test.flags); !EqualsTol(got[2], farDepth, 1e-4) {
+				t.Errorf("%s: far depth = %v, want %v", test.name, got[2], farDepth)
+			}
+		}
+
+		for _, v := range []Vec3{{0, 0, -1}, {1, -2, -7}, {-30, 20, -50}} {
+			v.ApplyMat4(inverse)
+			got := v.Clone().ProjectClip(view, &projection, &viewport, test.flags).UnprojectClip(view, &projection, &viewport, test.flags)
+
+			if !got.EqualsRel(&v, 1e-3) {
+				t.Errorf("%s: Unproject(Project(%v)) = %v", test.name, v, *got)
+			}
+		}
+	}
+}
+
+func TestVec3ApplyMatrix(t *testing.T) {
+	rotation := Mat3{0, 1, 0, -1, 0, 0, 0, 0, 1}
+	transform := Mat4{0, 1, 0, 0, -1, 0, 0, 0, 0, 0, 1, 0, 10, 20, 30, 1}
+	q := *NewQuat().FromAxisAngle(&Vec3{0, 0, 1}, Pi*0.5)
+	dq := NewDualQuat().FromRotationTranslation(&q, &Vec3{10, 20, 30})
+
+	tests := []struct {
+		name    string
+		apply   func(v *Vec3) *Vec3
+		applyTo func(v *Vec3) *Vec3
+		want    Vec3
+	}{
+		{"Mat3", func(v *Vec3) *Vec3 { return v.ApplyMat3(&rotation) }, func(v *Vec3) *Vec3 { return new(Vec3).VApplyMat3(v, &rotation) }, Vec3{-2, 1, 3}},
+		{"Mat4", func(v *Vec3) *Vec3 { return v.ApplyMat4(&transform) }, func(v *Vec3) *Vec3 { return new(Vec3).VApplyMat4(v, &transform) }, Vec3{8, 21, 33}},
+		{"Mat4Direction", func(v *Vec3) *Vec3 { return v.ApplyMat4Direction(&transform) }, func(v *Vec3) *Vec3 { return new(Vec3).VApplyMat4Direction(v, &transform) }, Vec3{-2, 1, 3}},
+		{"Quat", func(v *Vec3) *Vec3 { return v.ApplyQuat(&q) }, func(v *Vec3) *Vec3 { return new(Vec3).VApplyQuat(v, &q) }, Vec3{-2, 1, 3}},
+		{"DualQuat", func(v *Vec3) *Vec3 { return v.ApplyDualQuat(dq) }, func(v *Vec3) *Vec3 { return new(Vec3).VApplyDualQuat(v, dq) }, Vec3{8, 21, 33}},
+	}
+
+	for _, test := range tests {
+		v := Vec3{1, 2, 3}
+
+		if got := test.apply(&v); !got.EqualsTol(&test.want, 1e-5) {
+			t.Errorf("Apply%s() = %v, want %v", test.name, *got, test.want)
+		}
+		if got := test.applyTo(&Vec3{1, 2, 3}); !got.EqualsTol(&test.want, 1e-5) {
+			t.Errorf("VApply%s() = %v, want %v", test.name, *got, test.want)
+		}
+	}
+
+	v := Vec3{1, 2, 3}
+
+	if got := v.Transformed(transform); got != (Vec3{8, 21, 33}) {
+		t.Errorf("Transformed = %v, want %v", got, Vec3{8, 21, 33})
+	}
+	if got := v.TransformedDirection(transform); got != (Vec3{-2, 1, 3}) {
+		t.Errorf("TransformedDirection = %v, want %v", got, Vec3{-2, 1, 3})
+	}
+	if got, want := v.Rotated(q), (Vec3{-2, 1, 3}); !got.EqualsTol(&want, 1e-5) {
+		t.Errorf("Rotated = %v, want %v", got, want)
+	}
+}
@@ -5,8 +5,14 @@ import (
 	"math"
 )
 
-// float32 Array representing a 4D Vector
-type Vec4 [4]float32
+// Array representing a 4D Vector of float32 or float64
+type Vec4T[T Float] [4]T
+
+// float32 Vec4
+type Vec4 = Vec4T[float32]
+
+// float64 Vec4
+type Vec4d = Vec4T[float64]
 
 // returns new Vec4
 func NewVec4(x, y, z, w float32) *Vec4 {
@@ -17,14 +23,23 @@ func NewVec4(x, y, z, w float32) *Vec4 {
 	return this
 }
 
+// returns new Vec4d
+func NewVec4d(x, y, z, w float64) *Vec4d {
+	this := new(Vec4d)
+
+	this[0], this[1], this[2], this[3] = x, y, z, w
+
+	return this
+}
+
 // returns a copy of this
-func (this *Vec4) Clone() *Vec4 {
+func (this *Vec4T[T]) Clone() *Vec4T[T] {
 
-	return new(Vec4).Copy(this)
+	return new(Vec4T[T]).Copy(this)
 }
 
 // copies other
-func (this *Vec4) Copy(other *Vec4) *Vec4 {
+func (this *Vec4T[T]) Copy(other *Vec4T[T]) *Vec4T[T] {
 
 	this[0], this[1], this[2], this[3] = other[0], other[1], other[2], other[3]
 
@@ -32,7 +47,7 @@ func (this *Vec4) Copy(other *Vec4) *Vec4 {
 }
 
 // sets this from values
-func (this *Vec4) Set(x, y, z, w float32) *Vec4 {
+func (this *Vec4T[T]) Set(x, y, z, w T) *Vec4T[T] {
 
 	this[0], this[1], this[2], this[3] = x, y, z, w
 
@@ -40,7 +55,7 @@ func (this *Vec4) Set(x, y, z, w float32) *Vec4 {
 }
 
 // adds other to this
-func (this *Vec4) Add(other *Vec4) *Vec4 {
+func (this *Vec4T[T]) Add(other *Vec4T[T]) *Vec4T[T] {
 
 	this[0] += other[0]
 	this[1] += other[1]
@@ -51,7 +66,7 @@ func (this *Vec4) Add(other *Vec4) *Vec4 {
 }
 
 // adds a and b saves in this
-func (this *Vec4) VAdd(a, b *Vec4) *Vec4 {
+func (this *Vec4T[T]) VAdd(a, b *Vec4T[T]) *Vec4T[T] {
 
 	this[0] = a[0] + b[0]
 	this[1] = a[1] + b[1]
@@ -62,7 +77,7 @@ func (this *Vec4) VAdd(a, b *Vec4) *Vec4 {
 }
 
 // adds scalar to this
-func (this *Vec4) SAdd(s float32) *Vec4 {
+func (this *Vec4T[T]) SAdd(s T) *Vec4T[T] {
 
 	this[0] += s
 	this[1] += s
@@ -73,7 +88,7 @@ func (this *Vec4) SAdd(s float32) *Vec4 {
 }
 
 // subtracts other from this
-func (this *Vec4) Sub(other *Vec4) *Vec4 {
+func (this *Vec4T[T]) Sub(other *Vec4T[T]) *Vec4T[T] {
 
 	this[0] -= other[0]
 	this[1] -= other[1]
@@ -84,7 +99,7 @@ func (this *Vec4) Sub(other *Vec4) *Vec4 {
 }
 
 // subtracts a and b saves in this
-func (this *Vec4) VSub(a, b *Vec4) *Vec4 {
+func (this *Vec4T[T]) VSub(a, b *Vec4T[T]) *Vec4T[T] {
 
 	this[0] = a[0] - b[0]
 	this[1] = a[1] - b[1]
@@ -95,7 +110,7 @@ func (this *Vec4) VSub(a, b *Vec4) *Vec4 {
 }
 
 // subtracts scalar from this
-func (this *Vec4) SSub(s float32) *Vec4 {
+func (this *Vec4T[T]) SSub(s T) *Vec4T[T] {
 
 	this[0] -= s
 	this[1] -= s
@@ -106,7 +121,7 @@ func (this *Vec4) SSub(s float32) *Vec4 {
 }
 
 // mutiples this by other
-func (this *Vec4) Mul(other *Vec4) *Vec4 {
+func (this *Vec4T[T]) Mul(other *Vec4T[T]) *Vec4T[T] {
 
 	this[0] *= other[0]
 	this[1] *= other[1]
@@ -117,7 +132,7 @@ func (this *Vec4) Mul(other *Vec4) *Vec4 {
 }
 
 // mutiples a and b saves in this
-func (this *Vec4) VMul(a, b *Vec4) *Vec4 {
+func (this *Vec4T[T]) VMul(a, b *Vec4T[T]) *Vec4T[T] {
 
 	this[0] = a[0] * b[0]
 	this[1] = a[1] * b[1]
@@ -128,7 +143,7 @@ func (this *Vec4) VMul(a, b *Vec4) *Vec4 {
 }
 
 // mutiples this by scalar
-func (this *Vec4) SMul(s float32) *Vec4 {
+func (this *Vec4T[T]) SMul(s T) *Vec4T[T] {
 
 	this[0] *= s
 	this[1] *= s
@@ -139,7 +154,7 @@ func (this *Vec4) SMul(s float32) *Vec4 {
 }
 
 // divides this by other
-func (this *Vec4) Div(other *Vec4) *Vec4 {
+func (this *Vec4T[T]) Div(other *Vec4T[T]) *Vec4T[T] {
 	x, y, z, w := other[0], other[1], other[2], other[3]
 
 	if x != 0 {
@@ -164,7 +179,7 @@ func (this *Vec4) Div(other *Vec4) *Vec4 {
 }
 
 // divides a and b saves in this
-func (this *Vec4) VDiv(a, b *Vec4) *Vec4 {
+func (this *Vec4T[T]) VDiv(a, b *Vec4T[T]) *Vec4T[T] {
 	x, y, z, w := b[0], b[1], b[2], b[3]
 
 	if x != 0 {
@@ -189,7 +204,7 @@ func (this *Vec4) VDiv(a, b *Vec4) *Vec4 {
 }
 
 // divides this by scalar
-func (this *Vec4) SDiv(s float32) *Vec4 {
+func (this *Vec4T[T]) SDiv(s T) *Vec4T[T] {
 	if s != 0 {
 		s = 1 / s
 	}
@@ -203,14 +218,14 @@ func (this *Vec4) SDiv(s float32) *Vec4 {
 }
 
 // normalize vector, makes length of 1
-func (this *Vec4) Normalize() *Vec4 {
+func (this *Vec4T[T]) Normalize() *Vec4T[T] {
 	l := this[0]*this[0] + this[1]*this[1] + this[2]*this[2] + this[3]*this[3]
 
 	if l == 0 {
 		return this
 	}
 
-	l = 1 / float32(math.Sqrt(float64(l)))
+	l = 1 / T(math.Sqrt(float64(l)))
 	this[0] *= l
 	this[1] *= l
 	this[2] *= l
@@ -220,31 +235,31 @@ func (this *Vec4) Normalize() *Vec4 {
 }
 
 // returns length of this
-func (this *Vec4) Length() float32 {
+func (this *Vec4T[T]) Length() T {
 	l := this[0]*this[0] + this[1]*this[1] + this[2]*this[2] + this[3]*this[3]
 
 	if l == 0 {
 		return 0
 	}
 
-	return float32(math.Sqrt(float64(l)))
+	return T(math.Sqrt(float64(l)))
 }
 
 // returns length squared of this
-func (this *Vec4) LengthSq() float32 {
+func (this *Vec4T[T]) LengthSq() T {
 
 	return this[0]*this[0] + this[1]*this[1] + this[2]*this[2] + this[3]*this[3]
 }
 
 // sets length of vector, if length is zero returns zero vector
-func (this *Vec4) SetLength(length float32) *Vec4 {
+func (this *Vec4T[T]) SetLength(length T) *Vec4T[T] {
 	l := this[0]*this[0] + this[1]*this[1] + this[2]*this[2] + this[3]*this[3]
 
 	if l == 0 {
 		return this
 	}
 
-	l = 1 / float32(math.Sqrt(float64(l)))
+	l = 1 / T(math.Sqrt(float64(l)))
 	this[0] *= length * l
 	this[1] *= length * l
 	this[2] *= length * l
@@ -254,19 +269,19 @@ func (this *Vec4) SetLength(length float32) *Vec4 {
 }
 
 // returns dot product of this and other
-func (this *Vec4) Dot(other *Vec4) float32 {
+func (this *Vec4T[T]) Dot(other *Vec4T[T]) T {
 
 	return this[0]*other[0] + this[1]*other[1] + this[2]*other[2] + this[3]*other[3]
 }
 
 // returns dot product of a and b
-func (this *Vec4) VDot(a, b *Vec4) float32 {
+func (this *Vec4T[T]) VDot(a, b *Vec4T[T]) T {
 
 	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2] + a[3]*b[3]
 }
 
 // returns inverse of this
-func (this *Vec4) Inverse() *Vec4 {
+func (this *Vec4T[T]) Inverse() *Vec4T[T] {
 
 	this[0] *= -1
 	this[1] *= -1
@@ -277,7 +292,7 @@ func (this *Vec4) Inverse() *Vec4 {
 }
 
 // saves inverse of other in this
-func (this *Vec4) VInverse(other *Vec4) *Vec4 {
+func (this *Vec4T[T]) VInverse(other *Vec4T[T]) *Vec4T[T] {
 
 	this[0] = -other[0]
 	this[1] = -other[1]
@@ -288,7 +303,7 @@ func (this *Vec4) VInverse(other *Vec4) *Vec4 {
 }
 
 // lerps this and other by x
-func (this *Vec4) Lerp(other *Vec4, x float32) *Vec4 {
+func (this *Vec4T[T]) Lerp(other *Vec4T[T], x T) *Vec4T[T] {
 
 	this[0] += (other[0] - this[0]) * x
 	this[1] += (other[1] - this[1]) * x
@@ -299,7 +314,7 @@ func (this *Vec4) Lerp(other *Vec4, x float32) *Vec4 {
 }
 
 // lerps a and b by x, saves in this
-func (this *Vec4) VLerp(a, b *Vec4, x float32) *Vec4 {
+func (this *Vec4T[T]) VLerp(a, b *Vec4T[T], x T) *Vec4T[T] {
 
 	this[0] = a[0] + (b[0]-a[0])*x
 	this[1] = a[1] + (b[1]-a[1])*x
@@ -310,7 +325,7 @@ func (this *Vec4) VLerp(a, b *Vec4, x float32) *Vec4 {
 }
 
 // returns distance to other
-func (this *Vec4) DistanceTo(other *Vec4) float32 {
+func (this *Vec4T[T]) DistanceTo(other *Vec4T[T]) T {
 	x := this[0] - other[0]
 	y := this[1] - other[1]
 	z := this[2] - other[2]
@@ -321,11 +336,11 @@ func (this *Vec4) DistanceTo(other *Vec4) float32 {
 		return 0
 	}
 
-	return float32(math.Sqrt(float64(l)))
+	return T(math.Sqrt(float64(l)))
 }
 
 // returns distance squared to other
-func (this *Vec4) DistanceToSq(other *Vec4) float32 {
+func (this *Vec4T[T]) DistanceToSq(other *Vec4T[T]) T {
 	x := this[0] - other[0]
 	y := this[1] - other[1]
 	z := this[2] - other[2]
@@ -335,7 +350,7 @@ func (this *Vec4) DistanceToSq(other *Vec4) float32 {
 }
 
 // sets this values from  min values of this and other
-func (this *Vec4) Min(other *Vec4) *Vec4 {
+func (this *Vec4T[T]) Min(other *Vec4T[T]) *Vec4T[T] {
 
 	if this[0] > other[0] {
 		this[0] = other[0]
@@ -354,7 +369,7 @@ func (this *Vec4) Min(other *Vec4) *Vec4 {
 }
 
 // sets this values from max values of this and other
-func (this *Vec4) Max(other *Vec4) *Vec4 {
+func (this *Vec4T[T]) Max(other *Vec4T[T]) *Vec4T[T] {
 
 	if this[0] < other[0] {
 		this[0] = other[0]
@@ -373,7 +388,7 @@ func (this *Vec4) Max(other *Vec4) *Vec4 {
 }
 
 // clamps this between min and max
-func (this *Vec4) Clamp(min, max *Vec4) *Vec4 {
+func (this *Vec4T[T]) Clamp(min, max *Vec4T[T]) *Vec4T[T] {
 
 	if this[0] < min[0] {
 		this[0] = min[0]
@@ -405,18 +420,60 @@ func (this *Vec4) Clamp(min, max *Vec4) *Vec4 {
 }
 
 // clamps each element between 0 and 1
-func (this *Vec4) Clamp01() *Vec4 {
+func (this *Vec4T[T]) Clamp01() *Vec4T[T] {
+
+	this[0] = clamp01(this[0])
+	this[1] = clamp01(this[1])
+	this[2] = clamp01(this[2])
+	this[3] = clamp01(this[3])
+
+	return this
+}
+
+// transforms this by Mat4
+func (this *Vec4T[T]) ApplyMat4(m *Mat4T[T]) *Vec4T[T] {
+	x, y, z, w := this[0], this[1], this[2], this[3]
+
+	this[0] = m[0]*x + m[4]*y + m[8]*z + m[12]*w
+	this[1] = m[1]*x + m[5]*y + m[9]*z + m[13]*w
+	this[2] = m[2]*x + m[6]*y + m[10]*z + m[14]*w
+	this[3] = m[3]*x + m[7]*y + m[11]*z + m[15]*w
+
+	return this
+}
+
+// transforms v by Mat4 saves in this
+func (this *Vec4T[T]) VApplyMat4(v *Vec4T[T], m *Mat4T[T]) *Vec4T[T] {
+	x, y, z, w := v[0], v[1], v[2], v[3]
+
+	this[0] = m[0]*x + m[4]*y + m[8]*z + m[12]*w
+	this[1] = m[1]*x + m[5]*y + m[9]*z + m[13]*w
+	this[2] = m[2]*x + m[6]*y + m[10]*z + m[14]*w
+	this[3] = m[3]*x + m[7]*y + m[11]*z + m[15]*w
+
+	return this
+}
+
+// divides x, y and z by w, sets w to 1, a zero w is a point at infinity and this is unchanged
+func (this *Vec4T[T]) PerspectiveDivide() *Vec4T[T] {
+	w := this[3]
+
+	if w == 0 {
+		return this
+	}
+
+	w = 1 / w
 
-	this[0] = Clamp01(this[0])
-	this[1] = Clamp01(this[1])
-	this[2] = Clamp01(this[2])
-	this[3] = Clamp01(this[3])
+	this[0] *= w
+	this[1] *= w
+	this[2] *= w
+	this[3] = 1
 
 	return this
 }
 
 // sets values from Vec2
-func (this *Vec4) FromVec2(v *Vec2) *Vec4 {
+func (this *Vec4T[T]) FromVec2(v *Vec2T[T]) *Vec4T[T] {
 
 	this[0] = v[0]
 	this[1] = v[1]
@@ -427,7 +484,7 @@ func (this *Vec4) FromVec2(v *Vec2) *Vec4 {
 }
 
 // sets values from Vec3
-func (this *Vec4) FromVec3(v *Vec3) *Vec4 {
+func (this *Vec4T[T]) FromVec3(v *Vec3T[T]) *Vec4T[T] {
 
 	this[0] = v[0]
 	this[1] = v[1]
@@ -437,8 +494,88 @@ func (this *Vec4) FromVec3(v *Vec3) *Vec4 {
 	return this
 }
 
-// checks if this equals other
-func (this *Vec4) Equals(other *Vec4) bool {
+// returns sum of this and other by value
+func (this Vec4T[T]) Plus(other Vec4T[T]) Vec4T[T] {
+
+	return Vec4T[T]{this[0] + other[0], this[1] + other[1], this[2] + other[2], this[3] + other[3]}
+}
+
+// returns difference of this and other by value
+func (this Vec4T[T]) Minus(other Vec4T[T]) Vec4T[T] {
+
+	return Vec4T[T]{this[0] - other[0], this[1] - other[1], this[2] - other[2], this[3] - other[3]}
+}
+
+// returns component product of this and other by value
+func (this Vec4T[T]) Times(other Vec4T[T]) Vec4T[T] {
+
+	return Vec4T[T]{this[0] * other[0], this[1] * other[1], this[2] * other[2], this[3] * other[3]}
+}
+
+// returns component quotient of this and other by value
+func (this Vec4T[T]) DividedBy(other Vec4T[T]) Vec4T[T] {
+
+	return *this.Div(&other)
+}
+
+// returns this scaled by s by value
+func (this Vec4T[T]) Scaled(s T) Vec4T[T] {
+
+	return Vec4T[T]{this[0] * s, this[1] * s, this[2] * s, this[3] * s}
+}
+
+// returns this normalized by value
+func (this Vec4T[T]) Normalized() Vec4T[T] {
+
+	return *this.Normalize()
+}
+
+// returns this negated by value
+func (this Vec4T[T]) Negated() Vec4T[T] {
+
+	return Vec4T[T]{-this[0], -this[1], -this[2], -this[3]}
+}
+
+// returns lerp of this and other by x by value
+func (this Vec4T[T]) Lerped(other Vec4T[T], x T) Vec4T[T] {
+
+	return Vec4T[T]{this[0] + (other[0]-this[0])*x, this[1] + (other[1]-this[1])*x, this[2] + (other[2]-this[2])*x, this[3] + (other[3]-this[3])*x}
+}
+
+// returns this clamped between min and max by value
+func (this Vec4T[T]) Clamped(min, max Vec4T[T]) Vec4T[T] {
+
+	return *this.Clamp(&min, &max)
+}
+
+// returns this transformed by Mat4 by value
+func (this Vec4T[T]) Transformed(m Mat4T[T]) Vec4T[T] {
+
+	return *this.ApplyMat4(&m)
+}
+
+// converts this to float32, saves in target
+func (this *Vec4T[T]) Float32(target *Vec4) *Vec4 {
+
+	for i, v := range this {
+		target[i] = float32(v)
+	}
+
+	return target
+}
+
+// converts this to float64, saves in target
+func (this *Vec4T[T]) Float64(target *Vec4d) *Vec4d {
+
+	for i, v := range this {
+		target[i] = float64(v)
+	}
+
+	return target
+}
+
+// checks if this exactly equals other, use EqualsTol, EqualsRel or EqualsULP for approximate equality
+func (this *Vec4T[T]) Equals(other *Vec4T[T]) bool {
 
 	if this[0] != other[0] {
 		return false
@@ -456,8 +593,62 @@ func (this *Vec4) Equals(other *Vec4) bool {
 	return true
 }
 
+// checks if this equals other within absolute tolerance tol
+func (this *Vec4T[T]) EqualsTol(other *Vec4T[T], tol T) bool {
+
+	return equalsTolSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other within tol relative to its largest element magnitude
+func (this *Vec4T[T]) EqualsRel(other *Vec4T[T], tol T) bool {
+
+	return equalsRelSlice(this[:], other[:], tol)
+}
+
+// checks if this equals other with elements at most ulps representable floats apart
+func (this *Vec4T[T]) EqualsULP(other *Vec4T[T], ulps int) bool {
+
+	return equalsULPSlice(this[:], other[:], ulps)
+}
+
+// returns this in the String format with round trip precision, implements encoding.TextMarshaler
+func (this *Vec4T[T]) MarshalText() ([]byte, error) {
+
+	return appendText(nil, vec4Text, this[:]), nil
+}
+
+// parses the String format into this, implements encoding.TextUnmarshaler
+func (this *Vec4T[T]) UnmarshalText(text []byte) error {
+
+	return parseText(text, vec4Text, this[:])
+}
+
+// returns this as a JSON array, implements json.Marshaler
+func (this *Vec4T[T]) MarshalJSON() ([]byte, error) {
+
+	return appendJSON(nil, this[:])
+}
+
+// parses a JSON array into this, implements json.Unmarshaler
+func (this *Vec4T[T]) UnmarshalJSON(data []byte) error {
+
+	return parseJSON(data, this[:])
+}
+
+// returns this as little endian floats, implements encoding.BinaryMarshaler
+func (this *Vec4T[T]) MarshalBinary() ([]byte, error) {
+
+	return appendBinary(nil, this[:]), nil
+}
+
+// parses little endian floats into this, implements encoding.BinaryUnmarshaler
+func (this *Vec4T[T]) UnmarshalBinary(data []byte) error {
+
+	return parseBinary(data, this[:])
+}
+
 // returns this as string type
-func (this *Vec4) String() string {
+func (this *Vec4T[T]) String() string {
 
 	return fmt.Sprintf("Vec4[ %f, %f, %f, %f ]", this[0], this[1], this[2], this[3])
 }
@@ -0,0 +1,49 @@
+package mathf
+
+import (
+	"testing"
+)
+
+func TestVec4PerspectiveDivide(t *testing.T) {
+	tests := []struct {
+		v, want Vec4
+	}{
+		{Vec4{2, 4, 6, 2}, Vec4{1, 2, 3, 1}},
+		{Vec4{2, 4, 6, -0.5}, Vec4{-4, -8, -12, 1}},
+		{Vec4{2, 4, 6, 0}, Vec4{2, 4, 6, 0}},
+	}
+
+	for _, test := range tests {
+		v := test.v
+
+		if got := v.PerspectiveDivide(); *got != test.want {
+			t.Errorf("%v.PerspectiveDivide() = %v, want %v", test.v, *got, test.want)
+		}
+	}
+}
+
+func TestVec4ApplyMat4(t *testing.T) {
+	transform := Mat4{0, 1, 0, 0, -1, 0, 0, 0, 0, 0, 1, 0, 10, 20, 30, 1}
+
+	tests := []struct {
+		v, want Vec4
+	}{
+		{Vec4{1, 2, 3, 1}, Vec4{8, 21, 33, 1}},
+		{Vec4{1, 2, 3, 0}, Vec4{-2, 1, 3, 0}},
+		{Vec4{1, 2, 3, 2}, Vec4{18, 41, 63, 2}},
+	}
+
+	for _, test := range tests {
+		v := test.v
+
+		if got := v.ApplyMat4(&transform); *got != test.want {
+			t.Errorf("%v.ApplyMat4() = %v, want %v", test.v, *got, test.want)
+		}
+		if got := new(Vec4).VApplyMat4(&test.v, &transform); *got != test.want {
+			t.Errorf("VApplyMat4(%v) = %v, want %v", test.v, *got, test.want)
+		}
+		if got := test.v.Transformed(transform); got != test.want {
+			t.Errorf("%v.Transformed() = %v, want %v", test.v, got, test.want)
+		}
+	}
+}